@@ -272,8 +272,22 @@ func CheckerFromEvent(event Event) (EventChecker, error) {
 		return NewProcessKprobeChecker().FromProcessKprobe(ev), nil
 	case *tetragon.ProcessTracepoint:
 		return NewProcessTracepointChecker().FromProcessTracepoint(ev), nil
+	case *tetragon.ProcessCredentialsChange:
+		return NewProcessCredentialsChangeChecker().FromProcessCredentialsChange(ev), nil
+	case *tetragon.ProcessNamespaceChange:
+		return NewProcessNamespaceChangeChecker().FromProcessNamespaceChange(ev), nil
+	case *tetragon.ProcessKernelModuleLoad:
+		return NewProcessKernelModuleLoadChecker().FromProcessKernelModuleLoad(ev), nil
+	case *tetragon.ProcessBpfProgLoad:
+		return NewProcessBpfProgLoadChecker().FromProcessBpfProgLoad(ev), nil
+	case *tetragon.ProcessBpfMapCreate:
+		return NewProcessBpfMapCreateChecker().FromProcessBpfMapCreate(ev), nil
+	case *tetragon.ProcessMount:
+		return NewProcessMountChecker().FromProcessMount(ev), nil
 	case *tetragon.Test:
 		return NewTestChecker().FromTest(ev), nil
+	case *tetragon.EventsLost:
+		return NewEventsLostChecker().FromEventsLost(ev), nil
 
 	default:
 		return nil, fmt.Errorf("Unhandled event type %T", event)
@@ -311,8 +325,22 @@ func EventFromResponse(response *tetragon.GetEventsResponse) (Event, error) {
 		return ev.ProcessKprobe, nil
 	case *tetragon.GetEventsResponse_ProcessTracepoint:
 		return ev.ProcessTracepoint, nil
+	case *tetragon.GetEventsResponse_ProcessCredentialsChange:
+		return ev.ProcessCredentialsChange, nil
+	case *tetragon.GetEventsResponse_ProcessNamespaceChange:
+		return ev.ProcessNamespaceChange, nil
+	case *tetragon.GetEventsResponse_ProcessKernelModuleLoad:
+		return ev.ProcessKernelModuleLoad, nil
+	case *tetragon.GetEventsResponse_ProcessBpfProgLoad:
+		return ev.ProcessBpfProgLoad, nil
+	case *tetragon.GetEventsResponse_ProcessBpfMapCreate:
+		return ev.ProcessBpfMapCreate, nil
+	case *tetragon.GetEventsResponse_ProcessMount:
+		return ev.ProcessMount, nil
 	case *tetragon.GetEventsResponse_Test:
 		return ev.Test, nil
+	case *tetragon.GetEventsResponse_EventsLost:
+		return ev.EventsLost, nil
 
 	default:
 		return nil, fmt.Errorf("Unknown event type %T", response.Event)
@@ -621,12 +649,18 @@ func (checker *ProcessExitChecker) FromProcessExit(event *tetragon.ProcessExit)
 
 // ProcessKprobeChecker implements a checker struct to check a ProcessKprobe event
 type ProcessKprobeChecker struct {
-	Process      *ProcessChecker              `json:"process,omitempty"`
-	Parent       *ProcessChecker              `json:"parent,omitempty"`
-	FunctionName *stringmatcher.StringMatcher `json:"functionName,omitempty"`
-	Args         *KprobeArgumentListMatcher   `json:"args,omitempty"`
-	Return       *KprobeArgumentChecker       `json:"return,omitempty"`
-	Action       *KprobeActionChecker         `json:"action,omitempty"`
+	Process          *ProcessChecker              `json:"process,omitempty"`
+	Parent           *ProcessChecker              `json:"parent,omitempty"`
+	FunctionName     *stringmatcher.StringMatcher `json:"functionName,omitempty"`
+	Args             *KprobeArgumentListMatcher   `json:"args,omitempty"`
+	Return           *KprobeArgumentChecker       `json:"return,omitempty"`
+	Action           *KprobeActionChecker         `json:"action,omitempty"`
+	PolicyName       *stringmatcher.StringMatcher `json:"policyName,omitempty"`
+	SelectorIndex    *uint32                      `json:"selectorIndex,omitempty"`
+	Tags             *StringListMatcher           `json:"tags,omitempty"`
+	Message          *stringmatcher.StringMatcher `json:"message,omitempty"`
+	KernelStackTrace *StackAddressListMatcher     `json:"kernelStackTrace,omitempty"`
+	UserStackTrace   *StackAddressListMatcher     `json:"userStackTrace,omitempty"`
 }
 
 // CheckEvent checks a single event and implements the EventChecker interface
@@ -687,6 +721,39 @@ func (checker *ProcessKprobeChecker) Check(event *tetragon.ProcessKprobe) error
 			return fmt.Errorf("ProcessKprobeChecker: Action check failed: %w", err)
 		}
 	}
+	if checker.PolicyName != nil {
+		if err := checker.PolicyName.Match(event.PolicyName); err != nil {
+			return fmt.Errorf("ProcessKprobeChecker: PolicyName check failed: %w", err)
+		}
+	}
+	if checker.SelectorIndex != nil {
+		if event.SelectorIndex == nil {
+			return fmt.Errorf("ProcessKprobeChecker: SelectorIndex is nil and does not match expected value %v", *checker.SelectorIndex)
+		}
+		if *checker.SelectorIndex != event.SelectorIndex.Value {
+			return fmt.Errorf("ProcessKprobeChecker: SelectorIndex has value %v which does not match expected value %v", event.SelectorIndex.Value, *checker.SelectorIndex)
+		}
+	}
+	if checker.Tags != nil {
+		if err := checker.Tags.Check(event.Tags); err != nil {
+			return fmt.Errorf("ProcessKprobeChecker: Tags check failed: %w", err)
+		}
+	}
+	if checker.Message != nil {
+		if err := checker.Message.Match(event.Message); err != nil {
+			return fmt.Errorf("ProcessKprobeChecker: Message check failed: %w", err)
+		}
+	}
+	if checker.KernelStackTrace != nil {
+		if err := checker.KernelStackTrace.Check(event.KernelStackTrace); err != nil {
+			return fmt.Errorf("ProcessKprobeChecker: KernelStackTrace check failed: %w", err)
+		}
+	}
+	if checker.UserStackTrace != nil {
+		if err := checker.UserStackTrace.Check(event.UserStackTrace); err != nil {
+			return fmt.Errorf("ProcessKprobeChecker: UserStackTrace check failed: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -727,6 +794,42 @@ func (checker *ProcessKprobeChecker) WithAction(check tetragon.KprobeAction) *Pr
 	return checker
 }
 
+// WithPolicyName adds a PolicyName check to the ProcessKprobeChecker
+func (checker *ProcessKprobeChecker) WithPolicyName(check *stringmatcher.StringMatcher) *ProcessKprobeChecker {
+	checker.PolicyName = check
+	return checker
+}
+
+// WithSelectorIndex adds a SelectorIndex check to the ProcessKprobeChecker
+func (checker *ProcessKprobeChecker) WithSelectorIndex(check uint32) *ProcessKprobeChecker {
+	checker.SelectorIndex = &check
+	return checker
+}
+
+// WithTags adds a Tags check to the ProcessKprobeChecker
+func (checker *ProcessKprobeChecker) WithTags(check *StringListMatcher) *ProcessKprobeChecker {
+	checker.Tags = check
+	return checker
+}
+
+// WithMessage adds a Message check to the ProcessKprobeChecker
+func (checker *ProcessKprobeChecker) WithMessage(check *stringmatcher.StringMatcher) *ProcessKprobeChecker {
+	checker.Message = check
+	return checker
+}
+
+// WithKernelStackTrace adds a KernelStackTrace check to the ProcessKprobeChecker
+func (checker *ProcessKprobeChecker) WithKernelStackTrace(check *StackAddressListMatcher) *ProcessKprobeChecker {
+	checker.KernelStackTrace = check
+	return checker
+}
+
+// WithUserStackTrace adds a UserStackTrace check to the ProcessKprobeChecker
+func (checker *ProcessKprobeChecker) WithUserStackTrace(check *StackAddressListMatcher) *ProcessKprobeChecker {
+	checker.UserStackTrace = check
+	return checker
+}
+
 //FromProcessKprobe populates the ProcessKprobeChecker using data from a ProcessKprobe event
 func (checker *ProcessKprobeChecker) FromProcessKprobe(event *tetragon.ProcessKprobe) *ProcessKprobeChecker {
 	if event == nil {
@@ -756,6 +859,49 @@ func (checker *ProcessKprobeChecker) FromProcessKprobe(event *tetragon.ProcessKp
 		checker.Return = NewKprobeArgumentChecker().FromKprobeArgument(event.Return)
 	}
 	checker.Action = NewKprobeActionChecker(event.Action)
+	checker.PolicyName = stringmatcher.Full(event.PolicyName)
+	if event.SelectorIndex != nil {
+		val := event.SelectorIndex.Value
+		checker.SelectorIndex = &val
+	}
+	{
+		var checks []*stringmatcher.StringMatcher
+		for _, check := range event.Tags {
+			var convertedCheck *stringmatcher.StringMatcher
+			convertedCheck = stringmatcher.Full(check)
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewStringListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Tags = lm
+	}
+	checker.Message = stringmatcher.Full(event.Message)
+	{
+		var checks []*StackAddressChecker
+		for _, check := range event.KernelStackTrace {
+			var convertedCheck *StackAddressChecker
+			if check != nil {
+				convertedCheck = NewStackAddressChecker().FromStackAddress(check)
+			}
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewStackAddressListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.KernelStackTrace = lm
+	}
+	{
+		var checks []*StackAddressChecker
+		for _, check := range event.UserStackTrace {
+			var convertedCheck *StackAddressChecker
+			if check != nil {
+				convertedCheck = NewStackAddressChecker().FromStackAddress(check)
+			}
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewStackAddressListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.UserStackTrace = lm
+	}
 	return checker
 }
 
@@ -859,13 +1005,217 @@ nextCheck:
 	return nil
 }
 
+// StringListMatcher checks a list of string fields
+type StringListMatcher struct {
+	Operator listmatcher.Operator           `json:"operator"`
+	Values   []*stringmatcher.StringMatcher `json:"values"`
+}
+
+// NewStringListMatcher creates a new StringListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
+func NewStringListMatcher() *StringListMatcher {
+	return &StringListMatcher{
+		Operator: listmatcher.Subset,
+	}
+}
+
+// WithOperator sets the match kind for the StringListMatcher
+func (checker *StringListMatcher) WithOperator(operator listmatcher.Operator) *StringListMatcher {
+	checker.Operator = operator
+	return checker
+}
+
+// WithValues sets the checkers that the StringListMatcher should use
+func (checker *StringListMatcher) WithValues(values ...*stringmatcher.StringMatcher) *StringListMatcher {
+	checker.Values = values
+	return checker
+}
+
+// Check checks a list of string fields
+func (checker *StringListMatcher) Check(values []string) error {
+	switch checker.Operator {
+	case listmatcher.Ordered:
+		return checker.orderedCheck(values)
+	case listmatcher.Unordered:
+		return checker.unorderedCheck(values)
+	case listmatcher.Subset:
+		return checker.subsetCheck(values)
+	default:
+		return fmt.Errorf("Unhandled ListMatcher operator %s", checker.Operator)
+	}
+}
+
+// orderedCheck checks a list of ordered string fields
+func (checker *StringListMatcher) orderedCheck(values []string) error {
+	innerCheck := func(check *stringmatcher.StringMatcher, value string) error {
+		if err := check.Match(value); err != nil {
+			return fmt.Errorf("StringListMatcher: Tags check failed: %w", err)
+		}
+		return nil
+	}
+
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("StringListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
+
+	for i, check := range checker.Values {
+		value := values[i]
+		if err := innerCheck(check, value); err != nil {
+			return fmt.Errorf("StringListMatcher: Check failed on element %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// unorderedCheck checks a list of unordered string fields
+func (checker *StringListMatcher) unorderedCheck(values []string) error {
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("StringListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
+
+	return checker.subsetCheck(values)
+}
+
+// subsetCheck checks a subset of string fields
+func (checker *StringListMatcher) subsetCheck(values []string) error {
+	innerCheck := func(check *stringmatcher.StringMatcher, value string) error {
+		if err := check.Match(value); err != nil {
+			return fmt.Errorf("StringListMatcher: Tags check failed: %w", err)
+		}
+		return nil
+	}
+
+	numDesired := len(checker.Values)
+	numMatched := 0
+
+nextCheck:
+	for _, check := range checker.Values {
+		for _, value := range values {
+			if err := innerCheck(check, value); err == nil {
+				numMatched += 1
+				continue nextCheck
+			}
+		}
+	}
+
+	if numMatched < numDesired {
+		return fmt.Errorf("StringListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
+	}
+
+	return nil
+}
+
+// StackAddressListMatcher checks a list of *tetragon.StackAddress fields
+type StackAddressListMatcher struct {
+	Operator listmatcher.Operator   `json:"operator"`
+	Values   []*StackAddressChecker `json:"values"`
+}
+
+// NewStackAddressListMatcher creates a new StackAddressListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
+func NewStackAddressListMatcher() *StackAddressListMatcher {
+	return &StackAddressListMatcher{
+		Operator: listmatcher.Subset,
+	}
+}
+
+// WithOperator sets the match kind for the StackAddressListMatcher
+func (checker *StackAddressListMatcher) WithOperator(operator listmatcher.Operator) *StackAddressListMatcher {
+	checker.Operator = operator
+	return checker
+}
+
+// WithValues sets the checkers that the StackAddressListMatcher should use
+func (checker *StackAddressListMatcher) WithValues(values ...*StackAddressChecker) *StackAddressListMatcher {
+	checker.Values = values
+	return checker
+}
+
+// Check checks a list of *tetragon.StackAddress fields
+func (checker *StackAddressListMatcher) Check(values []*tetragon.StackAddress) error {
+	switch checker.Operator {
+	case listmatcher.Ordered:
+		return checker.orderedCheck(values)
+	case listmatcher.Unordered:
+		return checker.unorderedCheck(values)
+	case listmatcher.Subset:
+		return checker.subsetCheck(values)
+	default:
+		return fmt.Errorf("Unhandled ListMatcher operator %s", checker.Operator)
+	}
+}
+
+// orderedCheck checks a list of ordered *tetragon.StackAddress fields
+func (checker *StackAddressListMatcher) orderedCheck(values []*tetragon.StackAddress) error {
+	innerCheck := func(check *StackAddressChecker, value *tetragon.StackAddress) error {
+		if err := check.Check(value); err != nil {
+			return fmt.Errorf("StackAddressListMatcher: KernelStackTrace check failed: %w", err)
+		}
+		return nil
+	}
+
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("StackAddressListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
+
+	for i, check := range checker.Values {
+		value := values[i]
+		if err := innerCheck(check, value); err != nil {
+			return fmt.Errorf("StackAddressListMatcher: Check failed on element %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// unorderedCheck checks a list of unordered *tetragon.StackAddress fields
+func (checker *StackAddressListMatcher) unorderedCheck(values []*tetragon.StackAddress) error {
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("StackAddressListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
+
+	return checker.subsetCheck(values)
+}
+
+// subsetCheck checks a subset of *tetragon.StackAddress fields
+func (checker *StackAddressListMatcher) subsetCheck(values []*tetragon.StackAddress) error {
+	innerCheck := func(check *StackAddressChecker, value *tetragon.StackAddress) error {
+		if err := check.Check(value); err != nil {
+			return fmt.Errorf("StackAddressListMatcher: KernelStackTrace check failed: %w", err)
+		}
+		return nil
+	}
+
+	numDesired := len(checker.Values)
+	numMatched := 0
+
+nextCheck:
+	for _, check := range checker.Values {
+		for _, value := range values {
+			if err := innerCheck(check, value); err == nil {
+				numMatched += 1
+				continue nextCheck
+			}
+		}
+	}
+
+	if numMatched < numDesired {
+		return fmt.Errorf("StackAddressListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
+	}
+
+	return nil
+}
+
 // ProcessTracepointChecker implements a checker struct to check a ProcessTracepoint event
 type ProcessTracepointChecker struct {
-	Process *ProcessChecker              `json:"process,omitempty"`
-	Parent  *ProcessChecker              `json:"parent,omitempty"`
-	Subsys  *stringmatcher.StringMatcher `json:"subsys,omitempty"`
-	Event   *stringmatcher.StringMatcher `json:"event,omitempty"`
-	Args    *KprobeArgumentListMatcher   `json:"args,omitempty"`
+	Process       *ProcessChecker              `json:"process,omitempty"`
+	Parent        *ProcessChecker              `json:"parent,omitempty"`
+	Subsys        *stringmatcher.StringMatcher `json:"subsys,omitempty"`
+	Event         *stringmatcher.StringMatcher `json:"event,omitempty"`
+	Args          *KprobeArgumentListMatcher   `json:"args,omitempty"`
+	PolicyName    *stringmatcher.StringMatcher `json:"policyName,omitempty"`
+	SelectorIndex *uint32                      `json:"selectorIndex,omitempty"`
+	Tags          *StringListMatcher           `json:"tags,omitempty"`
+	Message       *stringmatcher.StringMatcher `json:"message,omitempty"`
 }
 
 // CheckEvent checks a single event and implements the EventChecker interface
@@ -901,61 +1251,986 @@ func (checker *ProcessTracepointChecker) Check(event *tetragon.ProcessTracepoint
 			return fmt.Errorf("ProcessTracepointChecker: Process check failed: %w", err)
 		}
 	}
-	if checker.Parent != nil {
-		if err := checker.Parent.Check(event.Parent); err != nil {
-			return fmt.Errorf("ProcessTracepointChecker: Parent check failed: %w", err)
+	if checker.Parent != nil {
+		if err := checker.Parent.Check(event.Parent); err != nil {
+			return fmt.Errorf("ProcessTracepointChecker: Parent check failed: %w", err)
+		}
+	}
+	if checker.Subsys != nil {
+		if err := checker.Subsys.Match(event.Subsys); err != nil {
+			return fmt.Errorf("ProcessTracepointChecker: Subsys check failed: %w", err)
+		}
+	}
+	if checker.Event != nil {
+		if err := checker.Event.Match(event.Event); err != nil {
+			return fmt.Errorf("ProcessTracepointChecker: Event check failed: %w", err)
+		}
+	}
+	if checker.Args != nil {
+		if err := checker.Args.Check(event.Args); err != nil {
+			return fmt.Errorf("ProcessTracepointChecker: Args check failed: %w", err)
+		}
+	}
+	if checker.PolicyName != nil {
+		if err := checker.PolicyName.Match(event.PolicyName); err != nil {
+			return fmt.Errorf("ProcessTracepointChecker: PolicyName check failed: %w", err)
+		}
+	}
+	if checker.SelectorIndex != nil {
+		if event.SelectorIndex == nil {
+			return fmt.Errorf("ProcessTracepointChecker: SelectorIndex is nil and does not match expected value %v", *checker.SelectorIndex)
+		}
+		if *checker.SelectorIndex != event.SelectorIndex.Value {
+			return fmt.Errorf("ProcessTracepointChecker: SelectorIndex has value %v which does not match expected value %v", event.SelectorIndex.Value, *checker.SelectorIndex)
+		}
+	}
+	if checker.Tags != nil {
+		if err := checker.Tags.Check(event.Tags); err != nil {
+			return fmt.Errorf("ProcessTracepointChecker: Tags check failed: %w", err)
+		}
+	}
+	if checker.Message != nil {
+		if err := checker.Message.Match(event.Message); err != nil {
+			return fmt.Errorf("ProcessTracepointChecker: Message check failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// WithProcess adds a Process check to the ProcessTracepointChecker
+func (checker *ProcessTracepointChecker) WithProcess(check *ProcessChecker) *ProcessTracepointChecker {
+	checker.Process = check
+	return checker
+}
+
+// WithParent adds a Parent check to the ProcessTracepointChecker
+func (checker *ProcessTracepointChecker) WithParent(check *ProcessChecker) *ProcessTracepointChecker {
+	checker.Parent = check
+	return checker
+}
+
+// WithSubsys adds a Subsys check to the ProcessTracepointChecker
+func (checker *ProcessTracepointChecker) WithSubsys(check *stringmatcher.StringMatcher) *ProcessTracepointChecker {
+	checker.Subsys = check
+	return checker
+}
+
+// WithEvent adds a Event check to the ProcessTracepointChecker
+func (checker *ProcessTracepointChecker) WithEvent(check *stringmatcher.StringMatcher) *ProcessTracepointChecker {
+	checker.Event = check
+	return checker
+}
+
+// WithArgs adds a Args check to the ProcessTracepointChecker
+func (checker *ProcessTracepointChecker) WithArgs(check *KprobeArgumentListMatcher) *ProcessTracepointChecker {
+	checker.Args = check
+	return checker
+}
+
+// WithPolicyName adds a PolicyName check to the ProcessTracepointChecker
+func (checker *ProcessTracepointChecker) WithPolicyName(check *stringmatcher.StringMatcher) *ProcessTracepointChecker {
+	checker.PolicyName = check
+	return checker
+}
+
+// WithSelectorIndex adds a SelectorIndex check to the ProcessTracepointChecker
+func (checker *ProcessTracepointChecker) WithSelectorIndex(check uint32) *ProcessTracepointChecker {
+	checker.SelectorIndex = &check
+	return checker
+}
+
+// WithTags adds a Tags check to the ProcessTracepointChecker
+func (checker *ProcessTracepointChecker) WithTags(check *StringListMatcher) *ProcessTracepointChecker {
+	checker.Tags = check
+	return checker
+}
+
+// WithMessage adds a Message check to the ProcessTracepointChecker
+func (checker *ProcessTracepointChecker) WithMessage(check *stringmatcher.StringMatcher) *ProcessTracepointChecker {
+	checker.Message = check
+	return checker
+}
+
+//FromProcessTracepoint populates the ProcessTracepointChecker using data from a ProcessTracepoint event
+func (checker *ProcessTracepointChecker) FromProcessTracepoint(event *tetragon.ProcessTracepoint) *ProcessTracepointChecker {
+	if event == nil {
+		return checker
+	}
+	if event.Process != nil {
+		checker.Process = NewProcessChecker().FromProcess(event.Process)
+	}
+	if event.Parent != nil {
+		checker.Parent = NewProcessChecker().FromProcess(event.Parent)
+	}
+	checker.Subsys = stringmatcher.Full(event.Subsys)
+	checker.Event = stringmatcher.Full(event.Event)
+	{
+		var checks []*KprobeArgumentChecker
+		for _, check := range event.Args {
+			var convertedCheck *KprobeArgumentChecker
+			if check != nil {
+				convertedCheck = NewKprobeArgumentChecker().FromKprobeArgument(check)
+			}
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewKprobeArgumentListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Args = lm
+	}
+	checker.PolicyName = stringmatcher.Full(event.PolicyName)
+	if event.SelectorIndex != nil {
+		val := event.SelectorIndex.Value
+		checker.SelectorIndex = &val
+	}
+	{
+		var checks []*stringmatcher.StringMatcher
+		for _, check := range event.Tags {
+			var convertedCheck *stringmatcher.StringMatcher
+			convertedCheck = stringmatcher.Full(check)
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewStringListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Tags = lm
+	}
+	checker.Message = stringmatcher.Full(event.Message)
+	return checker
+}
+
+// ProcessCredentialsChangeChecker implements a checker struct to check a ProcessCredentialsChange event
+type ProcessCredentialsChangeChecker struct {
+	Process        *ProcessChecker              `json:"process,omitempty"`
+	Parent         *ProcessChecker              `json:"parent,omitempty"`
+	OldCredentials *ProcessCredentialsChecker   `json:"oldCredentials,omitempty"`
+	NewCredentials *ProcessCredentialsChecker   `json:"newCredentials,omitempty"`
+	Syscall        *stringmatcher.StringMatcher `json:"syscall,omitempty"`
+}
+
+// CheckEvent checks a single event and implements the EventChecker interface
+func (checker *ProcessCredentialsChangeChecker) CheckEvent(event Event) error {
+	if ev, ok := event.(*tetragon.ProcessCredentialsChange); ok {
+		return checker.Check(ev)
+	}
+	return fmt.Errorf("%T is not a ProcessCredentialsChange event", event)
+}
+
+// CheckResponse checks a single gRPC response and implements the EventChecker interface
+func (checker *ProcessCredentialsChangeChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
+	event, err := EventFromResponse(response)
+	if err != nil {
+		return err
+	}
+	return checker.CheckEvent(event)
+}
+
+// NewProcessCredentialsChangeChecker creates a new ProcessCredentialsChangeChecker
+func NewProcessCredentialsChangeChecker() *ProcessCredentialsChangeChecker {
+	return &ProcessCredentialsChangeChecker{}
+}
+
+// Check checks a ProcessCredentialsChange event
+func (checker *ProcessCredentialsChangeChecker) Check(event *tetragon.ProcessCredentialsChange) error {
+	if event == nil {
+		return fmt.Errorf("ProcessCredentialsChangeChecker: ProcessCredentialsChange event is nil")
+	}
+
+	if checker.Process != nil {
+		if err := checker.Process.Check(event.Process); err != nil {
+			return fmt.Errorf("ProcessCredentialsChangeChecker: Process check failed: %w", err)
+		}
+	}
+	if checker.Parent != nil {
+		if err := checker.Parent.Check(event.Parent); err != nil {
+			return fmt.Errorf("ProcessCredentialsChangeChecker: Parent check failed: %w", err)
+		}
+	}
+	if checker.OldCredentials != nil {
+		if err := checker.OldCredentials.Check(event.OldCredentials); err != nil {
+			return fmt.Errorf("ProcessCredentialsChangeChecker: OldCredentials check failed: %w", err)
+		}
+	}
+	if checker.NewCredentials != nil {
+		if err := checker.NewCredentials.Check(event.NewCredentials); err != nil {
+			return fmt.Errorf("ProcessCredentialsChangeChecker: NewCredentials check failed: %w", err)
+		}
+	}
+	if checker.Syscall != nil {
+		if err := checker.Syscall.Match(event.Syscall); err != nil {
+			return fmt.Errorf("ProcessCredentialsChangeChecker: Syscall check failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// WithProcess adds a Process check to the ProcessCredentialsChangeChecker
+func (checker *ProcessCredentialsChangeChecker) WithProcess(check *ProcessChecker) *ProcessCredentialsChangeChecker {
+	checker.Process = check
+	return checker
+}
+
+// WithParent adds a Parent check to the ProcessCredentialsChangeChecker
+func (checker *ProcessCredentialsChangeChecker) WithParent(check *ProcessChecker) *ProcessCredentialsChangeChecker {
+	checker.Parent = check
+	return checker
+}
+
+// WithOldCredentials adds a OldCredentials check to the ProcessCredentialsChangeChecker
+func (checker *ProcessCredentialsChangeChecker) WithOldCredentials(check *ProcessCredentialsChecker) *ProcessCredentialsChangeChecker {
+	checker.OldCredentials = check
+	return checker
+}
+
+// WithNewCredentials adds a NewCredentials check to the ProcessCredentialsChangeChecker
+func (checker *ProcessCredentialsChangeChecker) WithNewCredentials(check *ProcessCredentialsChecker) *ProcessCredentialsChangeChecker {
+	checker.NewCredentials = check
+	return checker
+}
+
+// WithSyscall adds a Syscall check to the ProcessCredentialsChangeChecker
+func (checker *ProcessCredentialsChangeChecker) WithSyscall(check *stringmatcher.StringMatcher) *ProcessCredentialsChangeChecker {
+	checker.Syscall = check
+	return checker
+}
+
+//FromProcessCredentialsChange populates the ProcessCredentialsChangeChecker using data from a ProcessCredentialsChange event
+func (checker *ProcessCredentialsChangeChecker) FromProcessCredentialsChange(event *tetragon.ProcessCredentialsChange) *ProcessCredentialsChangeChecker {
+	if event == nil {
+		return checker
+	}
+	if event.Process != nil {
+		checker.Process = NewProcessChecker().FromProcess(event.Process)
+	}
+	if event.Parent != nil {
+		checker.Parent = NewProcessChecker().FromProcess(event.Parent)
+	}
+	if event.OldCredentials != nil {
+		checker.OldCredentials = NewProcessCredentialsChecker().FromProcessCredentials(event.OldCredentials)
+	}
+	if event.NewCredentials != nil {
+		checker.NewCredentials = NewProcessCredentialsChecker().FromProcessCredentials(event.NewCredentials)
+	}
+	checker.Syscall = stringmatcher.Full(event.Syscall)
+	return checker
+}
+
+// ProcessNamespaceChangeChecker implements a checker struct to check a ProcessNamespaceChange event
+type ProcessNamespaceChangeChecker struct {
+	Process       *ProcessChecker              `json:"process,omitempty"`
+	Parent        *ProcessChecker              `json:"parent,omitempty"`
+	OldNamespaces *NamespacesChecker           `json:"oldNamespaces,omitempty"`
+	NewNamespaces *NamespacesChecker           `json:"newNamespaces,omitempty"`
+	Flags         *uint32                      `json:"flags,omitempty"`
+	Syscall       *stringmatcher.StringMatcher `json:"syscall,omitempty"`
+	ChildPid      *uint32                      `json:"childPid,omitempty"`
+}
+
+// CheckEvent checks a single event and implements the EventChecker interface
+func (checker *ProcessNamespaceChangeChecker) CheckEvent(event Event) error {
+	if ev, ok := event.(*tetragon.ProcessNamespaceChange); ok {
+		return checker.Check(ev)
+	}
+	return fmt.Errorf("%T is not a ProcessNamespaceChange event", event)
+}
+
+// CheckResponse checks a single gRPC response and implements the EventChecker interface
+func (checker *ProcessNamespaceChangeChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
+	event, err := EventFromResponse(response)
+	if err != nil {
+		return err
+	}
+	return checker.CheckEvent(event)
+}
+
+// NewProcessNamespaceChangeChecker creates a new ProcessNamespaceChangeChecker
+func NewProcessNamespaceChangeChecker() *ProcessNamespaceChangeChecker {
+	return &ProcessNamespaceChangeChecker{}
+}
+
+// Check checks a ProcessNamespaceChange event
+func (checker *ProcessNamespaceChangeChecker) Check(event *tetragon.ProcessNamespaceChange) error {
+	if event == nil {
+		return fmt.Errorf("ProcessNamespaceChangeChecker: ProcessNamespaceChange event is nil")
+	}
+
+	if checker.Process != nil {
+		if err := checker.Process.Check(event.Process); err != nil {
+			return fmt.Errorf("ProcessNamespaceChangeChecker: Process check failed: %w", err)
+		}
+	}
+	if checker.Parent != nil {
+		if err := checker.Parent.Check(event.Parent); err != nil {
+			return fmt.Errorf("ProcessNamespaceChangeChecker: Parent check failed: %w", err)
+		}
+	}
+	if checker.OldNamespaces != nil {
+		if err := checker.OldNamespaces.Check(event.OldNamespaces); err != nil {
+			return fmt.Errorf("ProcessNamespaceChangeChecker: OldNamespaces check failed: %w", err)
+		}
+	}
+	if checker.NewNamespaces != nil {
+		if err := checker.NewNamespaces.Check(event.NewNamespaces); err != nil {
+			return fmt.Errorf("ProcessNamespaceChangeChecker: NewNamespaces check failed: %w", err)
+		}
+	}
+	if checker.Flags != nil {
+		if *checker.Flags != event.Flags {
+			return fmt.Errorf("ProcessNamespaceChangeChecker: Flags has value %d which does not match expected value %d", event.Flags, *checker.Flags)
+		}
+	}
+	if checker.Syscall != nil {
+		if err := checker.Syscall.Match(event.Syscall); err != nil {
+			return fmt.Errorf("ProcessNamespaceChangeChecker: Syscall check failed: %w", err)
+		}
+	}
+	if checker.ChildPid != nil {
+		if event.ChildPid == nil {
+			return fmt.Errorf("ProcessNamespaceChangeChecker: ChildPid is nil and does not match expected value %v", *checker.ChildPid)
+		}
+		if *checker.ChildPid != event.ChildPid.Value {
+			return fmt.Errorf("ProcessNamespaceChangeChecker: ChildPid has value %v which does not match expected value %v", event.ChildPid.Value, *checker.ChildPid)
+		}
+	}
+	return nil
+}
+
+// WithProcess adds a Process check to the ProcessNamespaceChangeChecker
+func (checker *ProcessNamespaceChangeChecker) WithProcess(check *ProcessChecker) *ProcessNamespaceChangeChecker {
+	checker.Process = check
+	return checker
+}
+
+// WithParent adds a Parent check to the ProcessNamespaceChangeChecker
+func (checker *ProcessNamespaceChangeChecker) WithParent(check *ProcessChecker) *ProcessNamespaceChangeChecker {
+	checker.Parent = check
+	return checker
+}
+
+// WithOldNamespaces adds a OldNamespaces check to the ProcessNamespaceChangeChecker
+func (checker *ProcessNamespaceChangeChecker) WithOldNamespaces(check *NamespacesChecker) *ProcessNamespaceChangeChecker {
+	checker.OldNamespaces = check
+	return checker
+}
+
+// WithNewNamespaces adds a NewNamespaces check to the ProcessNamespaceChangeChecker
+func (checker *ProcessNamespaceChangeChecker) WithNewNamespaces(check *NamespacesChecker) *ProcessNamespaceChangeChecker {
+	checker.NewNamespaces = check
+	return checker
+}
+
+// WithFlags adds a Flags check to the ProcessNamespaceChangeChecker
+func (checker *ProcessNamespaceChangeChecker) WithFlags(check uint32) *ProcessNamespaceChangeChecker {
+	checker.Flags = &check
+	return checker
+}
+
+// WithSyscall adds a Syscall check to the ProcessNamespaceChangeChecker
+func (checker *ProcessNamespaceChangeChecker) WithSyscall(check *stringmatcher.StringMatcher) *ProcessNamespaceChangeChecker {
+	checker.Syscall = check
+	return checker
+}
+
+// WithChildPid adds a ChildPid check to the ProcessNamespaceChangeChecker
+func (checker *ProcessNamespaceChangeChecker) WithChildPid(check uint32) *ProcessNamespaceChangeChecker {
+	checker.ChildPid = &check
+	return checker
+}
+
+//FromProcessNamespaceChange populates the ProcessNamespaceChangeChecker using data from a ProcessNamespaceChange event
+func (checker *ProcessNamespaceChangeChecker) FromProcessNamespaceChange(event *tetragon.ProcessNamespaceChange) *ProcessNamespaceChangeChecker {
+	if event == nil {
+		return checker
+	}
+	if event.Process != nil {
+		checker.Process = NewProcessChecker().FromProcess(event.Process)
+	}
+	if event.Parent != nil {
+		checker.Parent = NewProcessChecker().FromProcess(event.Parent)
+	}
+	if event.OldNamespaces != nil {
+		checker.OldNamespaces = NewNamespacesChecker().FromNamespaces(event.OldNamespaces)
+	}
+	if event.NewNamespaces != nil {
+		checker.NewNamespaces = NewNamespacesChecker().FromNamespaces(event.NewNamespaces)
+	}
+	{
+		val := event.Flags
+		checker.Flags = &val
+	}
+	checker.Syscall = stringmatcher.Full(event.Syscall)
+	if event.ChildPid != nil {
+		val := event.ChildPid.Value
+		checker.ChildPid = &val
+	}
+	return checker
+}
+
+// ProcessKernelModuleLoadChecker implements a checker struct to check a ProcessKernelModuleLoad event
+type ProcessKernelModuleLoadChecker struct {
+	Process *ProcessChecker              `json:"process,omitempty"`
+	Parent  *ProcessChecker              `json:"parent,omitempty"`
+	Module  *KernelModuleChecker         `json:"module,omitempty"`
+	Syscall *stringmatcher.StringMatcher `json:"syscall,omitempty"`
+}
+
+// CheckEvent checks a single event and implements the EventChecker interface
+func (checker *ProcessKernelModuleLoadChecker) CheckEvent(event Event) error {
+	if ev, ok := event.(*tetragon.ProcessKernelModuleLoad); ok {
+		return checker.Check(ev)
+	}
+	return fmt.Errorf("%T is not a ProcessKernelModuleLoad event", event)
+}
+
+// CheckResponse checks a single gRPC response and implements the EventChecker interface
+func (checker *ProcessKernelModuleLoadChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
+	event, err := EventFromResponse(response)
+	if err != nil {
+		return err
+	}
+	return checker.CheckEvent(event)
+}
+
+// NewProcessKernelModuleLoadChecker creates a new ProcessKernelModuleLoadChecker
+func NewProcessKernelModuleLoadChecker() *ProcessKernelModuleLoadChecker {
+	return &ProcessKernelModuleLoadChecker{}
+}
+
+// Check checks a ProcessKernelModuleLoad event
+func (checker *ProcessKernelModuleLoadChecker) Check(event *tetragon.ProcessKernelModuleLoad) error {
+	if event == nil {
+		return fmt.Errorf("ProcessKernelModuleLoadChecker: ProcessKernelModuleLoad event is nil")
+	}
+
+	if checker.Process != nil {
+		if err := checker.Process.Check(event.Process); err != nil {
+			return fmt.Errorf("ProcessKernelModuleLoadChecker: Process check failed: %w", err)
+		}
+	}
+	if checker.Parent != nil {
+		if err := checker.Parent.Check(event.Parent); err != nil {
+			return fmt.Errorf("ProcessKernelModuleLoadChecker: Parent check failed: %w", err)
+		}
+	}
+	if checker.Module != nil {
+		if err := checker.Module.Check(event.Module); err != nil {
+			return fmt.Errorf("ProcessKernelModuleLoadChecker: Module check failed: %w", err)
+		}
+	}
+	if checker.Syscall != nil {
+		if err := checker.Syscall.Match(event.Syscall); err != nil {
+			return fmt.Errorf("ProcessKernelModuleLoadChecker: Syscall check failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// WithProcess adds a Process check to the ProcessKernelModuleLoadChecker
+func (checker *ProcessKernelModuleLoadChecker) WithProcess(check *ProcessChecker) *ProcessKernelModuleLoadChecker {
+	checker.Process = check
+	return checker
+}
+
+// WithParent adds a Parent check to the ProcessKernelModuleLoadChecker
+func (checker *ProcessKernelModuleLoadChecker) WithParent(check *ProcessChecker) *ProcessKernelModuleLoadChecker {
+	checker.Parent = check
+	return checker
+}
+
+// WithModule adds a Module check to the ProcessKernelModuleLoadChecker
+func (checker *ProcessKernelModuleLoadChecker) WithModule(check *KernelModuleChecker) *ProcessKernelModuleLoadChecker {
+	checker.Module = check
+	return checker
+}
+
+// WithSyscall adds a Syscall check to the ProcessKernelModuleLoadChecker
+func (checker *ProcessKernelModuleLoadChecker) WithSyscall(check *stringmatcher.StringMatcher) *ProcessKernelModuleLoadChecker {
+	checker.Syscall = check
+	return checker
+}
+
+//FromProcessKernelModuleLoad populates the ProcessKernelModuleLoadChecker using data from a ProcessKernelModuleLoad event
+func (checker *ProcessKernelModuleLoadChecker) FromProcessKernelModuleLoad(event *tetragon.ProcessKernelModuleLoad) *ProcessKernelModuleLoadChecker {
+	if event == nil {
+		return checker
+	}
+	if event.Process != nil {
+		checker.Process = NewProcessChecker().FromProcess(event.Process)
+	}
+	if event.Parent != nil {
+		checker.Parent = NewProcessChecker().FromProcess(event.Parent)
+	}
+	if event.Module != nil {
+		checker.Module = NewKernelModuleChecker().FromKernelModule(event.Module)
+	}
+	checker.Syscall = stringmatcher.Full(event.Syscall)
+	return checker
+}
+
+// ProcessBpfProgLoadChecker implements a checker struct to check a ProcessBpfProgLoad event
+type ProcessBpfProgLoadChecker struct {
+	Process    *ProcessChecker              `json:"process,omitempty"`
+	Parent     *ProcessChecker              `json:"parent,omitempty"`
+	ProgType   *stringmatcher.StringMatcher `json:"progType,omitempty"`
+	Name       *stringmatcher.StringMatcher `json:"name,omitempty"`
+	InsnCnt    *uint32                      `json:"insnCnt,omitempty"`
+	AttachType *stringmatcher.StringMatcher `json:"attachType,omitempty"`
+	Tag        *stringmatcher.StringMatcher `json:"tag,omitempty"`
+	Id         *uint32                      `json:"id,omitempty"`
+}
+
+// CheckEvent checks a single event and implements the EventChecker interface
+func (checker *ProcessBpfProgLoadChecker) CheckEvent(event Event) error {
+	if ev, ok := event.(*tetragon.ProcessBpfProgLoad); ok {
+		return checker.Check(ev)
+	}
+	return fmt.Errorf("%T is not a ProcessBpfProgLoad event", event)
+}
+
+// CheckResponse checks a single gRPC response and implements the EventChecker interface
+func (checker *ProcessBpfProgLoadChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
+	event, err := EventFromResponse(response)
+	if err != nil {
+		return err
+	}
+	return checker.CheckEvent(event)
+}
+
+// NewProcessBpfProgLoadChecker creates a new ProcessBpfProgLoadChecker
+func NewProcessBpfProgLoadChecker() *ProcessBpfProgLoadChecker {
+	return &ProcessBpfProgLoadChecker{}
+}
+
+// Check checks a ProcessBpfProgLoad event
+func (checker *ProcessBpfProgLoadChecker) Check(event *tetragon.ProcessBpfProgLoad) error {
+	if event == nil {
+		return fmt.Errorf("ProcessBpfProgLoadChecker: ProcessBpfProgLoad event is nil")
+	}
+
+	if checker.Process != nil {
+		if err := checker.Process.Check(event.Process); err != nil {
+			return fmt.Errorf("ProcessBpfProgLoadChecker: Process check failed: %w", err)
+		}
+	}
+	if checker.Parent != nil {
+		if err := checker.Parent.Check(event.Parent); err != nil {
+			return fmt.Errorf("ProcessBpfProgLoadChecker: Parent check failed: %w", err)
+		}
+	}
+	if checker.ProgType != nil {
+		if err := checker.ProgType.Match(event.ProgType); err != nil {
+			return fmt.Errorf("ProcessBpfProgLoadChecker: ProgType check failed: %w", err)
+		}
+	}
+	if checker.Name != nil {
+		if err := checker.Name.Match(event.Name); err != nil {
+			return fmt.Errorf("ProcessBpfProgLoadChecker: Name check failed: %w", err)
+		}
+	}
+	if checker.InsnCnt != nil {
+		if *checker.InsnCnt != event.InsnCnt {
+			return fmt.Errorf("ProcessBpfProgLoadChecker: InsnCnt has value %d which does not match expected value %d", event.InsnCnt, *checker.InsnCnt)
+		}
+	}
+	if checker.AttachType != nil {
+		if err := checker.AttachType.Match(event.AttachType); err != nil {
+			return fmt.Errorf("ProcessBpfProgLoadChecker: AttachType check failed: %w", err)
+		}
+	}
+	if checker.Tag != nil {
+		if err := checker.Tag.Match(event.Tag); err != nil {
+			return fmt.Errorf("ProcessBpfProgLoadChecker: Tag check failed: %w", err)
+		}
+	}
+	if checker.Id != nil {
+		if *checker.Id != event.Id {
+			return fmt.Errorf("ProcessBpfProgLoadChecker: Id has value %d which does not match expected value %d", event.Id, *checker.Id)
+		}
+	}
+	return nil
+}
+
+// WithProcess adds a Process check to the ProcessBpfProgLoadChecker
+func (checker *ProcessBpfProgLoadChecker) WithProcess(check *ProcessChecker) *ProcessBpfProgLoadChecker {
+	checker.Process = check
+	return checker
+}
+
+// WithParent adds a Parent check to the ProcessBpfProgLoadChecker
+func (checker *ProcessBpfProgLoadChecker) WithParent(check *ProcessChecker) *ProcessBpfProgLoadChecker {
+	checker.Parent = check
+	return checker
+}
+
+// WithProgType adds a ProgType check to the ProcessBpfProgLoadChecker
+func (checker *ProcessBpfProgLoadChecker) WithProgType(check *stringmatcher.StringMatcher) *ProcessBpfProgLoadChecker {
+	checker.ProgType = check
+	return checker
+}
+
+// WithName adds a Name check to the ProcessBpfProgLoadChecker
+func (checker *ProcessBpfProgLoadChecker) WithName(check *stringmatcher.StringMatcher) *ProcessBpfProgLoadChecker {
+	checker.Name = check
+	return checker
+}
+
+// WithInsnCnt adds a InsnCnt check to the ProcessBpfProgLoadChecker
+func (checker *ProcessBpfProgLoadChecker) WithInsnCnt(check uint32) *ProcessBpfProgLoadChecker {
+	checker.InsnCnt = &check
+	return checker
+}
+
+// WithAttachType adds a AttachType check to the ProcessBpfProgLoadChecker
+func (checker *ProcessBpfProgLoadChecker) WithAttachType(check *stringmatcher.StringMatcher) *ProcessBpfProgLoadChecker {
+	checker.AttachType = check
+	return checker
+}
+
+// WithTag adds a Tag check to the ProcessBpfProgLoadChecker
+func (checker *ProcessBpfProgLoadChecker) WithTag(check *stringmatcher.StringMatcher) *ProcessBpfProgLoadChecker {
+	checker.Tag = check
+	return checker
+}
+
+// WithId adds a Id check to the ProcessBpfProgLoadChecker
+func (checker *ProcessBpfProgLoadChecker) WithId(check uint32) *ProcessBpfProgLoadChecker {
+	checker.Id = &check
+	return checker
+}
+
+//FromProcessBpfProgLoad populates the ProcessBpfProgLoadChecker using data from a ProcessBpfProgLoad event
+func (checker *ProcessBpfProgLoadChecker) FromProcessBpfProgLoad(event *tetragon.ProcessBpfProgLoad) *ProcessBpfProgLoadChecker {
+	if event == nil {
+		return checker
+	}
+	if event.Process != nil {
+		checker.Process = NewProcessChecker().FromProcess(event.Process)
+	}
+	if event.Parent != nil {
+		checker.Parent = NewProcessChecker().FromProcess(event.Parent)
+	}
+	checker.ProgType = stringmatcher.Full(event.ProgType)
+	checker.Name = stringmatcher.Full(event.Name)
+	{
+		val := event.InsnCnt
+		checker.InsnCnt = &val
+	}
+	checker.AttachType = stringmatcher.Full(event.AttachType)
+	checker.Tag = stringmatcher.Full(event.Tag)
+	{
+		val := event.Id
+		checker.Id = &val
+	}
+	return checker
+}
+
+// ProcessBpfMapCreateChecker implements a checker struct to check a ProcessBpfMapCreate event
+type ProcessBpfMapCreateChecker struct {
+	Process    *ProcessChecker              `json:"process,omitempty"`
+	Parent     *ProcessChecker              `json:"parent,omitempty"`
+	MapType    *stringmatcher.StringMatcher `json:"mapType,omitempty"`
+	Name       *stringmatcher.StringMatcher `json:"name,omitempty"`
+	KeySize    *uint32                      `json:"keySize,omitempty"`
+	ValueSize  *uint32                      `json:"valueSize,omitempty"`
+	MaxEntries *uint32                      `json:"maxEntries,omitempty"`
+	MapFlags   *uint32                      `json:"mapFlags,omitempty"`
+}
+
+// CheckEvent checks a single event and implements the EventChecker interface
+func (checker *ProcessBpfMapCreateChecker) CheckEvent(event Event) error {
+	if ev, ok := event.(*tetragon.ProcessBpfMapCreate); ok {
+		return checker.Check(ev)
+	}
+	return fmt.Errorf("%T is not a ProcessBpfMapCreate event", event)
+}
+
+// CheckResponse checks a single gRPC response and implements the EventChecker interface
+func (checker *ProcessBpfMapCreateChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
+	event, err := EventFromResponse(response)
+	if err != nil {
+		return err
+	}
+	return checker.CheckEvent(event)
+}
+
+// NewProcessBpfMapCreateChecker creates a new ProcessBpfMapCreateChecker
+func NewProcessBpfMapCreateChecker() *ProcessBpfMapCreateChecker {
+	return &ProcessBpfMapCreateChecker{}
+}
+
+// Check checks a ProcessBpfMapCreate event
+func (checker *ProcessBpfMapCreateChecker) Check(event *tetragon.ProcessBpfMapCreate) error {
+	if event == nil {
+		return fmt.Errorf("ProcessBpfMapCreateChecker: ProcessBpfMapCreate event is nil")
+	}
+
+	if checker.Process != nil {
+		if err := checker.Process.Check(event.Process); err != nil {
+			return fmt.Errorf("ProcessBpfMapCreateChecker: Process check failed: %w", err)
+		}
+	}
+	if checker.Parent != nil {
+		if err := checker.Parent.Check(event.Parent); err != nil {
+			return fmt.Errorf("ProcessBpfMapCreateChecker: Parent check failed: %w", err)
+		}
+	}
+	if checker.MapType != nil {
+		if err := checker.MapType.Match(event.MapType); err != nil {
+			return fmt.Errorf("ProcessBpfMapCreateChecker: MapType check failed: %w", err)
+		}
+	}
+	if checker.Name != nil {
+		if err := checker.Name.Match(event.Name); err != nil {
+			return fmt.Errorf("ProcessBpfMapCreateChecker: Name check failed: %w", err)
+		}
+	}
+	if checker.KeySize != nil {
+		if *checker.KeySize != event.KeySize {
+			return fmt.Errorf("ProcessBpfMapCreateChecker: KeySize has value %d which does not match expected value %d", event.KeySize, *checker.KeySize)
+		}
+	}
+	if checker.ValueSize != nil {
+		if *checker.ValueSize != event.ValueSize {
+			return fmt.Errorf("ProcessBpfMapCreateChecker: ValueSize has value %d which does not match expected value %d", event.ValueSize, *checker.ValueSize)
+		}
+	}
+	if checker.MaxEntries != nil {
+		if *checker.MaxEntries != event.MaxEntries {
+			return fmt.Errorf("ProcessBpfMapCreateChecker: MaxEntries has value %d which does not match expected value %d", event.MaxEntries, *checker.MaxEntries)
+		}
+	}
+	if checker.MapFlags != nil {
+		if *checker.MapFlags != event.MapFlags {
+			return fmt.Errorf("ProcessBpfMapCreateChecker: MapFlags has value %d which does not match expected value %d", event.MapFlags, *checker.MapFlags)
+		}
+	}
+	return nil
+}
+
+// WithProcess adds a Process check to the ProcessBpfMapCreateChecker
+func (checker *ProcessBpfMapCreateChecker) WithProcess(check *ProcessChecker) *ProcessBpfMapCreateChecker {
+	checker.Process = check
+	return checker
+}
+
+// WithParent adds a Parent check to the ProcessBpfMapCreateChecker
+func (checker *ProcessBpfMapCreateChecker) WithParent(check *ProcessChecker) *ProcessBpfMapCreateChecker {
+	checker.Parent = check
+	return checker
+}
+
+// WithMapType adds a MapType check to the ProcessBpfMapCreateChecker
+func (checker *ProcessBpfMapCreateChecker) WithMapType(check *stringmatcher.StringMatcher) *ProcessBpfMapCreateChecker {
+	checker.MapType = check
+	return checker
+}
+
+// WithName adds a Name check to the ProcessBpfMapCreateChecker
+func (checker *ProcessBpfMapCreateChecker) WithName(check *stringmatcher.StringMatcher) *ProcessBpfMapCreateChecker {
+	checker.Name = check
+	return checker
+}
+
+// WithKeySize adds a KeySize check to the ProcessBpfMapCreateChecker
+func (checker *ProcessBpfMapCreateChecker) WithKeySize(check uint32) *ProcessBpfMapCreateChecker {
+	checker.KeySize = &check
+	return checker
+}
+
+// WithValueSize adds a ValueSize check to the ProcessBpfMapCreateChecker
+func (checker *ProcessBpfMapCreateChecker) WithValueSize(check uint32) *ProcessBpfMapCreateChecker {
+	checker.ValueSize = &check
+	return checker
+}
+
+// WithMaxEntries adds a MaxEntries check to the ProcessBpfMapCreateChecker
+func (checker *ProcessBpfMapCreateChecker) WithMaxEntries(check uint32) *ProcessBpfMapCreateChecker {
+	checker.MaxEntries = &check
+	return checker
+}
+
+// WithMapFlags adds a MapFlags check to the ProcessBpfMapCreateChecker
+func (checker *ProcessBpfMapCreateChecker) WithMapFlags(check uint32) *ProcessBpfMapCreateChecker {
+	checker.MapFlags = &check
+	return checker
+}
+
+//FromProcessBpfMapCreate populates the ProcessBpfMapCreateChecker using data from a ProcessBpfMapCreate event
+func (checker *ProcessBpfMapCreateChecker) FromProcessBpfMapCreate(event *tetragon.ProcessBpfMapCreate) *ProcessBpfMapCreateChecker {
+	if event == nil {
+		return checker
+	}
+	if event.Process != nil {
+		checker.Process = NewProcessChecker().FromProcess(event.Process)
+	}
+	if event.Parent != nil {
+		checker.Parent = NewProcessChecker().FromProcess(event.Parent)
+	}
+	checker.MapType = stringmatcher.Full(event.MapType)
+	checker.Name = stringmatcher.Full(event.Name)
+	{
+		val := event.KeySize
+		checker.KeySize = &val
+	}
+	{
+		val := event.ValueSize
+		checker.ValueSize = &val
+	}
+	{
+		val := event.MaxEntries
+		checker.MaxEntries = &val
+	}
+	{
+		val := event.MapFlags
+		checker.MapFlags = &val
+	}
+	return checker
+}
+
+// ProcessMountChecker implements a checker struct to check a ProcessMount event
+type ProcessMountChecker struct {
+	Process      *ProcessChecker              `json:"process,omitempty"`
+	Parent       *ProcessChecker              `json:"parent,omitempty"`
+	Operation    *MountOperationChecker       `json:"operation,omitempty"`
+	Source       *stringmatcher.StringMatcher `json:"source,omitempty"`
+	Target       *stringmatcher.StringMatcher `json:"target,omitempty"`
+	TargetFlags  *stringmatcher.StringMatcher `json:"targetFlags,omitempty"`
+	Fstype       *stringmatcher.StringMatcher `json:"fstype,omitempty"`
+	Flags        *uint64                      `json:"flags,omitempty"`
+	MntNamespace *NamespaceChecker            `json:"mntNamespace,omitempty"`
+}
+
+// CheckEvent checks a single event and implements the EventChecker interface
+func (checker *ProcessMountChecker) CheckEvent(event Event) error {
+	if ev, ok := event.(*tetragon.ProcessMount); ok {
+		return checker.Check(ev)
+	}
+	return fmt.Errorf("%T is not a ProcessMount event", event)
+}
+
+// CheckResponse checks a single gRPC response and implements the EventChecker interface
+func (checker *ProcessMountChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
+	event, err := EventFromResponse(response)
+	if err != nil {
+		return err
+	}
+	return checker.CheckEvent(event)
+}
+
+// NewProcessMountChecker creates a new ProcessMountChecker
+func NewProcessMountChecker() *ProcessMountChecker {
+	return &ProcessMountChecker{}
+}
+
+// Check checks a ProcessMount event
+func (checker *ProcessMountChecker) Check(event *tetragon.ProcessMount) error {
+	if event == nil {
+		return fmt.Errorf("ProcessMountChecker: ProcessMount event is nil")
+	}
+
+	if checker.Process != nil {
+		if err := checker.Process.Check(event.Process); err != nil {
+			return fmt.Errorf("ProcessMountChecker: Process check failed: %w", err)
+		}
+	}
+	if checker.Parent != nil {
+		if err := checker.Parent.Check(event.Parent); err != nil {
+			return fmt.Errorf("ProcessMountChecker: Parent check failed: %w", err)
+		}
+	}
+	if checker.Operation != nil {
+		if err := checker.Operation.Check(&event.Operation); err != nil {
+			return fmt.Errorf("ProcessMountChecker: Operation check failed: %w", err)
+		}
+	}
+	if checker.Source != nil {
+		if err := checker.Source.Match(event.Source); err != nil {
+			return fmt.Errorf("ProcessMountChecker: Source check failed: %w", err)
+		}
+	}
+	if checker.Target != nil {
+		if err := checker.Target.Match(event.Target); err != nil {
+			return fmt.Errorf("ProcessMountChecker: Target check failed: %w", err)
 		}
 	}
-	if checker.Subsys != nil {
-		if err := checker.Subsys.Match(event.Subsys); err != nil {
-			return fmt.Errorf("ProcessTracepointChecker: Subsys check failed: %w", err)
+	if checker.TargetFlags != nil {
+		if err := checker.TargetFlags.Match(event.TargetFlags); err != nil {
+			return fmt.Errorf("ProcessMountChecker: TargetFlags check failed: %w", err)
 		}
 	}
-	if checker.Event != nil {
-		if err := checker.Event.Match(event.Event); err != nil {
-			return fmt.Errorf("ProcessTracepointChecker: Event check failed: %w", err)
+	if checker.Fstype != nil {
+		if err := checker.Fstype.Match(event.Fstype); err != nil {
+			return fmt.Errorf("ProcessMountChecker: Fstype check failed: %w", err)
 		}
 	}
-	if checker.Args != nil {
-		if err := checker.Args.Check(event.Args); err != nil {
-			return fmt.Errorf("ProcessTracepointChecker: Args check failed: %w", err)
+	if checker.Flags != nil {
+		if *checker.Flags != event.Flags {
+			return fmt.Errorf("ProcessMountChecker: Flags has value %d which does not match expected value %d", event.Flags, *checker.Flags)
+		}
+	}
+	if checker.MntNamespace != nil {
+		if err := checker.MntNamespace.Check(event.MntNamespace); err != nil {
+			return fmt.Errorf("ProcessMountChecker: MntNamespace check failed: %w", err)
 		}
 	}
 	return nil
 }
 
-// WithProcess adds a Process check to the ProcessTracepointChecker
-func (checker *ProcessTracepointChecker) WithProcess(check *ProcessChecker) *ProcessTracepointChecker {
+// WithProcess adds a Process check to the ProcessMountChecker
+func (checker *ProcessMountChecker) WithProcess(check *ProcessChecker) *ProcessMountChecker {
 	checker.Process = check
 	return checker
 }
 
-// WithParent adds a Parent check to the ProcessTracepointChecker
-func (checker *ProcessTracepointChecker) WithParent(check *ProcessChecker) *ProcessTracepointChecker {
+// WithParent adds a Parent check to the ProcessMountChecker
+func (checker *ProcessMountChecker) WithParent(check *ProcessChecker) *ProcessMountChecker {
 	checker.Parent = check
 	return checker
 }
 
-// WithSubsys adds a Subsys check to the ProcessTracepointChecker
-func (checker *ProcessTracepointChecker) WithSubsys(check *stringmatcher.StringMatcher) *ProcessTracepointChecker {
-	checker.Subsys = check
+// WithOperation adds a Operation check to the ProcessMountChecker
+func (checker *ProcessMountChecker) WithOperation(check tetragon.MountOperation) *ProcessMountChecker {
+	wrappedCheck := MountOperationChecker(check)
+	checker.Operation = &wrappedCheck
 	return checker
 }
 
-// WithEvent adds a Event check to the ProcessTracepointChecker
-func (checker *ProcessTracepointChecker) WithEvent(check *stringmatcher.StringMatcher) *ProcessTracepointChecker {
-	checker.Event = check
+// WithSource adds a Source check to the ProcessMountChecker
+func (checker *ProcessMountChecker) WithSource(check *stringmatcher.StringMatcher) *ProcessMountChecker {
+	checker.Source = check
 	return checker
 }
 
-// WithArgs adds a Args check to the ProcessTracepointChecker
-func (checker *ProcessTracepointChecker) WithArgs(check *KprobeArgumentListMatcher) *ProcessTracepointChecker {
-	checker.Args = check
+// WithTarget adds a Target check to the ProcessMountChecker
+func (checker *ProcessMountChecker) WithTarget(check *stringmatcher.StringMatcher) *ProcessMountChecker {
+	checker.Target = check
 	return checker
 }
 
-//FromProcessTracepoint populates the ProcessTracepointChecker using data from a ProcessTracepoint event
-func (checker *ProcessTracepointChecker) FromProcessTracepoint(event *tetragon.ProcessTracepoint) *ProcessTracepointChecker {
+// WithTargetFlags adds a TargetFlags check to the ProcessMountChecker
+func (checker *ProcessMountChecker) WithTargetFlags(check *stringmatcher.StringMatcher) *ProcessMountChecker {
+	checker.TargetFlags = check
+	return checker
+}
+
+// WithFstype adds a Fstype check to the ProcessMountChecker
+func (checker *ProcessMountChecker) WithFstype(check *stringmatcher.StringMatcher) *ProcessMountChecker {
+	checker.Fstype = check
+	return checker
+}
+
+// WithFlags adds a Flags check to the ProcessMountChecker
+func (checker *ProcessMountChecker) WithFlags(check uint64) *ProcessMountChecker {
+	checker.Flags = &check
+	return checker
+}
+
+// WithMntNamespace adds a MntNamespace check to the ProcessMountChecker
+func (checker *ProcessMountChecker) WithMntNamespace(check *NamespaceChecker) *ProcessMountChecker {
+	checker.MntNamespace = check
+	return checker
+}
+
+//FromProcessMount populates the ProcessMountChecker using data from a ProcessMount event
+func (checker *ProcessMountChecker) FromProcessMount(event *tetragon.ProcessMount) *ProcessMountChecker {
 	if event == nil {
 		return checker
 	}
@@ -965,20 +2240,17 @@ func (checker *ProcessTracepointChecker) FromProcessTracepoint(event *tetragon.P
 	if event.Parent != nil {
 		checker.Parent = NewProcessChecker().FromProcess(event.Parent)
 	}
-	checker.Subsys = stringmatcher.Full(event.Subsys)
-	checker.Event = stringmatcher.Full(event.Event)
+	checker.Operation = NewMountOperationChecker(event.Operation)
+	checker.Source = stringmatcher.Full(event.Source)
+	checker.Target = stringmatcher.Full(event.Target)
+	checker.TargetFlags = stringmatcher.Full(event.TargetFlags)
+	checker.Fstype = stringmatcher.Full(event.Fstype)
 	{
-		var checks []*KprobeArgumentChecker
-		for _, check := range event.Args {
-			var convertedCheck *KprobeArgumentChecker
-			if check != nil {
-				convertedCheck = NewKprobeArgumentChecker().FromKprobeArgument(check)
-			}
-			checks = append(checks, convertedCheck)
-		}
-		lm := NewKprobeArgumentListMatcher().WithOperator(listmatcher.Ordered).
-			WithValues(checks...)
-		checker.Args = lm
+		val := event.Flags
+		checker.Flags = &val
+	}
+	if event.MntNamespace != nil {
+		checker.MntNamespace = NewNamespaceChecker().FromNamespace(event.MntNamespace)
 	}
 	return checker
 }
@@ -1090,6 +2362,146 @@ func (checker *TestChecker) FromTest(event *tetragon.Test) *TestChecker {
 	return checker
 }
 
+// EventsLostChecker implements a checker struct to check a EventsLost event
+type EventsLostChecker struct {
+	Count          *uint64                      `json:"count,omitempty"`
+	OverflowPolicy *stringmatcher.StringMatcher `json:"overflowPolicy,omitempty"`
+}
+
+// CheckEvent checks a single event and implements the EventChecker interface
+func (checker *EventsLostChecker) CheckEvent(event Event) error {
+	if ev, ok := event.(*tetragon.EventsLost); ok {
+		return checker.Check(ev)
+	}
+	return fmt.Errorf("%T is not a EventsLost event", event)
+}
+
+// CheckResponse checks a single gRPC response and implements the EventChecker interface
+func (checker *EventsLostChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
+	event, err := EventFromResponse(response)
+	if err != nil {
+		return err
+	}
+	return checker.CheckEvent(event)
+}
+
+// NewEventsLostChecker creates a new EventsLostChecker
+func NewEventsLostChecker() *EventsLostChecker {
+	return &EventsLostChecker{}
+}
+
+// Check checks a EventsLost event
+func (checker *EventsLostChecker) Check(event *tetragon.EventsLost) error {
+	if event == nil {
+		return fmt.Errorf("EventsLostChecker: EventsLost event is nil")
+	}
+
+	if checker.Count != nil {
+		if *checker.Count != event.Count {
+			return fmt.Errorf("EventsLostChecker: Count has value %d which does not match expected value %d", event.Count, *checker.Count)
+		}
+	}
+	if checker.OverflowPolicy != nil {
+		if err := checker.OverflowPolicy.Match(event.OverflowPolicy); err != nil {
+			return fmt.Errorf("EventsLostChecker: OverflowPolicy check failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// WithCount adds a Count check to the EventsLostChecker
+func (checker *EventsLostChecker) WithCount(check uint64) *EventsLostChecker {
+	checker.Count = &check
+	return checker
+}
+
+// WithOverflowPolicy adds a OverflowPolicy check to the EventsLostChecker
+func (checker *EventsLostChecker) WithOverflowPolicy(check *stringmatcher.StringMatcher) *EventsLostChecker {
+	checker.OverflowPolicy = check
+	return checker
+}
+
+//FromEventsLost populates the EventsLostChecker using data from a EventsLost event
+func (checker *EventsLostChecker) FromEventsLost(event *tetragon.EventsLost) *EventsLostChecker {
+	if event == nil {
+		return checker
+	}
+	{
+		val := event.Count
+		checker.Count = &val
+	}
+	checker.OverflowPolicy = stringmatcher.Full(event.OverflowPolicy)
+	return checker
+}
+
+// StackAddressChecker implements a checker struct to check a StackAddress field
+type StackAddressChecker struct {
+	Address *uint64                      `json:"address,omitempty"`
+	Symbol  *stringmatcher.StringMatcher `json:"symbol,omitempty"`
+	Module  *stringmatcher.StringMatcher `json:"module,omitempty"`
+}
+
+// NewStackAddressChecker creates a new StackAddressChecker
+func NewStackAddressChecker() *StackAddressChecker {
+	return &StackAddressChecker{}
+}
+
+// Check checks a StackAddress field
+func (checker *StackAddressChecker) Check(event *tetragon.StackAddress) error {
+	if event == nil {
+		return fmt.Errorf("StackAddressChecker: StackAddress field is nil")
+	}
+
+	if checker.Address != nil {
+		if *checker.Address != event.Address {
+			return fmt.Errorf("StackAddressChecker: Address has value %d which does not match expected value %d", event.Address, *checker.Address)
+		}
+	}
+	if checker.Symbol != nil {
+		if err := checker.Symbol.Match(event.Symbol); err != nil {
+			return fmt.Errorf("StackAddressChecker: Symbol check failed: %w", err)
+		}
+	}
+	if checker.Module != nil {
+		if err := checker.Module.Match(event.Module); err != nil {
+			return fmt.Errorf("StackAddressChecker: Module check failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// WithAddress adds a Address check to the StackAddressChecker
+func (checker *StackAddressChecker) WithAddress(check uint64) *StackAddressChecker {
+	checker.Address = &check
+	return checker
+}
+
+// WithSymbol adds a Symbol check to the StackAddressChecker
+func (checker *StackAddressChecker) WithSymbol(check *stringmatcher.StringMatcher) *StackAddressChecker {
+	checker.Symbol = check
+	return checker
+}
+
+// WithModule adds a Module check to the StackAddressChecker
+func (checker *StackAddressChecker) WithModule(check *stringmatcher.StringMatcher) *StackAddressChecker {
+	checker.Module = check
+	return checker
+}
+
+//FromStackAddress populates the StackAddressChecker using data from a StackAddress field
+func (checker *StackAddressChecker) FromStackAddress(event *tetragon.StackAddress) *StackAddressChecker {
+	if event == nil {
+		return checker
+	}
+	{
+		val := event.Address
+		checker.Address = &val
+	}
+	checker.Symbol = stringmatcher.Full(event.Symbol)
+	checker.Module = stringmatcher.Full(event.Module)
+	return checker
+}
+
 // ImageChecker implements a checker struct to check a Image field
 type ImageChecker struct {
 	Id   *stringmatcher.StringMatcher `json:"id,omitempty"`
@@ -1759,97 +3171,303 @@ func (checker *NamespacesChecker) WithPid(check *NamespaceChecker) *NamespacesCh
 	return checker
 }
 
-// WithPidForChildren adds a PidForChildren check to the NamespacesChecker
-func (checker *NamespacesChecker) WithPidForChildren(check *NamespaceChecker) *NamespacesChecker {
-	checker.PidForChildren = check
+// WithPidForChildren adds a PidForChildren check to the NamespacesChecker
+func (checker *NamespacesChecker) WithPidForChildren(check *NamespaceChecker) *NamespacesChecker {
+	checker.PidForChildren = check
+	return checker
+}
+
+// WithNet adds a Net check to the NamespacesChecker
+func (checker *NamespacesChecker) WithNet(check *NamespaceChecker) *NamespacesChecker {
+	checker.Net = check
+	return checker
+}
+
+// WithTime adds a Time check to the NamespacesChecker
+func (checker *NamespacesChecker) WithTime(check *NamespaceChecker) *NamespacesChecker {
+	checker.Time = check
+	return checker
+}
+
+// WithTimeForChildren adds a TimeForChildren check to the NamespacesChecker
+func (checker *NamespacesChecker) WithTimeForChildren(check *NamespaceChecker) *NamespacesChecker {
+	checker.TimeForChildren = check
+	return checker
+}
+
+// WithCgroup adds a Cgroup check to the NamespacesChecker
+func (checker *NamespacesChecker) WithCgroup(check *NamespaceChecker) *NamespacesChecker {
+	checker.Cgroup = check
+	return checker
+}
+
+// WithUser adds a User check to the NamespacesChecker
+func (checker *NamespacesChecker) WithUser(check *NamespaceChecker) *NamespacesChecker {
+	checker.User = check
+	return checker
+}
+
+//FromNamespaces populates the NamespacesChecker using data from a Namespaces field
+func (checker *NamespacesChecker) FromNamespaces(event *tetragon.Namespaces) *NamespacesChecker {
+	if event == nil {
+		return checker
+	}
+	if event.Uts != nil {
+		checker.Uts = NewNamespaceChecker().FromNamespace(event.Uts)
+	}
+	if event.Ipc != nil {
+		checker.Ipc = NewNamespaceChecker().FromNamespace(event.Ipc)
+	}
+	if event.Mnt != nil {
+		checker.Mnt = NewNamespaceChecker().FromNamespace(event.Mnt)
+	}
+	if event.Pid != nil {
+		checker.Pid = NewNamespaceChecker().FromNamespace(event.Pid)
+	}
+	if event.PidForChildren != nil {
+		checker.PidForChildren = NewNamespaceChecker().FromNamespace(event.PidForChildren)
+	}
+	if event.Net != nil {
+		checker.Net = NewNamespaceChecker().FromNamespace(event.Net)
+	}
+	if event.Time != nil {
+		checker.Time = NewNamespaceChecker().FromNamespace(event.Time)
+	}
+	if event.TimeForChildren != nil {
+		checker.TimeForChildren = NewNamespaceChecker().FromNamespace(event.TimeForChildren)
+	}
+	if event.Cgroup != nil {
+		checker.Cgroup = NewNamespaceChecker().FromNamespace(event.Cgroup)
+	}
+	if event.User != nil {
+		checker.User = NewNamespaceChecker().FromNamespace(event.User)
+	}
+	return checker
+}
+
+// BinaryPropertiesChecker implements a checker struct to check a BinaryProperties field
+type BinaryPropertiesChecker struct {
+	Sha256  *stringmatcher.StringMatcher `json:"sha256,omitempty"`
+	Inode   *uint64                      `json:"inode,omitempty"`
+	Dev     *uint64                      `json:"dev,omitempty"`
+	Uid     *uint32                      `json:"uid,omitempty"`
+	Gid     *uint32                      `json:"gid,omitempty"`
+	Mode    *uint32                      `json:"mode,omitempty"`
+	Size    *uint64                      `json:"size,omitempty"`
+	Setuid  *bool                        `json:"setuid,omitempty"`
+	Setgid  *bool                        `json:"setgid,omitempty"`
+	Deleted *bool                        `json:"deleted,omitempty"`
+	Memfd   *bool                        `json:"memfd,omitempty"`
+}
+
+// NewBinaryPropertiesChecker creates a new BinaryPropertiesChecker
+func NewBinaryPropertiesChecker() *BinaryPropertiesChecker {
+	return &BinaryPropertiesChecker{}
+}
+
+// Check checks a BinaryProperties field
+func (checker *BinaryPropertiesChecker) Check(event *tetragon.BinaryProperties) error {
+	if event == nil {
+		return fmt.Errorf("BinaryPropertiesChecker: BinaryProperties field is nil")
+	}
+
+	if checker.Sha256 != nil {
+		if err := checker.Sha256.Match(event.Sha256); err != nil {
+			return fmt.Errorf("BinaryPropertiesChecker: Sha256 check failed: %w", err)
+		}
+	}
+	if checker.Inode != nil {
+		if *checker.Inode != event.Inode {
+			return fmt.Errorf("BinaryPropertiesChecker: Inode has value %d which does not match expected value %d", event.Inode, *checker.Inode)
+		}
+	}
+	if checker.Dev != nil {
+		if *checker.Dev != event.Dev {
+			return fmt.Errorf("BinaryPropertiesChecker: Dev has value %d which does not match expected value %d", event.Dev, *checker.Dev)
+		}
+	}
+	if checker.Uid != nil {
+		if event.Uid == nil {
+			return fmt.Errorf("BinaryPropertiesChecker: Uid is nil and does not match expected value %v", *checker.Uid)
+		}
+		if *checker.Uid != event.Uid.Value {
+			return fmt.Errorf("BinaryPropertiesChecker: Uid has value %v which does not match expected value %v", event.Uid.Value, *checker.Uid)
+		}
+	}
+	if checker.Gid != nil {
+		if event.Gid == nil {
+			return fmt.Errorf("BinaryPropertiesChecker: Gid is nil and does not match expected value %v", *checker.Gid)
+		}
+		if *checker.Gid != event.Gid.Value {
+			return fmt.Errorf("BinaryPropertiesChecker: Gid has value %v which does not match expected value %v", event.Gid.Value, *checker.Gid)
+		}
+	}
+	if checker.Mode != nil {
+		if *checker.Mode != event.Mode {
+			return fmt.Errorf("BinaryPropertiesChecker: Mode has value %d which does not match expected value %d", event.Mode, *checker.Mode)
+		}
+	}
+	if checker.Size != nil {
+		if *checker.Size != event.Size {
+			return fmt.Errorf("BinaryPropertiesChecker: Size has value %d which does not match expected value %d", event.Size, *checker.Size)
+		}
+	}
+	if checker.Setuid != nil {
+		if *checker.Setuid != event.Setuid {
+			return fmt.Errorf("BinaryPropertiesChecker: Setuid has value %t which does not match expected value %t", event.Setuid, *checker.Setuid)
+		}
+	}
+	if checker.Setgid != nil {
+		if *checker.Setgid != event.Setgid {
+			return fmt.Errorf("BinaryPropertiesChecker: Setgid has value %t which does not match expected value %t", event.Setgid, *checker.Setgid)
+		}
+	}
+	if checker.Deleted != nil {
+		if *checker.Deleted != event.Deleted {
+			return fmt.Errorf("BinaryPropertiesChecker: Deleted has value %t which does not match expected value %t", event.Deleted, *checker.Deleted)
+		}
+	}
+	if checker.Memfd != nil {
+		if *checker.Memfd != event.Memfd {
+			return fmt.Errorf("BinaryPropertiesChecker: Memfd has value %t which does not match expected value %t", event.Memfd, *checker.Memfd)
+		}
+	}
+	return nil
+}
+
+// WithSha256 adds a Sha256 check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithSha256(check *stringmatcher.StringMatcher) *BinaryPropertiesChecker {
+	checker.Sha256 = check
+	return checker
+}
+
+// WithInode adds a Inode check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithInode(check uint64) *BinaryPropertiesChecker {
+	checker.Inode = &check
+	return checker
+}
+
+// WithDev adds a Dev check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithDev(check uint64) *BinaryPropertiesChecker {
+	checker.Dev = &check
+	return checker
+}
+
+// WithUid adds a Uid check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithUid(check uint32) *BinaryPropertiesChecker {
+	checker.Uid = &check
+	return checker
+}
+
+// WithGid adds a Gid check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithGid(check uint32) *BinaryPropertiesChecker {
+	checker.Gid = &check
+	return checker
+}
+
+// WithMode adds a Mode check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithMode(check uint32) *BinaryPropertiesChecker {
+	checker.Mode = &check
 	return checker
 }
 
-// WithNet adds a Net check to the NamespacesChecker
-func (checker *NamespacesChecker) WithNet(check *NamespaceChecker) *NamespacesChecker {
-	checker.Net = check
+// WithSize adds a Size check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithSize(check uint64) *BinaryPropertiesChecker {
+	checker.Size = &check
 	return checker
 }
 
-// WithTime adds a Time check to the NamespacesChecker
-func (checker *NamespacesChecker) WithTime(check *NamespaceChecker) *NamespacesChecker {
-	checker.Time = check
+// WithSetuid adds a Setuid check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithSetuid(check bool) *BinaryPropertiesChecker {
+	checker.Setuid = &check
 	return checker
 }
 
-// WithTimeForChildren adds a TimeForChildren check to the NamespacesChecker
-func (checker *NamespacesChecker) WithTimeForChildren(check *NamespaceChecker) *NamespacesChecker {
-	checker.TimeForChildren = check
+// WithSetgid adds a Setgid check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithSetgid(check bool) *BinaryPropertiesChecker {
+	checker.Setgid = &check
 	return checker
 }
 
-// WithCgroup adds a Cgroup check to the NamespacesChecker
-func (checker *NamespacesChecker) WithCgroup(check *NamespaceChecker) *NamespacesChecker {
-	checker.Cgroup = check
+// WithDeleted adds a Deleted check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithDeleted(check bool) *BinaryPropertiesChecker {
+	checker.Deleted = &check
 	return checker
 }
 
-// WithUser adds a User check to the NamespacesChecker
-func (checker *NamespacesChecker) WithUser(check *NamespaceChecker) *NamespacesChecker {
-	checker.User = check
+// WithMemfd adds a Memfd check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithMemfd(check bool) *BinaryPropertiesChecker {
+	checker.Memfd = &check
 	return checker
 }
 
-//FromNamespaces populates the NamespacesChecker using data from a Namespaces field
-func (checker *NamespacesChecker) FromNamespaces(event *tetragon.Namespaces) *NamespacesChecker {
+//FromBinaryProperties populates the BinaryPropertiesChecker using data from a BinaryProperties field
+func (checker *BinaryPropertiesChecker) FromBinaryProperties(event *tetragon.BinaryProperties) *BinaryPropertiesChecker {
 	if event == nil {
 		return checker
 	}
-	if event.Uts != nil {
-		checker.Uts = NewNamespaceChecker().FromNamespace(event.Uts)
+	checker.Sha256 = stringmatcher.Full(event.Sha256)
+	{
+		val := event.Inode
+		checker.Inode = &val
 	}
-	if event.Ipc != nil {
-		checker.Ipc = NewNamespaceChecker().FromNamespace(event.Ipc)
+	{
+		val := event.Dev
+		checker.Dev = &val
 	}
-	if event.Mnt != nil {
-		checker.Mnt = NewNamespaceChecker().FromNamespace(event.Mnt)
+	if event.Uid != nil {
+		val := event.Uid.Value
+		checker.Uid = &val
 	}
-	if event.Pid != nil {
-		checker.Pid = NewNamespaceChecker().FromNamespace(event.Pid)
+	if event.Gid != nil {
+		val := event.Gid.Value
+		checker.Gid = &val
 	}
-	if event.PidForChildren != nil {
-		checker.PidForChildren = NewNamespaceChecker().FromNamespace(event.PidForChildren)
+	{
+		val := event.Mode
+		checker.Mode = &val
 	}
-	if event.Net != nil {
-		checker.Net = NewNamespaceChecker().FromNamespace(event.Net)
+	{
+		val := event.Size
+		checker.Size = &val
 	}
-	if event.Time != nil {
-		checker.Time = NewNamespaceChecker().FromNamespace(event.Time)
+	{
+		val := event.Setuid
+		checker.Setuid = &val
 	}
-	if event.TimeForChildren != nil {
-		checker.TimeForChildren = NewNamespaceChecker().FromNamespace(event.TimeForChildren)
+	{
+		val := event.Setgid
+		checker.Setgid = &val
 	}
-	if event.Cgroup != nil {
-		checker.Cgroup = NewNamespaceChecker().FromNamespace(event.Cgroup)
+	{
+		val := event.Deleted
+		checker.Deleted = &val
 	}
-	if event.User != nil {
-		checker.User = NewNamespaceChecker().FromNamespace(event.User)
+	{
+		val := event.Memfd
+		checker.Memfd = &val
 	}
 	return checker
 }
 
 // ProcessChecker implements a checker struct to check a Process field
 type ProcessChecker struct {
-	ExecId       *stringmatcher.StringMatcher       `json:"execId,omitempty"`
-	Pid          *uint32                            `json:"pid,omitempty"`
-	Uid          *uint32                            `json:"uid,omitempty"`
-	Cwd          *stringmatcher.StringMatcher       `json:"cwd,omitempty"`
-	Binary       *stringmatcher.StringMatcher       `json:"binary,omitempty"`
-	Arguments    *stringmatcher.StringMatcher       `json:"arguments,omitempty"`
-	Flags        *stringmatcher.StringMatcher       `json:"flags,omitempty"`
-	StartTime    *timestampmatcher.TimestampMatcher `json:"startTime,omitempty"`
-	Auid         *uint32                            `json:"auid,omitempty"`
-	Pod          *PodChecker                        `json:"pod,omitempty"`
-	Docker       *stringmatcher.StringMatcher       `json:"docker,omitempty"`
-	ParentExecId *stringmatcher.StringMatcher       `json:"parentExecId,omitempty"`
-	Refcnt       *uint32                            `json:"refcnt,omitempty"`
-	Cap          *CapabilitiesChecker               `json:"cap,omitempty"`
-	Ns           *NamespacesChecker                 `json:"ns,omitempty"`
+	ExecId           *stringmatcher.StringMatcher       `json:"execId,omitempty"`
+	Pid              *uint32                            `json:"pid,omitempty"`
+	Uid              *uint32                            `json:"uid,omitempty"`
+	Cwd              *stringmatcher.StringMatcher       `json:"cwd,omitempty"`
+	Binary           *stringmatcher.StringMatcher       `json:"binary,omitempty"`
+	Arguments        *stringmatcher.StringMatcher       `json:"arguments,omitempty"`
+	Flags            *stringmatcher.StringMatcher       `json:"flags,omitempty"`
+	StartTime        *timestampmatcher.TimestampMatcher `json:"startTime,omitempty"`
+	Auid             *uint32                            `json:"auid,omitempty"`
+	Pod              *PodChecker                        `json:"pod,omitempty"`
+	Docker           *stringmatcher.StringMatcher       `json:"docker,omitempty"`
+	ParentExecId     *stringmatcher.StringMatcher       `json:"parentExecId,omitempty"`
+	Refcnt           *uint32                            `json:"refcnt,omitempty"`
+	Cap              *CapabilitiesChecker               `json:"cap,omitempty"`
+	Ns               *NamespacesChecker                 `json:"ns,omitempty"`
+	BinaryProperties *BinaryPropertiesChecker           `json:"binaryProperties,omitempty"`
 }
 
 // NewProcessChecker creates a new ProcessChecker
@@ -1947,6 +3565,11 @@ func (checker *ProcessChecker) Check(event *tetragon.Process) error {
 			return fmt.Errorf("ProcessChecker: Ns check failed: %w", err)
 		}
 	}
+	if checker.BinaryProperties != nil {
+		if err := checker.BinaryProperties.Check(event.BinaryProperties); err != nil {
+			return fmt.Errorf("ProcessChecker: BinaryProperties check failed: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -2040,6 +3663,12 @@ func (checker *ProcessChecker) WithNs(check *NamespacesChecker) *ProcessChecker
 	return checker
 }
 
+// WithBinaryProperties adds a BinaryProperties check to the ProcessChecker
+func (checker *ProcessChecker) WithBinaryProperties(check *BinaryPropertiesChecker) *ProcessChecker {
+	checker.BinaryProperties = check
+	return checker
+}
+
 //FromProcess populates the ProcessChecker using data from a Process field
 func (checker *ProcessChecker) FromProcess(event *tetragon.Process) *ProcessChecker {
 	if event == nil {
@@ -2079,6 +3708,9 @@ func (checker *ProcessChecker) FromProcess(event *tetragon.Process) *ProcessChec
 	if event.Ns != nil {
 		checker.Ns = NewNamespacesChecker().FromNamespaces(event.Ns)
 	}
+	if event.BinaryProperties != nil {
+		checker.BinaryProperties = NewBinaryPropertiesChecker().FromBinaryProperties(event.BinaryProperties)
+	}
 	return checker
 }
 
@@ -2880,6 +4512,7 @@ type KprobeArgumentChecker struct {
 	LongArg           *int64                       `json:"longArg,omitempty"`
 	BpfAttrArg        *KprobeBpfAttrChecker        `json:"bpfAttrArg,omitempty"`
 	PerfEventArg      *KprobePerfEventChecker      `json:"perfEventArg,omitempty"`
+	Label             *stringmatcher.StringMatcher `json:"label,omitempty"`
 }
 
 // NewKprobeArgumentChecker creates a new KprobeArgumentChecker
@@ -3023,6 +4656,11 @@ func (checker *KprobeArgumentChecker) Check(event *tetragon.KprobeArgument) erro
 			return fmt.Errorf("KprobeArgumentChecker: PerfEventArg check failed: %T is not a PerfEventArg", event)
 		}
 	}
+	if checker.Label != nil {
+		if err := checker.Label.Match(event.Label); err != nil {
+			return fmt.Errorf("KprobeArgumentChecker: Label check failed: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -3104,6 +4742,12 @@ func (checker *KprobeArgumentChecker) WithPerfEventArg(check *KprobePerfEventChe
 	return checker
 }
 
+// WithLabel adds a Label check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithLabel(check *stringmatcher.StringMatcher) *KprobeArgumentChecker {
+	checker.Label = check
+	return checker
+}
+
 //FromKprobeArgument populates the KprobeArgumentChecker using data from a KprobeArgument field
 func (checker *KprobeArgumentChecker) FromKprobeArgument(event *tetragon.KprobeArgument) *KprobeArgumentChecker {
 	if event == nil {
@@ -3186,6 +4830,285 @@ func (checker *KprobeArgumentChecker) FromKprobeArgument(event *tetragon.KprobeA
 			checker.PerfEventArg = NewKprobePerfEventChecker().FromKprobePerfEvent(event.PerfEventArg)
 		}
 	}
+	checker.Label = stringmatcher.Full(event.Label)
+	return checker
+}
+
+// ProcessCredentialsChecker implements a checker struct to check a ProcessCredentials field
+type ProcessCredentialsChecker struct {
+	Uid        *uint32              `json:"uid,omitempty"`
+	Gid        *uint32              `json:"gid,omitempty"`
+	Euid       *uint32              `json:"euid,omitempty"`
+	Egid       *uint32              `json:"egid,omitempty"`
+	Suid       *uint32              `json:"suid,omitempty"`
+	Sgid       *uint32              `json:"sgid,omitempty"`
+	Fsuid      *uint32              `json:"fsuid,omitempty"`
+	Fsgid      *uint32              `json:"fsgid,omitempty"`
+	Caps       *CapabilitiesChecker `json:"caps,omitempty"`
+	Securebits *uint32              `json:"securebits,omitempty"`
+}
+
+// NewProcessCredentialsChecker creates a new ProcessCredentialsChecker
+func NewProcessCredentialsChecker() *ProcessCredentialsChecker {
+	return &ProcessCredentialsChecker{}
+}
+
+// Check checks a ProcessCredentials field
+func (checker *ProcessCredentialsChecker) Check(event *tetragon.ProcessCredentials) error {
+	if event == nil {
+		return fmt.Errorf("ProcessCredentialsChecker: ProcessCredentials field is nil")
+	}
+
+	if checker.Uid != nil {
+		if event.Uid == nil {
+			return fmt.Errorf("ProcessCredentialsChecker: Uid is nil and does not match expected value %v", *checker.Uid)
+		}
+		if *checker.Uid != event.Uid.Value {
+			return fmt.Errorf("ProcessCredentialsChecker: Uid has value %v which does not match expected value %v", event.Uid.Value, *checker.Uid)
+		}
+	}
+	if checker.Gid != nil {
+		if event.Gid == nil {
+			return fmt.Errorf("ProcessCredentialsChecker: Gid is nil and does not match expected value %v", *checker.Gid)
+		}
+		if *checker.Gid != event.Gid.Value {
+			return fmt.Errorf("ProcessCredentialsChecker: Gid has value %v which does not match expected value %v", event.Gid.Value, *checker.Gid)
+		}
+	}
+	if checker.Euid != nil {
+		if event.Euid == nil {
+			return fmt.Errorf("ProcessCredentialsChecker: Euid is nil and does not match expected value %v", *checker.Euid)
+		}
+		if *checker.Euid != event.Euid.Value {
+			return fmt.Errorf("ProcessCredentialsChecker: Euid has value %v which does not match expected value %v", event.Euid.Value, *checker.Euid)
+		}
+	}
+	if checker.Egid != nil {
+		if event.Egid == nil {
+			return fmt.Errorf("ProcessCredentialsChecker: Egid is nil and does not match expected value %v", *checker.Egid)
+		}
+		if *checker.Egid != event.Egid.Value {
+			return fmt.Errorf("ProcessCredentialsChecker: Egid has value %v which does not match expected value %v", event.Egid.Value, *checker.Egid)
+		}
+	}
+	if checker.Suid != nil {
+		if event.Suid == nil {
+			return fmt.Errorf("ProcessCredentialsChecker: Suid is nil and does not match expected value %v", *checker.Suid)
+		}
+		if *checker.Suid != event.Suid.Value {
+			return fmt.Errorf("ProcessCredentialsChecker: Suid has value %v which does not match expected value %v", event.Suid.Value, *checker.Suid)
+		}
+	}
+	if checker.Sgid != nil {
+		if event.Sgid == nil {
+			return fmt.Errorf("ProcessCredentialsChecker: Sgid is nil and does not match expected value %v", *checker.Sgid)
+		}
+		if *checker.Sgid != event.Sgid.Value {
+			return fmt.Errorf("ProcessCredentialsChecker: Sgid has value %v which does not match expected value %v", event.Sgid.Value, *checker.Sgid)
+		}
+	}
+	if checker.Fsuid != nil {
+		if event.Fsuid == nil {
+			return fmt.Errorf("ProcessCredentialsChecker: Fsuid is nil and does not match expected value %v", *checker.Fsuid)
+		}
+		if *checker.Fsuid != event.Fsuid.Value {
+			return fmt.Errorf("ProcessCredentialsChecker: Fsuid has value %v which does not match expected value %v", event.Fsuid.Value, *checker.Fsuid)
+		}
+	}
+	if checker.Fsgid != nil {
+		if event.Fsgid == nil {
+			return fmt.Errorf("ProcessCredentialsChecker: Fsgid is nil and does not match expected value %v", *checker.Fsgid)
+		}
+		if *checker.Fsgid != event.Fsgid.Value {
+			return fmt.Errorf("ProcessCredentialsChecker: Fsgid has value %v which does not match expected value %v", event.Fsgid.Value, *checker.Fsgid)
+		}
+	}
+	if checker.Caps != nil {
+		if err := checker.Caps.Check(event.Caps); err != nil {
+			return fmt.Errorf("ProcessCredentialsChecker: Caps check failed: %w", err)
+		}
+	}
+	if checker.Securebits != nil {
+		if *checker.Securebits != event.Securebits {
+			return fmt.Errorf("ProcessCredentialsChecker: Securebits has value %d which does not match expected value %d", event.Securebits, *checker.Securebits)
+		}
+	}
+	return nil
+}
+
+// WithUid adds a Uid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithUid(check uint32) *ProcessCredentialsChecker {
+	checker.Uid = &check
+	return checker
+}
+
+// WithGid adds a Gid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithGid(check uint32) *ProcessCredentialsChecker {
+	checker.Gid = &check
+	return checker
+}
+
+// WithEuid adds a Euid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithEuid(check uint32) *ProcessCredentialsChecker {
+	checker.Euid = &check
+	return checker
+}
+
+// WithEgid adds a Egid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithEgid(check uint32) *ProcessCredentialsChecker {
+	checker.Egid = &check
+	return checker
+}
+
+// WithSuid adds a Suid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithSuid(check uint32) *ProcessCredentialsChecker {
+	checker.Suid = &check
+	return checker
+}
+
+// WithSgid adds a Sgid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithSgid(check uint32) *ProcessCredentialsChecker {
+	checker.Sgid = &check
+	return checker
+}
+
+// WithFsuid adds a Fsuid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithFsuid(check uint32) *ProcessCredentialsChecker {
+	checker.Fsuid = &check
+	return checker
+}
+
+// WithFsgid adds a Fsgid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithFsgid(check uint32) *ProcessCredentialsChecker {
+	checker.Fsgid = &check
+	return checker
+}
+
+// WithCaps adds a Caps check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithCaps(check *CapabilitiesChecker) *ProcessCredentialsChecker {
+	checker.Caps = check
+	return checker
+}
+
+// WithSecurebits adds a Securebits check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithSecurebits(check uint32) *ProcessCredentialsChecker {
+	checker.Securebits = &check
+	return checker
+}
+
+//FromProcessCredentials populates the ProcessCredentialsChecker using data from a ProcessCredentials field
+func (checker *ProcessCredentialsChecker) FromProcessCredentials(event *tetragon.ProcessCredentials) *ProcessCredentialsChecker {
+	if event == nil {
+		return checker
+	}
+	if event.Uid != nil {
+		val := event.Uid.Value
+		checker.Uid = &val
+	}
+	if event.Gid != nil {
+		val := event.Gid.Value
+		checker.Gid = &val
+	}
+	if event.Euid != nil {
+		val := event.Euid.Value
+		checker.Euid = &val
+	}
+	if event.Egid != nil {
+		val := event.Egid.Value
+		checker.Egid = &val
+	}
+	if event.Suid != nil {
+		val := event.Suid.Value
+		checker.Suid = &val
+	}
+	if event.Sgid != nil {
+		val := event.Sgid.Value
+		checker.Sgid = &val
+	}
+	if event.Fsuid != nil {
+		val := event.Fsuid.Value
+		checker.Fsuid = &val
+	}
+	if event.Fsgid != nil {
+		val := event.Fsgid.Value
+		checker.Fsgid = &val
+	}
+	if event.Caps != nil {
+		checker.Caps = NewCapabilitiesChecker().FromCapabilities(event.Caps)
+	}
+	{
+		val := event.Securebits
+		checker.Securebits = &val
+	}
+	return checker
+}
+
+// KernelModuleChecker implements a checker struct to check a KernelModule field
+type KernelModuleChecker struct {
+	Name      *stringmatcher.StringMatcher  `json:"name,omitempty"`
+	Signature *KernelModuleSignatureChecker `json:"signature,omitempty"`
+	Taints    *uint64                       `json:"taints,omitempty"`
+}
+
+// NewKernelModuleChecker creates a new KernelModuleChecker
+func NewKernelModuleChecker() *KernelModuleChecker {
+	return &KernelModuleChecker{}
+}
+
+// Check checks a KernelModule field
+func (checker *KernelModuleChecker) Check(event *tetragon.KernelModule) error {
+	if event == nil {
+		return fmt.Errorf("KernelModuleChecker: KernelModule field is nil")
+	}
+
+	if checker.Name != nil {
+		if err := checker.Name.Match(event.Name); err != nil {
+			return fmt.Errorf("KernelModuleChecker: Name check failed: %w", err)
+		}
+	}
+	if checker.Signature != nil {
+		if err := checker.Signature.Check(&event.Signature); err != nil {
+			return fmt.Errorf("KernelModuleChecker: Signature check failed: %w", err)
+		}
+	}
+	if checker.Taints != nil {
+		if *checker.Taints != event.Taints {
+			return fmt.Errorf("KernelModuleChecker: Taints has value %d which does not match expected value %d", event.Taints, *checker.Taints)
+		}
+	}
+	return nil
+}
+
+// WithName adds a Name check to the KernelModuleChecker
+func (checker *KernelModuleChecker) WithName(check *stringmatcher.StringMatcher) *KernelModuleChecker {
+	checker.Name = check
+	return checker
+}
+
+// WithSignature adds a Signature check to the KernelModuleChecker
+func (checker *KernelModuleChecker) WithSignature(check tetragon.KernelModuleSignature) *KernelModuleChecker {
+	wrappedCheck := KernelModuleSignatureChecker(check)
+	checker.Signature = &wrappedCheck
+	return checker
+}
+
+// WithTaints adds a Taints check to the KernelModuleChecker
+func (checker *KernelModuleChecker) WithTaints(check uint64) *KernelModuleChecker {
+	checker.Taints = &check
+	return checker
+}
+
+//FromKernelModule populates the KernelModuleChecker using data from a KernelModule field
+func (checker *KernelModuleChecker) FromKernelModule(event *tetragon.KernelModule) *KernelModuleChecker {
+	if event == nil {
+		return checker
+	}
+	checker.Name = stringmatcher.Full(event.Name)
+	checker.Signature = NewKernelModuleSignatureChecker(event.Signature)
+	{
+		val := event.Taints
+		checker.Taints = &val
+	}
 	return checker
 }
 
@@ -3292,3 +5215,107 @@ func (enum *KprobeActionChecker) Check(val *tetragon.KprobeAction) error {
 	}
 	return nil
 }
+
+// KernelModuleSignatureChecker checks a tetragon.KernelModuleSignature
+type KernelModuleSignatureChecker tetragon.KernelModuleSignature
+
+// MarshalJSON implements json.Marshaler interface
+func (enum KernelModuleSignatureChecker) MarshalJSON() ([]byte, error) {
+	if name, ok := tetragon.KernelModuleSignature_name[int32(enum)]; ok {
+		name = strings.TrimPrefix(name, "KERNEL_MODULE_SIGNATURE_")
+		return json.Marshal(name)
+	}
+
+	return nil, fmt.Errorf("Unknown KernelModuleSignature %d", enum)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (enum *KernelModuleSignatureChecker) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := yaml.UnmarshalStrict(b, &str); err != nil {
+		return err
+	}
+
+	// Convert to uppercase if not already
+	str = strings.ToUpper(str)
+
+	// Look up the value from the enum values map
+	if n, ok := tetragon.KernelModuleSignature_value[str]; ok {
+		*enum = KernelModuleSignatureChecker(n)
+	} else if n, ok := tetragon.KernelModuleSignature_value["KERNEL_MODULE_SIGNATURE_"+str]; ok {
+		*enum = KernelModuleSignatureChecker(n)
+	} else {
+		return fmt.Errorf("Unknown KernelModuleSignature %s", str)
+	}
+
+	return nil
+}
+
+// NewKernelModuleSignatureChecker creates a new KernelModuleSignatureChecker
+func NewKernelModuleSignatureChecker(val tetragon.KernelModuleSignature) *KernelModuleSignatureChecker {
+	enum := KernelModuleSignatureChecker(val)
+	return &enum
+}
+
+// Check checks a KernelModuleSignature against the checker
+func (enum *KernelModuleSignatureChecker) Check(val *tetragon.KernelModuleSignature) error {
+	if val == nil {
+		return fmt.Errorf("KernelModuleSignatureChecker: KernelModuleSignature is nil and does not match expected value %s", tetragon.KernelModuleSignature(*enum))
+	}
+	if *enum != KernelModuleSignatureChecker(*val) {
+		return fmt.Errorf("KernelModuleSignatureChecker: KernelModuleSignature has value %s which does not match expected value %s", (*val), tetragon.KernelModuleSignature(*enum))
+	}
+	return nil
+}
+
+// MountOperationChecker checks a tetragon.MountOperation
+type MountOperationChecker tetragon.MountOperation
+
+// MarshalJSON implements json.Marshaler interface
+func (enum MountOperationChecker) MarshalJSON() ([]byte, error) {
+	if name, ok := tetragon.MountOperation_name[int32(enum)]; ok {
+		name = strings.TrimPrefix(name, "MOUNT_OPERATION_")
+		return json.Marshal(name)
+	}
+
+	return nil, fmt.Errorf("Unknown MountOperation %d", enum)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (enum *MountOperationChecker) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := yaml.UnmarshalStrict(b, &str); err != nil {
+		return err
+	}
+
+	// Convert to uppercase if not already
+	str = strings.ToUpper(str)
+
+	// Look up the value from the enum values map
+	if n, ok := tetragon.MountOperation_value[str]; ok {
+		*enum = MountOperationChecker(n)
+	} else if n, ok := tetragon.MountOperation_value["MOUNT_OPERATION_"+str]; ok {
+		*enum = MountOperationChecker(n)
+	} else {
+		return fmt.Errorf("Unknown MountOperation %s", str)
+	}
+
+	return nil
+}
+
+// NewMountOperationChecker creates a new MountOperationChecker
+func NewMountOperationChecker(val tetragon.MountOperation) *MountOperationChecker {
+	enum := MountOperationChecker(val)
+	return &enum
+}
+
+// Check checks a MountOperation against the checker
+func (enum *MountOperationChecker) Check(val *tetragon.MountOperation) error {
+	if val == nil {
+		return fmt.Errorf("MountOperationChecker: MountOperation is nil and does not match expected value %s", tetragon.MountOperation(*enum))
+	}
+	if *enum != MountOperationChecker(*val) {
+		return fmt.Errorf("MountOperationChecker: MountOperation has value %s which does not match expected value %s", (*val), tetragon.MountOperation(*enum))
+	}
+	return nil
+}
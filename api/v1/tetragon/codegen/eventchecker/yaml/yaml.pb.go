@@ -140,11 +140,18 @@ func (conf *EventCheckerConf) WriteYamlFile(file string) error {
 }
 
 type eventCheckerHelper struct {
-	ProcessExec       *eventchecker.ProcessExecChecker       `json:"exec,omitempty"`
-	ProcessExit       *eventchecker.ProcessExitChecker       `json:"exit,omitempty"`
-	ProcessKprobe     *eventchecker.ProcessKprobeChecker     `json:"kprobe,omitempty"`
-	ProcessTracepoint *eventchecker.ProcessTracepointChecker `json:"tracepoint,omitempty"`
-	Test              *eventchecker.TestChecker              `json:"test,omitempty"`
+	ProcessExec              *eventchecker.ProcessExecChecker              `json:"exec,omitempty"`
+	ProcessExit              *eventchecker.ProcessExitChecker              `json:"exit,omitempty"`
+	ProcessKprobe            *eventchecker.ProcessKprobeChecker            `json:"kprobe,omitempty"`
+	ProcessTracepoint        *eventchecker.ProcessTracepointChecker        `json:"tracepoint,omitempty"`
+	ProcessCredentialsChange *eventchecker.ProcessCredentialsChangeChecker `json:"credentialsChange,omitempty"`
+	ProcessNamespaceChange   *eventchecker.ProcessNamespaceChangeChecker   `json:"namespaceChange,omitempty"`
+	ProcessKernelModuleLoad  *eventchecker.ProcessKernelModuleLoadChecker  `json:"kernelModuleLoad,omitempty"`
+	ProcessBpfProgLoad       *eventchecker.ProcessBpfProgLoadChecker       `json:"bpfProgLoad,omitempty"`
+	ProcessBpfMapCreate      *eventchecker.ProcessBpfMapCreateChecker      `json:"bpfMapCreate,omitempty"`
+	ProcessMount             *eventchecker.ProcessMountChecker             `json:"mount,omitempty"`
+	Test                     *eventchecker.TestChecker                     `json:"test,omitempty"`
+	EventsLost               *eventchecker.EventsLostChecker               `json:"eventsLost,omitempty"`
 }
 
 // EventChecker is a wrapper around the EventChecker interface to help unmarshaling
@@ -183,12 +190,54 @@ func (checker *EventChecker) UnmarshalJSON(b []byte) error {
 		}
 		eventChecker = helper.ProcessTracepoint
 	}
+	if helper.ProcessCredentialsChange != nil {
+		if eventChecker != nil {
+			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.ProcessCredentialsChange, eventChecker)
+		}
+		eventChecker = helper.ProcessCredentialsChange
+	}
+	if helper.ProcessNamespaceChange != nil {
+		if eventChecker != nil {
+			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.ProcessNamespaceChange, eventChecker)
+		}
+		eventChecker = helper.ProcessNamespaceChange
+	}
+	if helper.ProcessKernelModuleLoad != nil {
+		if eventChecker != nil {
+			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.ProcessKernelModuleLoad, eventChecker)
+		}
+		eventChecker = helper.ProcessKernelModuleLoad
+	}
+	if helper.ProcessBpfProgLoad != nil {
+		if eventChecker != nil {
+			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.ProcessBpfProgLoad, eventChecker)
+		}
+		eventChecker = helper.ProcessBpfProgLoad
+	}
+	if helper.ProcessBpfMapCreate != nil {
+		if eventChecker != nil {
+			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.ProcessBpfMapCreate, eventChecker)
+		}
+		eventChecker = helper.ProcessBpfMapCreate
+	}
+	if helper.ProcessMount != nil {
+		if eventChecker != nil {
+			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.ProcessMount, eventChecker)
+		}
+		eventChecker = helper.ProcessMount
+	}
 	if helper.Test != nil {
 		if eventChecker != nil {
 			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.Test, eventChecker)
 		}
 		eventChecker = helper.Test
 	}
+	if helper.EventsLost != nil {
+		if eventChecker != nil {
+			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.EventsLost, eventChecker)
+		}
+		eventChecker = helper.EventsLost
+	}
 	checker.EventChecker = eventChecker
 	return nil
 }
@@ -205,8 +254,22 @@ func (checker EventChecker) MarshalJSON() ([]byte, error) {
 		helper.ProcessKprobe = c
 	case *eventchecker.ProcessTracepointChecker:
 		helper.ProcessTracepoint = c
+	case *eventchecker.ProcessCredentialsChangeChecker:
+		helper.ProcessCredentialsChange = c
+	case *eventchecker.ProcessNamespaceChangeChecker:
+		helper.ProcessNamespaceChange = c
+	case *eventchecker.ProcessKernelModuleLoadChecker:
+		helper.ProcessKernelModuleLoad = c
+	case *eventchecker.ProcessBpfProgLoadChecker:
+		helper.ProcessBpfProgLoad = c
+	case *eventchecker.ProcessBpfMapCreateChecker:
+		helper.ProcessBpfMapCreate = c
+	case *eventchecker.ProcessMountChecker:
+		helper.ProcessMount = c
 	case *eventchecker.TestChecker:
 		helper.Test = c
+	case *eventchecker.EventsLostChecker:
+		helper.EventsLost = c
 	default:
 		return nil, fmt.Errorf("EventChecker: unknown checker type %T", c)
 	}
@@ -30,6 +30,20 @@ func ResponseTypeString(response *tetragon.GetEventsResponse) (string, error) {
 		return tetragon.EventType_PROCESS_KPROBE.String(), nil
 	case *tetragon.GetEventsResponse_ProcessTracepoint:
 		return tetragon.EventType_PROCESS_TRACEPOINT.String(), nil
+	case *tetragon.GetEventsResponse_ProcessCredentialsChange:
+		return tetragon.EventType_PROCESS_CREDENTIALS_CHANGE.String(), nil
+	case *tetragon.GetEventsResponse_ProcessNamespaceChange:
+		return tetragon.EventType_PROCESS_NAMESPACE_CHANGE.String(), nil
+	case *tetragon.GetEventsResponse_ProcessKernelModuleLoad:
+		return tetragon.EventType_PROCESS_KERNEL_MODULE_LOAD.String(), nil
+	case *tetragon.GetEventsResponse_ProcessBpfProgLoad:
+		return tetragon.EventType_PROCESS_BPF_PROG_LOAD.String(), nil
+	case *tetragon.GetEventsResponse_ProcessBpfMapCreate:
+		return tetragon.EventType_PROCESS_BPF_MAP_CREATE.String(), nil
+	case *tetragon.GetEventsResponse_ProcessMount:
+		return tetragon.EventType_PROCESS_MOUNT.String(), nil
+	case *tetragon.GetEventsResponse_EventsLost:
+		return tetragon.EventType_EVENTS_LOST.String(), nil
 	case *tetragon.GetEventsResponse_Test:
 		return tetragon.EventType_TEST.String(), nil
 
@@ -62,6 +76,18 @@ func ResponseInnerGetProcess(event tetragon.IsGetEventsResponse_Event) *tetragon
 		return ev.ProcessKprobe.Process
 	case *tetragon.GetEventsResponse_ProcessTracepoint:
 		return ev.ProcessTracepoint.Process
+	case *tetragon.GetEventsResponse_ProcessCredentialsChange:
+		return ev.ProcessCredentialsChange.Process
+	case *tetragon.GetEventsResponse_ProcessNamespaceChange:
+		return ev.ProcessNamespaceChange.Process
+	case *tetragon.GetEventsResponse_ProcessKernelModuleLoad:
+		return ev.ProcessKernelModuleLoad.Process
+	case *tetragon.GetEventsResponse_ProcessBpfProgLoad:
+		return ev.ProcessBpfProgLoad.Process
+	case *tetragon.GetEventsResponse_ProcessBpfMapCreate:
+		return ev.ProcessBpfMapCreate.Process
+	case *tetragon.GetEventsResponse_ProcessMount:
+		return ev.ProcessMount.Process
 
 	}
 	return nil
@@ -92,6 +118,18 @@ func ResponseInnerGetParent(event tetragon.IsGetEventsResponse_Event) *tetragon.
 		return ev.ProcessKprobe.Parent
 	case *tetragon.GetEventsResponse_ProcessTracepoint:
 		return ev.ProcessTracepoint.Parent
+	case *tetragon.GetEventsResponse_ProcessCredentialsChange:
+		return ev.ProcessCredentialsChange.Parent
+	case *tetragon.GetEventsResponse_ProcessNamespaceChange:
+		return ev.ProcessNamespaceChange.Parent
+	case *tetragon.GetEventsResponse_ProcessKernelModuleLoad:
+		return ev.ProcessKernelModuleLoad.Parent
+	case *tetragon.GetEventsResponse_ProcessBpfProgLoad:
+		return ev.ProcessBpfProgLoad.Parent
+	case *tetragon.GetEventsResponse_ProcessBpfMapCreate:
+		return ev.ProcessBpfMapCreate.Parent
+	case *tetragon.GetEventsResponse_ProcessMount:
+		return ev.ProcessMount.Parent
 
 	}
 	return nil
@@ -24,6 +24,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -41,12 +42,19 @@ const (
 type EventType int32
 
 const (
-	EventType_UNDEF              EventType = 0
-	EventType_PROCESS_EXEC       EventType = 5
-	EventType_PROCESS_EXIT       EventType = 7
-	EventType_PROCESS_KPROBE     EventType = 13
-	EventType_PROCESS_TRACEPOINT EventType = 14
-	EventType_TEST               EventType = 254
+	EventType_UNDEF                      EventType = 0
+	EventType_PROCESS_EXEC               EventType = 5
+	EventType_PROCESS_EXIT               EventType = 7
+	EventType_PROCESS_KPROBE             EventType = 13
+	EventType_PROCESS_TRACEPOINT         EventType = 14
+	EventType_PROCESS_CREDENTIALS_CHANGE EventType = 25
+	EventType_PROCESS_NAMESPACE_CHANGE   EventType = 26
+	EventType_PROCESS_KERNEL_MODULE_LOAD EventType = 27
+	EventType_PROCESS_BPF_PROG_LOAD      EventType = 28
+	EventType_PROCESS_BPF_MAP_CREATE     EventType = 29
+	EventType_PROCESS_MOUNT              EventType = 30
+	EventType_EVENTS_LOST                EventType = 31
+	EventType_TEST                       EventType = 254
 )
 
 // Enum value maps for EventType.
@@ -57,15 +65,29 @@ var (
 		7:   "PROCESS_EXIT",
 		13:  "PROCESS_KPROBE",
 		14:  "PROCESS_TRACEPOINT",
+		25:  "PROCESS_CREDENTIALS_CHANGE",
+		26:  "PROCESS_NAMESPACE_CHANGE",
+		27:  "PROCESS_KERNEL_MODULE_LOAD",
+		28:  "PROCESS_BPF_PROG_LOAD",
+		29:  "PROCESS_BPF_MAP_CREATE",
+		30:  "PROCESS_MOUNT",
+		31:  "EVENTS_LOST",
 		254: "TEST",
 	}
 	EventType_value = map[string]int32{
-		"UNDEF":              0,
-		"PROCESS_EXEC":       5,
-		"PROCESS_EXIT":       7,
-		"PROCESS_KPROBE":     13,
-		"PROCESS_TRACEPOINT": 14,
-		"TEST":               254,
+		"UNDEF":                      0,
+		"PROCESS_EXEC":               5,
+		"PROCESS_EXIT":               7,
+		"PROCESS_KPROBE":             13,
+		"PROCESS_TRACEPOINT":         14,
+		"PROCESS_CREDENTIALS_CHANGE": 25,
+		"PROCESS_NAMESPACE_CHANGE":   26,
+		"PROCESS_KERNEL_MODULE_LOAD": 27,
+		"PROCESS_BPF_PROG_LOAD":      28,
+		"PROCESS_BPF_MAP_CREATE":     29,
+		"PROCESS_MOUNT":              30,
+		"EVENTS_LOST":                31,
+		"TEST":                       254,
 	}
 )
 
@@ -96,6 +118,68 @@ func (EventType) EnumDescriptor() ([]byte, []int) {
 	return file_tetragon_events_proto_rawDescGZIP(), []int{0}
 }
 
+type ArgOperator int32
+
+const (
+	// Unset operator, rejected by the server.
+	ArgOperator_ARG_OPERATOR_UNSPECIFIED ArgOperator = 0
+	ArgOperator_ARG_OPERATOR_EQ          ArgOperator = 1
+	ArgOperator_ARG_OPERATOR_NE          ArgOperator = 2
+	ArgOperator_ARG_OPERATOR_LT          ArgOperator = 3
+	ArgOperator_ARG_OPERATOR_LE          ArgOperator = 4
+	ArgOperator_ARG_OPERATOR_GT          ArgOperator = 5
+	ArgOperator_ARG_OPERATOR_GE          ArgOperator = 6
+)
+
+// Enum value maps for ArgOperator.
+var (
+	ArgOperator_name = map[int32]string{
+		0: "ARG_OPERATOR_UNSPECIFIED",
+		1: "ARG_OPERATOR_EQ",
+		2: "ARG_OPERATOR_NE",
+		3: "ARG_OPERATOR_LT",
+		4: "ARG_OPERATOR_LE",
+		5: "ARG_OPERATOR_GT",
+		6: "ARG_OPERATOR_GE",
+	}
+	ArgOperator_value = map[string]int32{
+		"ARG_OPERATOR_UNSPECIFIED": 0,
+		"ARG_OPERATOR_EQ":          1,
+		"ARG_OPERATOR_NE":          2,
+		"ARG_OPERATOR_LT":          3,
+		"ARG_OPERATOR_LE":          4,
+		"ARG_OPERATOR_GT":          5,
+		"ARG_OPERATOR_GE":          6,
+	}
+)
+
+func (x ArgOperator) Enum() *ArgOperator {
+	p := new(ArgOperator)
+	*p = x
+	return p
+}
+
+func (x ArgOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArgOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_tetragon_events_proto_enumTypes[1].Descriptor()
+}
+
+func (ArgOperator) Type() protoreflect.EnumType {
+	return &file_tetragon_events_proto_enumTypes[1]
+}
+
+func (x ArgOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArgOperator.Descriptor instead.
+func (ArgOperator) EnumDescriptor() ([]byte, []int) {
+	return file_tetragon_events_proto_rawDescGZIP(), []int{1}
+}
+
 type Filter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -118,6 +202,30 @@ type Filter struct {
 	// Note that this filter never matches events without the pod field (i.e.
 	// host process events).
 	Labels []string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty"`
+	// Filter process_mount events by target path prefix. Note that this
+	// filter never matches other event types.
+	MountTargetPrefix []string `protobuf:"bytes,10,rep,name=mount_target_prefix,json=mountTargetPrefix,proto3" json:"mount_target_prefix,omitempty"`
+	// Filter events with a boolean expression over the fields of
+	// GetEventsResponse, for example:
+	//   process_kprobe.function_name == "tcp_connect" && process.uid == 0
+	// The variables process and parent refer to the process and parent of
+	// any event type, and event_type to the name of its EventType. See
+	// pkg/filters/expr for the full syntax.
+	Expression string `protobuf:"bytes,11,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Filter process_kprobe events by kernel function name.
+	FunctionName []string `protobuf:"bytes,12,rep,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// Filter process_kprobe and process_tracepoint events by the name of the
+	// tracing policy that created the probe.
+	PolicyName []string `protobuf:"bytes,13,rep,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	// Filter process_tracepoint events by "subsys/event", for example
+	// "syscalls/sys_enter_openat", or by "subsys" to match all the events of
+	// a subsystem.
+	Tracepoint []string `protobuf:"bytes,14,rep,name=tracepoint,proto3" json:"tracepoint,omitempty"`
+	// Filter process_kprobe events by action.
+	KprobeAction []KprobeAction `protobuf:"varint,15,rep,packed,name=kprobe_action,json=kprobeAction,proto3,enum=tetragon.KprobeAction" json:"kprobe_action,omitempty"`
+	// Filter process_kprobe and process_tracepoint events by argument value.
+	// All the argument filters have to match.
+	Arg []*ArgFilter `protobuf:"bytes,16,rep,name=arg,proto3" json:"arg,omitempty"`
 }
 
 func (x *Filter) Reset() {
@@ -215,6 +323,209 @@ func (x *Filter) GetLabels() []string {
 	return nil
 }
 
+func (x *Filter) GetMountTargetPrefix() []string {
+	if x != nil {
+		return x.MountTargetPrefix
+	}
+	return nil
+}
+
+func (x *Filter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *Filter) GetFunctionName() []string {
+	if x != nil {
+		return x.FunctionName
+	}
+	return nil
+}
+
+func (x *Filter) GetPolicyName() []string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return nil
+}
+
+func (x *Filter) GetTracepoint() []string {
+	if x != nil {
+		return x.Tracepoint
+	}
+	return nil
+}
+
+func (x *Filter) GetKprobeAction() []KprobeAction {
+	if x != nil {
+		return x.KprobeAction
+	}
+	return nil
+}
+
+func (x *Filter) GetArg() []*ArgFilter {
+	if x != nil {
+		return x.Arg
+	}
+	return nil
+}
+
+// ArgIntComparison compares an integer argument to value.
+type ArgIntComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator ArgOperator `protobuf:"varint,1,opt,name=operator,proto3,enum=tetragon.ArgOperator" json:"operator,omitempty"`
+	Value    int64       `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ArgIntComparison) Reset() {
+	*x = ArgIntComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tetragon_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArgIntComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArgIntComparison) ProtoMessage() {}
+
+func (x *ArgIntComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_tetragon_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArgIntComparison.ProtoReflect.Descriptor instead.
+func (*ArgIntComparison) Descriptor() ([]byte, []int) {
+	return file_tetragon_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ArgIntComparison) GetOperator() ArgOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return ArgOperator_ARG_OPERATOR_UNSPECIFIED
+}
+
+func (x *ArgIntComparison) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// ArgFilter matches the argument at index of process_kprobe and
+// process_tracepoint events. Each of the set fields has to match, and a field
+// matches if any of its values matches. An argument that none of the set
+// fields applies to never matches.
+type ArgFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Index of the argument, starting at 0.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Match string, path and file arguments by prefix.
+	Prefix []string `protobuf:"bytes,2,rep,name=prefix,proto3" json:"prefix,omitempty"`
+	// Match string, path and file arguments using RE2 regular expression
+	// syntax: https://github.com/google/re2/wiki/Syntax
+	Regex []string `protobuf:"bytes,3,rep,name=regex,proto3" json:"regex,omitempty"`
+	// Match int, long and size arguments.
+	Int []*ArgIntComparison `protobuf:"bytes,4,rep,name=int,proto3" json:"int,omitempty"`
+	// Match the source address of sock and skb arguments by CIDR or IP.
+	Saddr []string `protobuf:"bytes,5,rep,name=saddr,proto3" json:"saddr,omitempty"`
+	// Match the destination address of sock and skb arguments by CIDR or IP.
+	Daddr []string `protobuf:"bytes,6,rep,name=daddr,proto3" json:"daddr,omitempty"`
+}
+
+func (x *ArgFilter) Reset() {
+	*x = ArgFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tetragon_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArgFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArgFilter) ProtoMessage() {}
+
+func (x *ArgFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_tetragon_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArgFilter.ProtoReflect.Descriptor instead.
+func (*ArgFilter) Descriptor() ([]byte, []int) {
+	return file_tetragon_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ArgFilter) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ArgFilter) GetPrefix() []string {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *ArgFilter) GetRegex() []string {
+	if x != nil {
+		return x.Regex
+	}
+	return nil
+}
+
+func (x *ArgFilter) GetInt() []*ArgIntComparison {
+	if x != nil {
+		return x.Int
+	}
+	return nil
+}
+
+func (x *ArgFilter) GetSaddr() []string {
+	if x != nil {
+		return x.Saddr
+	}
+	return nil
+}
+
+func (x *ArgFilter) GetDaddr() []string {
+	if x != nil {
+		return x.Daddr
+	}
+	return nil
+}
+
 type GetEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -237,12 +548,27 @@ type GetEventsRequest struct {
 	// Note that currently only process_accept and process_connect events are
 	// aggregated. Other events remain unaggregated.
 	AggregationOptions *AggregationOptions `protobuf:"bytes,3,opt,name=aggregation_options,json=aggregationOptions,proto3" json:"aggregation_options,omitempty"`
+	// since_sequence replays the events the server still holds with a
+	// sequence number greater than since_sequence before streaming new
+	// events. A client resuming a stream should set it to the sequence of
+	// the last event it received. Events that are no longer held are
+	// reported with an EventsLost message.
+	SinceSequence uint64 `protobuf:"varint,4,opt,name=since_sequence,json=sinceSequence,proto3" json:"since_sequence,omitempty"`
+	// since_time replays the events the server still holds that were
+	// observed at or after since_time before streaming new events.
+	SinceTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since_time,json=sinceTime,proto3" json:"since_time,omitempty"`
+	// field_mask trims the fields of the returned events.
+	FieldMask *EventFieldMask `protobuf:"bytes,6,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	// since_epoch is the epoch of the event since_sequence was taken from.
+	// If it does not match the epoch of the server, the sequence numbers
+	// restarted since then and all the held events are replayed.
+	SinceEpoch string `protobuf:"bytes,7,opt,name=since_epoch,json=sinceEpoch,proto3" json:"since_epoch,omitempty"`
 }
 
 func (x *GetEventsRequest) Reset() {
 	*x = GetEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tetragon_events_proto_msgTypes[1]
+		mi := &file_tetragon_events_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -255,7 +581,7 @@ func (x *GetEventsRequest) String() string {
 func (*GetEventsRequest) ProtoMessage() {}
 
 func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tetragon_events_proto_msgTypes[1]
+	mi := &file_tetragon_events_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,7 +594,7 @@ func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
 func (*GetEventsRequest) Descriptor() ([]byte, []int) {
-	return file_tetragon_events_proto_rawDescGZIP(), []int{1}
+	return file_tetragon_events_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetEventsRequest) GetAllowList() []*Filter {
@@ -292,6 +618,99 @@ func (x *GetEventsRequest) GetAggregationOptions() *AggregationOptions {
 	return nil
 }
 
+func (x *GetEventsRequest) GetSinceSequence() uint64 {
+	if x != nil {
+		return x.SinceSequence
+	}
+	return 0
+}
+
+func (x *GetEventsRequest) GetSinceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SinceTime
+	}
+	return nil
+}
+
+func (x *GetEventsRequest) GetFieldMask() *EventFieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+func (x *GetEventsRequest) GetSinceEpoch() string {
+	if x != nil {
+		return x.SinceEpoch
+	}
+	return ""
+}
+
+// EventFieldMask selects the fields of GetEventsResponse to return. Paths are
+// relative to GetEventsResponse and can be nested, for example
+// process_kprobe.process.pod.labels. Paths through repeated messages apply to
+// every element.
+type EventFieldMask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// include keeps only the listed fields. Paths under an event type, such
+	// as process_kprobe.function_name, only apply to events of that type: an
+	// event whose type is not named by any include path keeps its event field
+	// whole. The sequence and epoch fields are always kept so that streams
+	// can be resumed.
+	Include *fieldmaskpb.FieldMask `protobuf:"bytes,1,opt,name=include,proto3" json:"include,omitempty"`
+	// exclude removes the listed fields. It is applied after include.
+	Exclude *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=exclude,proto3" json:"exclude,omitempty"`
+}
+
+func (x *EventFieldMask) Reset() {
+	*x = EventFieldMask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tetragon_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventFieldMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFieldMask) ProtoMessage() {}
+
+func (x *EventFieldMask) ProtoReflect() protoreflect.Message {
+	mi := &file_tetragon_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventFieldMask.ProtoReflect.Descriptor instead.
+func (*EventFieldMask) Descriptor() ([]byte, []int) {
+	return file_tetragon_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventFieldMask) GetInclude() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *EventFieldMask) GetExclude() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
 // AggregationOptions defines configuration options for aggregating events.
 type AggregationOptions struct {
 	state         protoimpl.MessageState
@@ -309,7 +728,7 @@ type AggregationOptions struct {
 func (x *AggregationOptions) Reset() {
 	*x = AggregationOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tetragon_events_proto_msgTypes[2]
+		mi := &file_tetragon_events_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -322,7 +741,7 @@ func (x *AggregationOptions) String() string {
 func (*AggregationOptions) ProtoMessage() {}
 
 func (x *AggregationOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_tetragon_events_proto_msgTypes[2]
+	mi := &file_tetragon_events_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,7 +754,7 @@ func (x *AggregationOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AggregationOptions.ProtoReflect.Descriptor instead.
 func (*AggregationOptions) Descriptor() ([]byte, []int) {
-	return file_tetragon_events_proto_rawDescGZIP(), []int{2}
+	return file_tetragon_events_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AggregationOptions) GetWindowSize() *durationpb.Duration {
@@ -352,6 +771,67 @@ func (x *AggregationOptions) GetChannelBufferSize() uint64 {
 	return 0
 }
 
+// EventsLost is sent by the server in place of the events that were dropped
+// because the client did not keep up with the event rate. Events lost are not
+// subject to the filters of the request.
+type EventsLost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of events dropped since the previous EventsLost message.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Overflow policy of the client queue, e.g. drop-oldest. Empty if the
+	// events could not be replayed because the server no longer holds them.
+	OverflowPolicy string `protobuf:"bytes,2,opt,name=overflow_policy,json=overflowPolicy,proto3" json:"overflow_policy,omitempty"`
+}
+
+func (x *EventsLost) Reset() {
+	*x = EventsLost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tetragon_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsLost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsLost) ProtoMessage() {}
+
+func (x *EventsLost) ProtoReflect() protoreflect.Message {
+	mi := &file_tetragon_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsLost.ProtoReflect.Descriptor instead.
+func (*EventsLost) Descriptor() ([]byte, []int) {
+	return file_tetragon_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventsLost) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *EventsLost) GetOverflowPolicy() string {
+	if x != nil {
+		return x.OverflowPolicy
+	}
+	return ""
+}
+
 // AggregationInfo contains information about aggregation results.
 type AggregationInfo struct {
 	state         protoimpl.MessageState
@@ -365,7 +845,7 @@ type AggregationInfo struct {
 func (x *AggregationInfo) Reset() {
 	*x = AggregationInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tetragon_events_proto_msgTypes[3]
+		mi := &file_tetragon_events_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -378,7 +858,7 @@ func (x *AggregationInfo) String() string {
 func (*AggregationInfo) ProtoMessage() {}
 
 func (x *AggregationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_tetragon_events_proto_msgTypes[3]
+	mi := &file_tetragon_events_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -391,7 +871,7 @@ func (x *AggregationInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AggregationInfo.ProtoReflect.Descriptor instead.
 func (*AggregationInfo) Descriptor() ([]byte, []int) {
-	return file_tetragon_events_proto_rawDescGZIP(), []int{3}
+	return file_tetragon_events_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AggregationInfo) GetCount() uint64 {
@@ -411,6 +891,13 @@ type GetEventsResponse struct {
 	//	*GetEventsResponse_ProcessExit
 	//	*GetEventsResponse_ProcessKprobe
 	//	*GetEventsResponse_ProcessTracepoint
+	//	*GetEventsResponse_ProcessCredentialsChange
+	//	*GetEventsResponse_ProcessNamespaceChange
+	//	*GetEventsResponse_ProcessKernelModuleLoad
+	//	*GetEventsResponse_ProcessBpfProgLoad
+	//	*GetEventsResponse_ProcessBpfMapCreate
+	//	*GetEventsResponse_ProcessMount
+	//	*GetEventsResponse_EventsLost
 	//	*GetEventsResponse_Test
 	Event isGetEventsResponse_Event `protobuf_oneof:"event"`
 	// Name of the node where this event was observed.
@@ -423,12 +910,18 @@ type GetEventsResponse struct {
 	// aggregation_info contains information about aggregation results. This field
 	// is set only for aggregated responses.
 	AggregationInfo *AggregationInfo `protobuf:"bytes,1002,opt,name=aggregation_info,json=aggregationInfo,proto3" json:"aggregation_info,omitempty"`
+	// Sequence number of this event on the node, increasing by one for each
+	// event. It is 0 for events_lost messages.
+	Sequence uint64 `protobuf:"varint,1003,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Epoch of the sequence number. It changes when the sequence numbers
+	// restart, e.g. when the server restarts without a persisted event ring.
+	Epoch string `protobuf:"bytes,1004,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
 func (x *GetEventsResponse) Reset() {
 	*x = GetEventsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tetragon_events_proto_msgTypes[4]
+		mi := &file_tetragon_events_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -441,7 +934,7 @@ func (x *GetEventsResponse) String() string {
 func (*GetEventsResponse) ProtoMessage() {}
 
 func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tetragon_events_proto_msgTypes[4]
+	mi := &file_tetragon_events_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +947,7 @@ func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
 func (*GetEventsResponse) Descriptor() ([]byte, []int) {
-	return file_tetragon_events_proto_rawDescGZIP(), []int{4}
+	return file_tetragon_events_proto_rawDescGZIP(), []int{8}
 }
 
 func (m *GetEventsResponse) GetEvent() isGetEventsResponse_Event {
@@ -492,6 +985,55 @@ func (x *GetEventsResponse) GetProcessTracepoint() *ProcessTracepoint {
 	return nil
 }
 
+func (x *GetEventsResponse) GetProcessCredentialsChange() *ProcessCredentialsChange {
+	if x, ok := x.GetEvent().(*GetEventsResponse_ProcessCredentialsChange); ok {
+		return x.ProcessCredentialsChange
+	}
+	return nil
+}
+
+func (x *GetEventsResponse) GetProcessNamespaceChange() *ProcessNamespaceChange {
+	if x, ok := x.GetEvent().(*GetEventsResponse_ProcessNamespaceChange); ok {
+		return x.ProcessNamespaceChange
+	}
+	return nil
+}
+
+func (x *GetEventsResponse) GetProcessKernelModuleLoad() *ProcessKernelModuleLoad {
+	if x, ok := x.GetEvent().(*GetEventsResponse_ProcessKernelModuleLoad); ok {
+		return x.ProcessKernelModuleLoad
+	}
+	return nil
+}
+
+func (x *GetEventsResponse) GetProcessBpfProgLoad() *ProcessBpfProgLoad {
+	if x, ok := x.GetEvent().(*GetEventsResponse_ProcessBpfProgLoad); ok {
+		return x.ProcessBpfProgLoad
+	}
+	return nil
+}
+
+func (x *GetEventsResponse) GetProcessBpfMapCreate() *ProcessBpfMapCreate {
+	if x, ok := x.GetEvent().(*GetEventsResponse_ProcessBpfMapCreate); ok {
+		return x.ProcessBpfMapCreate
+	}
+	return nil
+}
+
+func (x *GetEventsResponse) GetProcessMount() *ProcessMount {
+	if x, ok := x.GetEvent().(*GetEventsResponse_ProcessMount); ok {
+		return x.ProcessMount
+	}
+	return nil
+}
+
+func (x *GetEventsResponse) GetEventsLost() *EventsLost {
+	if x, ok := x.GetEvent().(*GetEventsResponse_EventsLost); ok {
+		return x.EventsLost
+	}
+	return nil
+}
+
 func (x *GetEventsResponse) GetTest() *Test {
 	if x, ok := x.GetEvent().(*GetEventsResponse_Test); ok {
 		return x.Test
@@ -520,6 +1062,20 @@ func (x *GetEventsResponse) GetAggregationInfo() *AggregationInfo {
 	return nil
 }
 
+func (x *GetEventsResponse) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *GetEventsResponse) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
 type isGetEventsResponse_Event interface {
 	isGetEventsResponse_Event()
 }
@@ -540,6 +1096,34 @@ type GetEventsResponse_ProcessTracepoint struct {
 	ProcessTracepoint *ProcessTracepoint `protobuf:"bytes,10,opt,name=process_tracepoint,json=processTracepoint,proto3,oneof"`
 }
 
+type GetEventsResponse_ProcessCredentialsChange struct {
+	ProcessCredentialsChange *ProcessCredentialsChange `protobuf:"bytes,11,opt,name=process_credentials_change,json=processCredentialsChange,proto3,oneof"`
+}
+
+type GetEventsResponse_ProcessNamespaceChange struct {
+	ProcessNamespaceChange *ProcessNamespaceChange `protobuf:"bytes,12,opt,name=process_namespace_change,json=processNamespaceChange,proto3,oneof"`
+}
+
+type GetEventsResponse_ProcessKernelModuleLoad struct {
+	ProcessKernelModuleLoad *ProcessKernelModuleLoad `protobuf:"bytes,13,opt,name=process_kernel_module_load,json=processKernelModuleLoad,proto3,oneof"`
+}
+
+type GetEventsResponse_ProcessBpfProgLoad struct {
+	ProcessBpfProgLoad *ProcessBpfProgLoad `protobuf:"bytes,14,opt,name=process_bpf_prog_load,json=processBpfProgLoad,proto3,oneof"`
+}
+
+type GetEventsResponse_ProcessBpfMapCreate struct {
+	ProcessBpfMapCreate *ProcessBpfMapCreate `protobuf:"bytes,15,opt,name=process_bpf_map_create,json=processBpfMapCreate,proto3,oneof"`
+}
+
+type GetEventsResponse_ProcessMount struct {
+	ProcessMount *ProcessMount `protobuf:"bytes,16,opt,name=process_mount,json=processMount,proto3,oneof"`
+}
+
+type GetEventsResponse_EventsLost struct {
+	EventsLost *EventsLost `protobuf:"bytes,17,opt,name=events_lost,json=eventsLost,proto3,oneof"`
+}
+
 type GetEventsResponse_Test struct {
 	Test *Test `protobuf:"bytes,40000,opt,name=test,proto3,oneof"`
 }
@@ -552,6 +1136,20 @@ func (*GetEventsResponse_ProcessKprobe) isGetEventsResponse_Event() {}
 
 func (*GetEventsResponse_ProcessTracepoint) isGetEventsResponse_Event() {}
 
+func (*GetEventsResponse_ProcessCredentialsChange) isGetEventsResponse_Event() {}
+
+func (*GetEventsResponse_ProcessNamespaceChange) isGetEventsResponse_Event() {}
+
+func (*GetEventsResponse_ProcessKernelModuleLoad) isGetEventsResponse_Event() {}
+
+func (*GetEventsResponse_ProcessBpfProgLoad) isGetEventsResponse_Event() {}
+
+func (*GetEventsResponse_ProcessBpfMapCreate) isGetEventsResponse_Event() {}
+
+func (*GetEventsResponse_ProcessMount) isGetEventsResponse_Event() {}
+
+func (*GetEventsResponse_EventsLost) isGetEventsResponse_Event() {}
+
 func (*GetEventsResponse_Test) isGetEventsResponse_Event() {}
 
 var File_tetragon_events_proto protoreflect.FileDescriptor
@@ -566,89 +1164,211 @@ var file_tetragon_events_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70,
 	0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc3, 0x02, 0x0a, 0x06,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69,
-	0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x69, 0x64, 0x5f,
-	0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x69, 0x64, 0x53, 0x65,
-	0x74, 0x12, 0x30, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x53, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x64, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x64, 0x52, 0x65, 0x67, 0x65, 0x78,
-	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65,
-	0x67, 0x65, 0x78, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x72, 0x67, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x22, 0xc1, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x74,
-	0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x09, 0x64, 0x65, 0x6e, 0x79, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x74,
-	0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x08, 0x64, 0x65,
-	0x6e, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x13, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x41,
-	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x12, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0b,
-	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x77, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x75,
-	0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x27, 0x0a, 0x0f, 0x41, 0x67, 0x67, 0x72,
-	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0xe2, 0x03, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x45, 0x78, 0x65, 0x63, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45,
-	0x78, 0x65, 0x63, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x65,
-	0x78, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x74, 0x72,
-	0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x69, 0x74,
-	0x48, 0x00, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x69, 0x74, 0x12,
-	0x40, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x70, 0x72, 0x6f, 0x62,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67,
-	0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x70, 0x72, 0x6f, 0x62, 0x65,
-	0x48, 0x00, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x70, 0x72, 0x6f, 0x62,
-	0x65, 0x12, 0x4c, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x72, 0x61,
-	0x63, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x54, 0x72, 0x61, 0x63, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x63, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
-	0x26, 0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0xc0, 0xb8, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x48,
-	0x00, 0x52, 0x04, 0x74, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0xe9, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0xea, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x41, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x61, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x07, 0x0a,
-	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a, 0x71, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x10, 0x00, 0x12, 0x10,
-	0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x10, 0x05,
-	0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x58, 0x49, 0x54,
-	0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x4b, 0x50,
-	0x52, 0x4f, 0x42, 0x45, 0x10, 0x0d, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53,
-	0x53, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x0e, 0x12, 0x09,
-	0x0a, 0x04, 0x54, 0x45, 0x53, 0x54, 0x10, 0xfe, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdd, 0x04,
+	0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x69,
+	0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x69, 0x64,
+	0x53, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f,
+	0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x64, 0x5f, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x64, 0x52, 0x65, 0x67,
+	0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x11, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x6b, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x4b, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6b, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x03, 0x61, 0x72, 0x67, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x41,
+	0x72, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x03, 0x61, 0x72, 0x67, 0x22, 0x5b, 0x0a,
+	0x10, 0x41, 0x72, 0x67, 0x49, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f,
+	0x6e, 0x12, 0x31, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x41,
+	0x72, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x09, 0x41,
+	0x72, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x03,
+	0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x74, 0x72,
+	0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x41, 0x72, 0x67, 0x49, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x03, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x61, 0x64, 0x64, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x61, 0x64, 0x64, 0x72, 0x22, 0xfd, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x09,
+	0x64, 0x65, 0x6e, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x08, 0x64, 0x65, 0x6e, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x13, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61,
+	0x67, 0x6f, 0x6e, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x12, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x0a,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x7c, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x34, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x34,
+	0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x07, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x4b, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x4c, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f,
+	0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x22, 0x27, 0x0a, 0x0f, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdb, 0x08,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x65,
+	0x78, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x74, 0x72,
+	0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x65, 0x63,
+	0x48, 0x00, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x65, 0x63, 0x12,
+	0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e,
+	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0b,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x69, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x48, 0x00, 0x52, 0x0d,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x4c, 0x0a,
+	0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x74, 0x72,
+	0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x72, 0x61, 0x63, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x62, 0x0a, 0x1a, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x18, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x5c, 0x0a, 0x18, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x60, 0x0a,
+	0x1a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x4c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4b,
+	0x65, 0x72, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x12,
+	0x51, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x62, 0x70, 0x66, 0x5f, 0x70,
+	0x72, 0x6f, 0x67, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x42, 0x70, 0x66, 0x50, 0x72, 0x6f, 0x67, 0x4c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x12,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x70, 0x66, 0x50, 0x72, 0x6f, 0x67, 0x4c, 0x6f,
+	0x61, 0x64, 0x12, 0x54, 0x0a, 0x16, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x62, 0x70,
+	0x66, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x70, 0x66, 0x4d, 0x61, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x70, 0x66, 0x4d,
+	0x61, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x5f, 0x6c, 0x6f, 0x73, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x6f,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x6f, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0xc0, 0xb8, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f,
+	0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0xe9,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0xea, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x74, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x2e, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0xeb, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0xec, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a, 0xaa, 0x02, 0x0a, 0x09,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x44,
+	0x45, 0x46, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f,
+	0x45, 0x58, 0x45, 0x43, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53,
+	0x53, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x43,
+	0x45, 0x53, 0x53, 0x5f, 0x4b, 0x50, 0x52, 0x4f, 0x42, 0x45, 0x10, 0x0d, 0x12, 0x16, 0x0a, 0x12,
+	0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x50, 0x4f, 0x49,
+	0x4e, 0x54, 0x10, 0x0e, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f,
+	0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x10, 0x19, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x10, 0x1a, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x4b, 0x45,
+	0x52, 0x4e, 0x45, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x41, 0x44,
+	0x10, 0x1b, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x42, 0x50,
+	0x46, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x1c, 0x12, 0x1a, 0x0a,
+	0x16, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x42, 0x50, 0x46, 0x5f, 0x4d, 0x41, 0x50,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x1d, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f,
+	0x43, 0x45, 0x53, 0x53, 0x5f, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x1e, 0x12, 0x0f, 0x0a, 0x0b,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x53, 0x5f, 0x4c, 0x4f, 0x53, 0x54, 0x10, 0x1f, 0x12, 0x09, 0x0a,
+	0x04, 0x54, 0x45, 0x53, 0x54, 0x10, 0xfe, 0x01, 0x2a, 0xa9, 0x01, 0x0a, 0x0b, 0x41, 0x72, 0x67,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x52, 0x47, 0x5f,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x52, 0x47, 0x5f, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41,
+	0x52, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x45, 0x10, 0x02,
+	0x12, 0x13, 0x0a, 0x0f, 0x41, 0x52, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x5f, 0x4c, 0x54, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x52, 0x47, 0x5f, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x52,
+	0x47, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x47, 0x54, 0x10, 0x05, 0x12,
+	0x13, 0x0a, 0x0f, 0x41, 0x52, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f,
+	0x47, 0x45, 0x10, 0x06, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -663,43 +1383,71 @@ func file_tetragon_events_proto_rawDescGZIP() []byte {
 	return file_tetragon_events_proto_rawDescData
 }
 
-var file_tetragon_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_tetragon_events_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_tetragon_events_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_tetragon_events_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_tetragon_events_proto_goTypes = []interface{}{
-	(EventType)(0),                // 0: tetragon.EventType
-	(*Filter)(nil),                // 1: tetragon.Filter
-	(*GetEventsRequest)(nil),      // 2: tetragon.GetEventsRequest
-	(*AggregationOptions)(nil),    // 3: tetragon.AggregationOptions
-	(*AggregationInfo)(nil),       // 4: tetragon.AggregationInfo
-	(*GetEventsResponse)(nil),     // 5: tetragon.GetEventsResponse
-	(*wrapperspb.BoolValue)(nil),  // 6: google.protobuf.BoolValue
-	(*durationpb.Duration)(nil),   // 7: google.protobuf.Duration
-	(*ProcessExec)(nil),           // 8: tetragon.ProcessExec
-	(*ProcessExit)(nil),           // 9: tetragon.ProcessExit
-	(*ProcessKprobe)(nil),         // 10: tetragon.ProcessKprobe
-	(*ProcessTracepoint)(nil),     // 11: tetragon.ProcessTracepoint
-	(*Test)(nil),                  // 12: tetragon.Test
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(EventType)(0),                   // 0: tetragon.EventType
+	(ArgOperator)(0),                 // 1: tetragon.ArgOperator
+	(*Filter)(nil),                   // 2: tetragon.Filter
+	(*ArgIntComparison)(nil),         // 3: tetragon.ArgIntComparison
+	(*ArgFilter)(nil),                // 4: tetragon.ArgFilter
+	(*GetEventsRequest)(nil),         // 5: tetragon.GetEventsRequest
+	(*EventFieldMask)(nil),           // 6: tetragon.EventFieldMask
+	(*AggregationOptions)(nil),       // 7: tetragon.AggregationOptions
+	(*EventsLost)(nil),               // 8: tetragon.EventsLost
+	(*AggregationInfo)(nil),          // 9: tetragon.AggregationInfo
+	(*GetEventsResponse)(nil),        // 10: tetragon.GetEventsResponse
+	(*wrapperspb.BoolValue)(nil),     // 11: google.protobuf.BoolValue
+	(KprobeAction)(0),                // 12: tetragon.KprobeAction
+	(*timestamppb.Timestamp)(nil),    // 13: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),    // 14: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),      // 15: google.protobuf.Duration
+	(*ProcessExec)(nil),              // 16: tetragon.ProcessExec
+	(*ProcessExit)(nil),              // 17: tetragon.ProcessExit
+	(*ProcessKprobe)(nil),            // 18: tetragon.ProcessKprobe
+	(*ProcessTracepoint)(nil),        // 19: tetragon.ProcessTracepoint
+	(*ProcessCredentialsChange)(nil), // 20: tetragon.ProcessCredentialsChange
+	(*ProcessNamespaceChange)(nil),   // 21: tetragon.ProcessNamespaceChange
+	(*ProcessKernelModuleLoad)(nil),  // 22: tetragon.ProcessKernelModuleLoad
+	(*ProcessBpfProgLoad)(nil),       // 23: tetragon.ProcessBpfProgLoad
+	(*ProcessBpfMapCreate)(nil),      // 24: tetragon.ProcessBpfMapCreate
+	(*ProcessMount)(nil),             // 25: tetragon.ProcessMount
+	(*Test)(nil),                     // 26: tetragon.Test
 }
 var file_tetragon_events_proto_depIdxs = []int32{
-	6,  // 0: tetragon.Filter.health_check:type_name -> google.protobuf.BoolValue
+	11, // 0: tetragon.Filter.health_check:type_name -> google.protobuf.BoolValue
 	0,  // 1: tetragon.Filter.event_set:type_name -> tetragon.EventType
-	1,  // 2: tetragon.GetEventsRequest.allow_list:type_name -> tetragon.Filter
-	1,  // 3: tetragon.GetEventsRequest.deny_list:type_name -> tetragon.Filter
-	3,  // 4: tetragon.GetEventsRequest.aggregation_options:type_name -> tetragon.AggregationOptions
-	7,  // 5: tetragon.AggregationOptions.window_size:type_name -> google.protobuf.Duration
-	8,  // 6: tetragon.GetEventsResponse.process_exec:type_name -> tetragon.ProcessExec
-	9,  // 7: tetragon.GetEventsResponse.process_exit:type_name -> tetragon.ProcessExit
-	10, // 8: tetragon.GetEventsResponse.process_kprobe:type_name -> tetragon.ProcessKprobe
-	11, // 9: tetragon.GetEventsResponse.process_tracepoint:type_name -> tetragon.ProcessTracepoint
-	12, // 10: tetragon.GetEventsResponse.test:type_name -> tetragon.Test
-	13, // 11: tetragon.GetEventsResponse.time:type_name -> google.protobuf.Timestamp
-	4,  // 12: tetragon.GetEventsResponse.aggregation_info:type_name -> tetragon.AggregationInfo
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	12, // 2: tetragon.Filter.kprobe_action:type_name -> tetragon.KprobeAction
+	4,  // 3: tetragon.Filter.arg:type_name -> tetragon.ArgFilter
+	1,  // 4: tetragon.ArgIntComparison.operator:type_name -> tetragon.ArgOperator
+	3,  // 5: tetragon.ArgFilter.int:type_name -> tetragon.ArgIntComparison
+	2,  // 6: tetragon.GetEventsRequest.allow_list:type_name -> tetragon.Filter
+	2,  // 7: tetragon.GetEventsRequest.deny_list:type_name -> tetragon.Filter
+	7,  // 8: tetragon.GetEventsRequest.aggregation_options:type_name -> tetragon.AggregationOptions
+	13, // 9: tetragon.GetEventsRequest.since_time:type_name -> google.protobuf.Timestamp
+	6,  // 10: tetragon.GetEventsRequest.field_mask:type_name -> tetragon.EventFieldMask
+	14, // 11: tetragon.EventFieldMask.include:type_name -> google.protobuf.FieldMask
+	14, // 12: tetragon.EventFieldMask.exclude:type_name -> google.protobuf.FieldMask
+	15, // 13: tetragon.AggregationOptions.window_size:type_name -> google.protobuf.Duration
+	16, // 14: tetragon.GetEventsResponse.process_exec:type_name -> tetragon.ProcessExec
+	17, // 15: tetragon.GetEventsResponse.process_exit:type_name -> tetragon.ProcessExit
+	18, // 16: tetragon.GetEventsResponse.process_kprobe:type_name -> tetragon.ProcessKprobe
+	19, // 17: tetragon.GetEventsResponse.process_tracepoint:type_name -> tetragon.ProcessTracepoint
+	20, // 18: tetragon.GetEventsResponse.process_credentials_change:type_name -> tetragon.ProcessCredentialsChange
+	21, // 19: tetragon.GetEventsResponse.process_namespace_change:type_name -> tetragon.ProcessNamespaceChange
+	22, // 20: tetragon.GetEventsResponse.process_kernel_module_load:type_name -> tetragon.ProcessKernelModuleLoad
+	23, // 21: tetragon.GetEventsResponse.process_bpf_prog_load:type_name -> tetragon.ProcessBpfProgLoad
+	24, // 22: tetragon.GetEventsResponse.process_bpf_map_create:type_name -> tetragon.ProcessBpfMapCreate
+	25, // 23: tetragon.GetEventsResponse.process_mount:type_name -> tetragon.ProcessMount
+	8,  // 24: tetragon.GetEventsResponse.events_lost:type_name -> tetragon.EventsLost
+	26, // 25: tetragon.GetEventsResponse.test:type_name -> tetragon.Test
+	13, // 26: tetragon.GetEventsResponse.time:type_name -> google.protobuf.Timestamp
+	9,  // 27: tetragon.GetEventsResponse.aggregation_info:type_name -> tetragon.AggregationInfo
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_tetragon_events_proto_init() }
@@ -722,7 +1470,7 @@ func file_tetragon_events_proto_init() {
 			}
 		}
 		file_tetragon_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEventsRequest); i {
+			switch v := v.(*ArgIntComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -734,7 +1482,7 @@ func file_tetragon_events_proto_init() {
 			}
 		}
 		file_tetragon_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AggregationOptions); i {
+			switch v := v.(*ArgFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -746,7 +1494,7 @@ func file_tetragon_events_proto_init() {
 			}
 		}
 		file_tetragon_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AggregationInfo); i {
+			switch v := v.(*GetEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -758,6 +1506,54 @@ func file_tetragon_events_proto_init() {
 			}
 		}
 		file_tetragon_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventFieldMask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tetragon_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregationOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tetragon_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventsLost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tetragon_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tetragon_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEventsResponse); i {
 			case 0:
 				return &v.state
@@ -770,11 +1566,18 @@ func file_tetragon_events_proto_init() {
 			}
 		}
 	}
-	file_tetragon_events_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_tetragon_events_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*GetEventsResponse_ProcessExec)(nil),
 		(*GetEventsResponse_ProcessExit)(nil),
 		(*GetEventsResponse_ProcessKprobe)(nil),
 		(*GetEventsResponse_ProcessTracepoint)(nil),
+		(*GetEventsResponse_ProcessCredentialsChange)(nil),
+		(*GetEventsResponse_ProcessNamespaceChange)(nil),
+		(*GetEventsResponse_ProcessKernelModuleLoad)(nil),
+		(*GetEventsResponse_ProcessBpfProgLoad)(nil),
+		(*GetEventsResponse_ProcessBpfMapCreate)(nil),
+		(*GetEventsResponse_ProcessMount)(nil),
+		(*GetEventsResponse_EventsLost)(nil),
 		(*GetEventsResponse_Test)(nil),
 	}
 	type x struct{}
@@ -782,8 +1585,8 @@ func file_tetragon_events_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tetragon_events_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
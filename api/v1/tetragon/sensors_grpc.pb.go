@@ -32,6 +32,8 @@ type FineGuidanceSensorsClient interface {
 	SetSensorConfig(ctx context.Context, in *SetSensorConfigRequest, opts ...grpc.CallOption) (*SetSensorConfigResponse, error)
 	GetSensorConfig(ctx context.Context, in *GetSensorConfigRequest, opts ...grpc.CallOption) (*GetSensorConfigResponse, error)
 	GetStackTraceTree(ctx context.Context, in *GetStackTraceTreeRequest, opts ...grpc.CallOption) (*GetStackTraceTreeResponse, error)
+	ExportStackTraceTree(ctx context.Context, in *ExportStackTraceTreeRequest, opts ...grpc.CallOption) (*ExportStackTraceTreeResponse, error)
+	RecordSamples(ctx context.Context, in *RecordSamplesRequest, opts ...grpc.CallOption) (*RecordSamplesResponse, error)
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
 }
 
@@ -156,6 +158,24 @@ func (c *fineGuidanceSensorsClient) GetStackTraceTree(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *fineGuidanceSensorsClient) ExportStackTraceTree(ctx context.Context, in *ExportStackTraceTreeRequest, opts ...grpc.CallOption) (*ExportStackTraceTreeResponse, error) {
+	out := new(ExportStackTraceTreeResponse)
+	err := c.cc.Invoke(ctx, "/tetragon.FineGuidanceSensors/ExportStackTraceTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fineGuidanceSensorsClient) RecordSamples(ctx context.Context, in *RecordSamplesRequest, opts ...grpc.CallOption) (*RecordSamplesResponse, error) {
+	out := new(RecordSamplesResponse)
+	err := c.cc.Invoke(ctx, "/tetragon.FineGuidanceSensors/RecordSamples", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fineGuidanceSensorsClient) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error) {
 	out := new(GetVersionResponse)
 	err := c.cc.Invoke(ctx, "/tetragon.FineGuidanceSensors/GetVersion", in, out, opts...)
@@ -179,6 +199,8 @@ type FineGuidanceSensorsServer interface {
 	SetSensorConfig(context.Context, *SetSensorConfigRequest) (*SetSensorConfigResponse, error)
 	GetSensorConfig(context.Context, *GetSensorConfigRequest) (*GetSensorConfigResponse, error)
 	GetStackTraceTree(context.Context, *GetStackTraceTreeRequest) (*GetStackTraceTreeResponse, error)
+	ExportStackTraceTree(context.Context, *ExportStackTraceTreeRequest) (*ExportStackTraceTreeResponse, error)
+	RecordSamples(context.Context, *RecordSamplesRequest) (*RecordSamplesResponse, error)
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
 }
 
@@ -216,6 +238,12 @@ func (UnimplementedFineGuidanceSensorsServer) GetSensorConfig(context.Context, *
 func (UnimplementedFineGuidanceSensorsServer) GetStackTraceTree(context.Context, *GetStackTraceTreeRequest) (*GetStackTraceTreeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStackTraceTree not implemented")
 }
+func (UnimplementedFineGuidanceSensorsServer) ExportStackTraceTree(context.Context, *ExportStackTraceTreeRequest) (*ExportStackTraceTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportStackTraceTree not implemented")
+}
+func (UnimplementedFineGuidanceSensorsServer) RecordSamples(context.Context, *RecordSamplesRequest) (*RecordSamplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSamples not implemented")
+}
 func (UnimplementedFineGuidanceSensorsServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
 }
@@ -414,6 +442,42 @@ func _FineGuidanceSensors_GetStackTraceTree_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FineGuidanceSensors_ExportStackTraceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportStackTraceTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineGuidanceSensorsServer).ExportStackTraceTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tetragon.FineGuidanceSensors/ExportStackTraceTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineGuidanceSensorsServer).ExportStackTraceTree(ctx, req.(*ExportStackTraceTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FineGuidanceSensors_RecordSamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSamplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineGuidanceSensorsServer).RecordSamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tetragon.FineGuidanceSensors/RecordSamples",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineGuidanceSensorsServer).RecordSamples(ctx, req.(*RecordSamplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FineGuidanceSensors_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetVersionRequest)
 	if err := dec(in); err != nil {
@@ -475,6 +539,14 @@ var FineGuidanceSensors_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStackTraceTree",
 			Handler:    _FineGuidanceSensors_GetStackTraceTree_Handler,
 		},
+		{
+			MethodName: "ExportStackTraceTree",
+			Handler:    _FineGuidanceSensors_ExportStackTraceTree_Handler,
+		},
+		{
+			MethodName: "RecordSamples",
+			Handler:    _FineGuidanceSensors_RecordSamples_Handler,
+		},
 		{
 			MethodName: "GetVersion",
 			Handler:    _FineGuidanceSensors_GetVersion_Handler,
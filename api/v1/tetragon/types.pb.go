@@ -105,6 +105,126 @@ func (event *ProcessTracepoint) SetParent(p *Process) {
 	event.Parent = p
 }
 
+// Encapsulate implements the Event interface.
+// Returns the event wrapped by its GetEventsResponse_* type.
+func (event *ProcessCredentialsChange) Encapsulate() IsGetEventsResponse_Event {
+	return &GetEventsResponse_ProcessCredentialsChange{
+		ProcessCredentialsChange: event,
+	}
+}
+
+// SetProcess implements the ProcessEvent interface.
+// Sets the Process field of an event.
+func (event *ProcessCredentialsChange) SetProcess(p *Process) {
+	event.Process = p
+}
+
+// SetParent implements the ParentEvent interface.
+// Sets the Parent field of an event.
+func (event *ProcessCredentialsChange) SetParent(p *Process) {
+	event.Parent = p
+}
+
+// Encapsulate implements the Event interface.
+// Returns the event wrapped by its GetEventsResponse_* type.
+func (event *ProcessNamespaceChange) Encapsulate() IsGetEventsResponse_Event {
+	return &GetEventsResponse_ProcessNamespaceChange{
+		ProcessNamespaceChange: event,
+	}
+}
+
+// SetProcess implements the ProcessEvent interface.
+// Sets the Process field of an event.
+func (event *ProcessNamespaceChange) SetProcess(p *Process) {
+	event.Process = p
+}
+
+// SetParent implements the ParentEvent interface.
+// Sets the Parent field of an event.
+func (event *ProcessNamespaceChange) SetParent(p *Process) {
+	event.Parent = p
+}
+
+// Encapsulate implements the Event interface.
+// Returns the event wrapped by its GetEventsResponse_* type.
+func (event *ProcessKernelModuleLoad) Encapsulate() IsGetEventsResponse_Event {
+	return &GetEventsResponse_ProcessKernelModuleLoad{
+		ProcessKernelModuleLoad: event,
+	}
+}
+
+// SetProcess implements the ProcessEvent interface.
+// Sets the Process field of an event.
+func (event *ProcessKernelModuleLoad) SetProcess(p *Process) {
+	event.Process = p
+}
+
+// SetParent implements the ParentEvent interface.
+// Sets the Parent field of an event.
+func (event *ProcessKernelModuleLoad) SetParent(p *Process) {
+	event.Parent = p
+}
+
+// Encapsulate implements the Event interface.
+// Returns the event wrapped by its GetEventsResponse_* type.
+func (event *ProcessBpfProgLoad) Encapsulate() IsGetEventsResponse_Event {
+	return &GetEventsResponse_ProcessBpfProgLoad{
+		ProcessBpfProgLoad: event,
+	}
+}
+
+// SetProcess implements the ProcessEvent interface.
+// Sets the Process field of an event.
+func (event *ProcessBpfProgLoad) SetProcess(p *Process) {
+	event.Process = p
+}
+
+// SetParent implements the ParentEvent interface.
+// Sets the Parent field of an event.
+func (event *ProcessBpfProgLoad) SetParent(p *Process) {
+	event.Parent = p
+}
+
+// Encapsulate implements the Event interface.
+// Returns the event wrapped by its GetEventsResponse_* type.
+func (event *ProcessBpfMapCreate) Encapsulate() IsGetEventsResponse_Event {
+	return &GetEventsResponse_ProcessBpfMapCreate{
+		ProcessBpfMapCreate: event,
+	}
+}
+
+// SetProcess implements the ProcessEvent interface.
+// Sets the Process field of an event.
+func (event *ProcessBpfMapCreate) SetProcess(p *Process) {
+	event.Process = p
+}
+
+// SetParent implements the ParentEvent interface.
+// Sets the Parent field of an event.
+func (event *ProcessBpfMapCreate) SetParent(p *Process) {
+	event.Parent = p
+}
+
+// Encapsulate implements the Event interface.
+// Returns the event wrapped by its GetEventsResponse_* type.
+func (event *ProcessMount) Encapsulate() IsGetEventsResponse_Event {
+	return &GetEventsResponse_ProcessMount{
+		ProcessMount: event,
+	}
+}
+
+// SetProcess implements the ProcessEvent interface.
+// Sets the Process field of an event.
+func (event *ProcessMount) SetProcess(p *Process) {
+	event.Process = p
+}
+
+// SetParent implements the ParentEvent interface.
+// Sets the Parent field of an event.
+func (event *ProcessMount) SetParent(p *Process) {
+	event.Parent = p
+}
+
 // Encapsulate implements the Event interface.
 // Returns the event wrapped by its GetEventsResponse_* type.
 func (event *Test) Encapsulate() IsGetEventsResponse_Event {
@@ -112,3 +232,11 @@ func (event *Test) Encapsulate() IsGetEventsResponse_Event {
 		Test: event,
 	}
 }
+
+// Encapsulate implements the Event interface.
+// Returns the event wrapped by its GetEventsResponse_* type.
+func (event *EventsLost) Encapsulate() IsGetEventsResponse_Event {
+	return &GetEventsResponse_EventsLost{
+		EventsLost: event,
+	}
+}
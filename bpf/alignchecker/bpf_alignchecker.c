@@ -34,6 +34,12 @@ int main(void)
 	DECLARE(struct, msg_execve_event, iter);
 	DECLARE(struct, msg_exit, iter);
 	DECLARE(struct, msg_test, iter);
+	DECLARE(struct, msg_cred_change, iter);
+	DECLARE(struct, msg_ns_change, iter);
+	DECLARE(struct, msg_kmod_load, iter);
+	DECLARE(struct, msg_bpf_prog_load, iter);
+	DECLARE(struct, msg_bpf_map_create, iter);
+	DECLARE(struct, msg_mount, iter);
 
 	// from maps
 	DECLARE(struct, event, iter);
@@ -25,6 +25,13 @@ struct msg_generic_kprobe {
 	__u64 id;
 	__u64 thread_id;
 	__u64 action;
+	/* 1 + index of the selector that matched, 0 if there are no selectors */
+	__u64 selector;
+	/* sizes in bytes of the stack traces copied after the args, 0 if not
+	 * captured and negative on errors
+	 */
+	__s64 kernel_stack_size;
+	__s64 user_stack_size;
 	/* anything above is shared with the userspace so it should match structs MsgGenericKprobe and MsgGenericTracepoint in Go */
 	char args[24000];
 	unsigned long a0, a1, a2, a3, a4;
@@ -40,6 +47,9 @@ struct msg_generic_kprobe {
 #endif
 };
 
+#define PERF_MAX_STACK_DEPTH 127
+#define STACK_SIZE	     (sizeof(__u64) * PERF_MAX_STACK_DEPTH)
+
 static inline __attribute__((always_inline)) size_t generic_kprobe_common_size()
 {
 	return offsetof(struct msg_generic_kprobe, args);
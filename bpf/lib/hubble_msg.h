@@ -8,6 +8,7 @@
 #include "common.h"
 #include "process.h"
 #include "bpf_helpers.h"
+#include "globals.h"
 
 struct msg_calltrace {
 	__u64 stack[16];
@@ -18,9 +19,46 @@ struct event {
 	int event;
 };
 
+/* Events are sent to user space through tcpmon_map. The loader turns it into
+ * a BPF_MAP_TYPE_RINGBUF on kernels supporting it and sets g_events_ringbuf
+ * then, see pkg/sensors/program/events.go.
+ */
 struct bpf_map_def __attribute__((section("maps"), used)) tcpmon_map = {
 	.type = BPF_MAP_TYPE_PERF_EVENT_ARRAY,
 	.key_size = sizeof(int),
 	.value_size = sizeof(struct event),
 };
+
+/* Number of events that could not be reserved in the ring buffer */
+struct bpf_map_def __attribute__((section("maps"), used)) tcpmon_map_errors = {
+	.type = BPF_MAP_TYPE_PERCPU_ARRAY,
+	.key_size = sizeof(__s32),
+	.value_size = sizeof(__s64),
+	.max_entries = 1,
+};
+
+GLOBAL_U32 g_events_ringbuf;
+
+/* Returns a negative error if the event could not be sent. */
+static inline __attribute__((always_inline)) long
+event_output(void *ctx, void *data, __u64 size)
+{
+	__s64 *cntr;
+	__s32 zero = 0;
+	long err;
+
+	/* The branch not taken is pruned by the verifier, so that the helper
+	 * of the other transport is not checked against tcpmon_map.
+	 */
+	if (!READ_GLOBAL(g_events_ringbuf))
+		return perf_event_output(ctx, &tcpmon_map, BPF_F_CURRENT_CPU,
+					 data, size);
+	err = ringbuf_output(&tcpmon_map, data, size, 0);
+	if (err < 0) {
+		cntr = map_lookup_elem(&tcpmon_map_errors, &zero);
+		if (cntr)
+			*cntr = *cntr + 1;
+	}
+	return err;
+}
 #endif // __HUBBLE_MSG_
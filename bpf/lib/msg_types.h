@@ -26,6 +26,13 @@ enum msg_ops {
 
 	MSG_OP_DATA = 24,
 
+	MSG_OP_CRED_CHANGE = 25,
+	MSG_OP_NS_CHANGE = 26,
+	MSG_OP_KMOD_LOAD = 27,
+	MSG_OP_BPF_PROG_LOAD = 28,
+	MSG_OP_BPF_MAP_CREATE = 29,
+	MSG_OP_MOUNT = 30,
+
 	MSG_OP_MAX,
 };
 #endif // _MSG_TYPES_
@@ -195,6 +195,103 @@ enum {
 	caps_inheritable = 2,
 };
 
+struct msg_cred_ids {
+	union {
+		struct {
+			__u32 uid;
+			__u32 gid;
+			__u32 suid;
+			__u32 sgid;
+			__u32 euid;
+			__u32 egid;
+			__u32 fsuid;
+			__u32 fsgid;
+			__u32 securebits;
+			__u32 pad;
+		};
+		__u64 raw[5];
+	};
+}; // All fields aligned so no 'packed' attribute.
+
+/* msg_cred_change is emitted when commit_creds() changes the ids,
+ * capabilities or securebits of a process.
+ */
+struct msg_cred_change {
+	struct msg_common common;
+	struct msg_execve_key current;
+	struct msg_cred_ids old_ids;
+	struct msg_cred_ids new_ids;
+	struct msg_capabilities old_caps;
+	struct msg_capabilities new_caps;
+	__s64 syscall;
+}; // All fields aligned so no 'packed' attribute.
+
+#define KMOD_NAME_LEN 56
+#define BPF_TAG_LEN   8
+#define BPF_NAME_LEN  16
+
+/* Kernel integrity events, see bpf_integrity.c */
+struct msg_kmod_load {
+	struct msg_common common;
+	struct msg_execve_key current;
+	char name[KMOD_NAME_LEN];
+	__u64 taints;
+	__u8 sig_ok;
+	__u8 sig_known;
+	__u8 pad[6];
+	__s64 syscall;
+}; // All fields aligned so no 'packed' attribute.
+
+struct msg_bpf_prog_load {
+	struct msg_common common;
+	struct msg_execve_key current;
+	__u32 prog_type;
+	__u32 attach_type;
+	__u32 insn_cnt;
+	__u32 id;
+	__u8 tag[BPF_TAG_LEN];
+	char name[BPF_NAME_LEN];
+}; // All fields aligned so no 'packed' attribute.
+
+struct msg_bpf_map_create {
+	struct msg_common common;
+	struct msg_execve_key current;
+	__u32 map_type;
+	__u32 key_size;
+	__u32 value_size;
+	__u32 max_entries;
+	__u32 map_flags;
+	__u32 pad;
+	char name[BPF_NAME_LEN];
+}; // All fields aligned so no 'packed' attribute.
+
+#define MOUNT_PATH_LEN	 256
+#define MOUNT_FSTYPE_LEN 32
+
+enum {
+	MOUNT_OP_MOUNT = 1,
+	MOUNT_OP_UMOUNT = 2,
+	MOUNT_OP_PIVOT_ROOT = 3,
+	MOUNT_OP_MOVE_MOUNT = 4,
+	MOUNT_OP_FSOPEN = 5,
+	MOUNT_OP_FSMOUNT = 6,
+};
+
+struct msg_mount {
+	struct msg_common common;
+	struct msg_execve_key current;
+	__u32 op;
+	__u32 target_flags;
+	__u64 flags;
+	__u32 mnt_ns;
+	__u32 source_len;
+	__u32 target_len;
+	__u32 pad;
+	char source[MOUNT_PATH_LEN];
+	char target[MOUNT_PATH_LEN];
+	char fstype[MOUNT_FSTYPE_LEN];
+}; // All fields aligned so no 'packed' attribute.
+
 struct exit_info {
 	__u32 code;
 	__u32 pad;
@@ -242,6 +339,20 @@ struct msg_ns {
 	};
 }; // All fields aligned so no 'packed' attribute.
 
+/* msg_ns_change is emitted when setns(), unshare() or clone() change the
+ * namespaces of a process. For clone() new_ns are the namespaces of the
+ * child identified by child_pid.
+ */
+struct msg_ns_change {
+	struct msg_common common;
+	struct msg_execve_key current;
+	struct msg_ns old_ns;
+	struct msg_ns new_ns;
+	__u32 child_pid;
+	__u32 pad;
+	__s64 syscall;
+}; // All fields aligned so no 'packed' attribute.
+
 struct msg_k8s {
 	__u32 net_ns;
 	__u32 cid;
@@ -249,6 +360,15 @@ struct msg_k8s {
 	char docker_id[DOCKER_ID_LENGTH];
 }; // All fields aligned so no 'packed' attribute.
 
+/* msg_exe identifies the executed file, userspace uses it to check that
+ * it reads the same file through procfs.
+ */
+struct msg_exe {
+	__u64 ino;
+	__u32 dev;
+	__u32 pad;
+};
+
 struct msg_execve_event {
 	struct msg_common common;
 	struct msg_k8s kube;
@@ -256,6 +376,7 @@ struct msg_execve_event {
 	__u64 parent_flags;
 	struct msg_capabilities caps;
 	struct msg_ns ns;
+	struct msg_exe exe;
 	/* if add anything above please also update the args of
 	 * validate_msg_execve_size() in bpf_execve_event.c */
 	union {
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: GPL-2.0
+/* Copyright Authors of Cilium */
+
+#include "vmlinux.h"
+#include "api.h"
+#include "bpf_tracing.h"
+
+#include "hubble_msg.h"
+#include "bpf_events.h"
+#include "bpf_process_event.h"
+#include "types/operations.h"
+
+char _license[] __attribute__((section("license"), used)) = "GPL";
+#ifdef VMLINUX_KERNEL_VERSION
+int _version __attribute__((section(("version")), used)) =
+	VMLINUX_KERNEL_VERSION;
+#endif
+
+/* cred_filter restricts which capability-only changes are reported. It
+ * follows the matchCapabilityChanges semantics: with op_filter_in an event
+ * is sent if any of the capabilities in val changed, with op_filter_notin
+ * if any capability outside of val changed. Changes of uids, gids or
+ * securebits are always reported.
+ */
+struct cred_filter {
+	__u32 enabled;
+	__u32 ty;
+	__u32 op;
+	__u32 ns;
+	__u64 val;
+};
+
+struct bpf_map_def __attribute__((section("maps"), used)) cred_filter_map = {
+	.type = BPF_MAP_TYPE_ARRAY,
+	.key_size = sizeof(int),
+	.value_size = sizeof(struct cred_filter),
+	.max_entries = 1,
+};
+
+struct bpf_map_def __attribute__((section("maps"), used)) cred_heap_map = {
+	.type = BPF_MAP_TYPE_PERCPU_ARRAY,
+	.key_size = sizeof(int),
+	.value_size = sizeof(struct msg_cred_change),
+	.max_entries = 1,
+};
+
+static inline __attribute__((always_inline)) void
+get_cred_ids(struct msg_cred_ids *ids, const struct cred *cred)
+{
+	/* uid, gid, suid, sgid, euid, egid, fsuid, fsgid and securebits are
+	 * laid out contiguously in struct cred.
+	 */
+	probe_read(&ids->uid, 9 * sizeof(__u32), _(&cred->uid));
+	ids->pad = 0;
+}
+
+static inline __attribute__((always_inline)) void
+get_cred_caps(struct msg_capabilities *caps, const struct cred *cred)
+{
+	probe_read(&caps->effective, sizeof(__u64), _(&cred->cap_effective));
+	probe_read(&caps->inheritable, sizeof(__u64),
+		   _(&cred->cap_inheritable));
+	probe_read(&caps->permitted, sizeof(__u64), _(&cred->cap_permitted));
+}
+
+static inline __attribute__((always_inline)) bool
+cred_filter_caps(struct msg_cred_change *msg, const struct cred *cred)
+{
+	struct cred_filter *filter;
+	struct user_namespace *ns;
+	__u64 ocaps, ncaps;
+	__u32 inum = 0;
+	int zero = 0;
+
+	filter = map_lookup_elem(&cred_filter_map, &zero);
+	if (!filter || !filter->enabled)
+		return true;
+
+	/* if ns != 0 we care only for changes outside the host user namespace */
+	if (filter->ns) {
+		probe_read(&ns, sizeof(ns), _(&cred->user_ns));
+		probe_read(&inum, sizeof(inum), _(&ns->ns.inum));
+		if (inum == filter->ns)
+			return false;
+	}
+
+	if (filter->ty > caps_inheritable)
+		return false;
+
+	ocaps = msg->old_caps.c[filter->ty];
+	ncaps = msg->new_caps.c[filter->ty];
+
+	if ((ocaps & filter->val) != (ncaps & filter->val))
+		return filter->op == op_filter_in;
+	if (ocaps != ncaps)
+		return filter->op == op_filter_notin;
+	return false;
+}
+
+__attribute__((section("kprobe/commit_creds"), used)) int
+BPF_KPROBE(event_commit_creds, struct cred *new)
+{
+	struct execve_map_value *enter;
+	struct msg_cred_change *msg;
+	struct task_struct *task;
+	const struct cred *old;
+	bool ids_changed = false;
+	int i, zero = 0;
+	__u32 pid;
+
+	pid = (get_current_pid_tgid() >> 32);
+	enter = execve_map_get_noinit(pid);
+	if (!enter || !enter->key.ktime)
+		return 0;
+
+	msg = map_lookup_elem(&cred_heap_map, &zero);
+	if (!msg)
+		return 0;
+
+	task = (struct task_struct *)get_current_task();
+	probe_read(&old, sizeof(old), _(&task->real_cred));
+
+	get_cred_ids(&msg->old_ids, old);
+	get_cred_ids(&msg->new_ids, new);
+	get_cred_caps(&msg->old_caps, old);
+	get_cred_caps(&msg->new_caps, new);
+
+#pragma unroll
+	for (i = 0; i < 5; i++) {
+		if (msg->old_ids.raw[i] != msg->new_ids.raw[i])
+			ids_changed = true;
+	}
+
+	if (!ids_changed) {
+		if (msg->old_caps.permitted == msg->new_caps.permitted &&
+		    msg->old_caps.effective == msg->new_caps.effective &&
+		    msg->old_caps.inheritable == msg->new_caps.inheritable)
+			return 0;
+		if (!cred_filter_caps(msg, new))
+			return 0;
+	}
+
+	msg->common.op = MSG_OP_CRED_CHANGE;
+	msg->common.flags = 0;
+	msg->common.pad[0] = 0;
+	msg->common.pad[1] = 0;
+	msg->common.size = sizeof(struct msg_cred_change);
+	msg->common.ktime = ktime_get_ns();
+	msg->current.pid = enter->key.pid;
+	msg->current.pad[0] = 0;
+	msg->current.pad[1] = 0;
+	msg->current.pad[2] = 0;
+	msg->current.pad[3] = 0;
+	msg->current.ktime = enter->key.ktime;
+	msg->syscall = get_task_syscall(task);
+
+	event_output(ctx, msg, sizeof(struct msg_cred_change));
+	return 0;
+}
@@ -145,6 +145,33 @@ event_filename_builder(void *ctx, struct msg_process *curr, __u32 curr_pid,
 	return bin;
 }
 
+static inline __attribute__((always_inline)) void
+event_exe_builder(struct task_struct *task, struct msg_exe *exe)
+{
+	struct super_block *sb;
+	struct inode *inode;
+	struct file *file;
+	struct mm_struct *mm;
+
+	exe->ino = 0;
+	exe->dev = 0;
+	exe->pad = 0;
+
+	probe_read(&mm, sizeof(mm), _(&task->mm));
+	if (!mm)
+		return;
+	probe_read(&file, sizeof(file), _(&mm->exe_file));
+	if (!file)
+		return;
+	probe_read(&inode, sizeof(inode), _(&file->f_inode));
+	if (!inode)
+		return;
+	probe_read(&exe->ino, sizeof(exe->ino), _(&inode->i_ino));
+	probe_read(&sb, sizeof(sb), _(&inode->i_sb));
+	if (sb)
+		probe_read(&exe->dev, sizeof(exe->dev), _(&sb->s_dev));
+}
+
 __attribute__((section("tracepoint/sys_execve"), used)) int
 event_execve(struct sched_execve_args *ctx)
 {
@@ -179,6 +206,7 @@ event_execve(struct sched_execve_args *ctx)
 	binary = event_filename_builder(ctx, execve, pid, EVENT_EXECVE, binary,
 					(char *)ctx + fileoff);
 	event_args_builder(ctx, event);
+	event_exe_builder(task, &event->exe);
 	compiler_barrier();
 	__event_get_task_info(event, MSG_OP_EXECVE, walker, true);
 
@@ -228,7 +256,7 @@ event_execve(struct sched_execve_args *ctx)
 		sizeof(struct msg_common) + sizeof(struct msg_k8s) +
 		sizeof(struct msg_execve_key) + sizeof(__u64) +
 		sizeof(struct msg_capabilities) + sizeof(struct msg_ns) +
-		execve->size);
-	perf_event_output(ctx, &tcpmon_map, BPF_F_CURRENT_CPU, event, size);
+		sizeof(struct msg_exe) + execve->size);
+	event_output(ctx, event, size);
 	return 0;
 }
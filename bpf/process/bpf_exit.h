@@ -70,8 +70,7 @@ event_exit_send(struct sched_execve_args *ctx, __u64 current)
 		probe_read(&exit->info.code, sizeof(exit->info.code),
 			   _(&task->exit_code));
 
-		perf_event_output(ctx, &tcpmon_map, BPF_F_CURRENT_CPU, exit,
-				  size);
+		event_output(ctx, exit, size);
 	}
 	execve_map_delete(tgid);
 }
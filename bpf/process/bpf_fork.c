@@ -55,8 +55,7 @@ BPF_KPROBE(event_wake_up_new_task, struct task_struct *task)
 		msg.flags = curr->flags;
 		msg.ktime = curr->key.ktime;
 
-		perf_event_output(ctx, &tcpmon_map, BPF_F_CURRENT_CPU, &msg,
-				  size);
+		event_output(ctx, &msg, size);
 	}
 	return 0;
 }
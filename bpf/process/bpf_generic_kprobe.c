@@ -69,6 +69,9 @@ generic_kprobe_start_process_filter(void *ctx)
 		msg->active[i] = 0;
 	/* Initialize accept field to reject */
 	msg->pass = 0;
+	msg->selector = 0;
+	msg->kernel_stack_size = 0;
+	msg->user_stack_size = 0;
 	task = (struct task_struct *)get_current_task();
 	/* Initialize namespaces to apply filters on them */
 	get_namespaces(&(msg->ns), task);
@@ -168,6 +171,8 @@ generic_kprobe_process_filter(void *ctx)
 	/* If filter does not accept drop it. Ideally we would
 	 * log error codes for later review, TBD.
 	 */
+	if (ret == PFILTER_REJECT)
+		policy_stats_inc(POLICY_STATS_FILTERED);
 	return PFILTER_REJECT;
 }
 
@@ -97,6 +97,10 @@ BPF_KRETPROBE(generic_retkprobe_event, unsigned long ret)
 	e->current.pad[3] = 0;
 
 	e->id = config->func_id;
+	/* The selector and stack traces are reported by the entry event. */
+	e->selector = 0;
+	e->kernel_stack_size = 0;
+	e->user_stack_size = 0;
 
 	total = size;
 	total += generic_kprobe_common_size();
@@ -108,6 +112,7 @@ BPF_KRETPROBE(generic_retkprobe_event, unsigned long ret)
 		     : [total] "+r"(total)
 		     :);
 	e->common.size = total;
-	perf_event_output(ctx, &tcpmon_map, BPF_F_CURRENT_CPU, e, total);
+	if (event_output(ctx, e, total) < 0)
+		policy_stats_inc(POLICY_STATS_LOST);
 	return 0;
 }
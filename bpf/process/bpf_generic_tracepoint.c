@@ -50,14 +50,15 @@ struct generic_tracepoint_event_arg {
 	/* tracepoint specific fields ... */
 };
 
-static inline __attribute__((always_inline)) unsigned long get_ctx_ul(void *src,
-								      int type)
+static inline __attribute__((always_inline)) unsigned long
+get_ctx_ul(void *ctx, void *src, int type)
 {
 	switch (type) {
 	case nop_s64_ty:
 	case nop_u64_ty:
 	case s64_ty:
-	case u64_ty: {
+	case u64_ty:
+	case syscall_id_type: {
 		u64 ret;
 		probe_read(&ret, sizeof(u64), src);
 		return ret;
@@ -89,10 +90,20 @@ static inline __attribute__((always_inline)) unsigned long get_ctx_ul(void *src,
 		return (unsigned long)buff;
 	}
 
-	case const_buf_type: {
+	case const_buf_type:
+	case syscall_args_type: {
 		return (unsigned long)src;
 	}
 
+	/* string_type arguments are __data_loc char[] fields: the lower 16 bits
+	 * are the offset of the string in ctx.
+	 */
+	case string_type: {
+		u32 loc;
+		probe_read(&loc, sizeof(loc), src);
+		return (unsigned long)ctx + (loc & 0xffff);
+	}
+
 	default:
 	case nop:
 		return 0;
@@ -119,7 +130,7 @@ generic_tracepoint_event(struct generic_tracepoint_event_arg *ctx)
 		int ty = config->arg0;
 		asm volatile("%[ctx_off] &= 0xffff;\n" ::[ctx_off] "+r"(ctx_off)
 			     :);
-		get_ctx_ul((char *)ctx + ctx_off, ty);
+		get_ctx_ul(ctx, (char *)ctx + ctx_off, ty);
 	});
 
 	msg->a1 = ({
@@ -127,7 +138,7 @@ generic_tracepoint_event(struct generic_tracepoint_event_arg *ctx)
 		int ty = config->arg1;
 		asm volatile("%[ctx_off] &= 0xffff;\n" ::[ctx_off] "+r"(ctx_off)
 			     :);
-		get_ctx_ul((char *)ctx + ctx_off, ty);
+		get_ctx_ul(ctx, (char *)ctx + ctx_off, ty);
 	});
 
 	msg->a2 = ({
@@ -135,7 +146,7 @@ generic_tracepoint_event(struct generic_tracepoint_event_arg *ctx)
 		int ty = config->arg2;
 		asm volatile("%[ctx_off] &= 0xffff;\n" ::[ctx_off] "+r"(ctx_off)
 			     :);
-		get_ctx_ul((char *)ctx + ctx_off, ty);
+		get_ctx_ul(ctx, (char *)ctx + ctx_off, ty);
 	});
 
 	msg->a3 = ({
@@ -143,7 +154,7 @@ generic_tracepoint_event(struct generic_tracepoint_event_arg *ctx)
 		int ty = config->arg3;
 		asm volatile("%[ctx_off] &= 0xffff;\n" ::[ctx_off] "+r"(ctx_off)
 			     :);
-		get_ctx_ul((char *)ctx + ctx_off, ty);
+		get_ctx_ul(ctx, (char *)ctx + ctx_off, ty);
 	});
 
 	msg->a4 = ({
@@ -151,7 +162,7 @@ generic_tracepoint_event(struct generic_tracepoint_event_arg *ctx)
 		int ty = config->arg4;
 		asm volatile("%[ctx_off] &= 0xffff;\n" ::[ctx_off] "+r"(ctx_off)
 			     :);
-		get_ctx_ul((char *)ctx + ctx_off, ty);
+		get_ctx_ul(ctx, (char *)ctx + ctx_off, ty);
 	});
 
 	msg->common.op = MSG_OP_GENERIC_TRACEPOINT;
@@ -160,6 +171,9 @@ generic_tracepoint_event(struct generic_tracepoint_event_arg *ctx)
 	for (i = 0; i < MAX_CONFIGURED_SELECTORS; i++)
 		msg->active[i] = 0;
 	msg->pass = 0;
+	msg->selector = 0;
+	msg->kernel_stack_size = 0;
+	msg->user_stack_size = 0;
 	tail_call(ctx, &tp_calls, 5);
 	return 0;
 }
@@ -217,6 +231,8 @@ generic_tracepoint_filter(void *ctx)
 	/* If filter does not accept drop it. Ideally we would
 	 * log error codes for later review, TBD.
 	 */
+	if (ret == PFILTER_REJECT)
+		policy_stats_inc(POLICY_STATS_FILTERED);
 	return PFILTER_REJECT;
 }
 
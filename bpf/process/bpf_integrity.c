@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: GPL-2.0
+/* Copyright Authors of Cilium */
+
+#include "vmlinux.h"
+#include "api.h"
+#include "bpf_tracing.h"
+
+#include "hubble_msg.h"
+#include "bpf_events.h"
+#include "bpf_process_event.h"
+
+char _license[] __attribute__((section("license"), used)) = "GPL";
+#ifdef VMLINUX_KERNEL_VERSION
+int _version __attribute__((section(("version")), used)) =
+	VMLINUX_KERNEL_VERSION;
+#endif
+
+/* integrity_config holds the default selectors of the kernel integrity
+ * sensor. Events from exclude_pid, i.e. tetragon itself, are not reported.
+ */
+struct integrity_config {
+	__u32 exclude_pid;
+	__u32 pad;
+};
+
+struct bpf_map_def __attribute__((section("maps"), used)) integrity_config_map = {
+	.type = BPF_MAP_TYPE_ARRAY,
+	.key_size = sizeof(int),
+	.value_size = sizeof(struct integrity_config),
+	.max_entries = 1,
+};
+
+/* integrity_heap_map is shared by all messages, msg_kmod_load is the largest. */
+struct bpf_map_def __attribute__((section("maps"), used)) integrity_heap_map = {
+	.type = BPF_MAP_TYPE_PERCPU_ARRAY,
+	.key_size = sizeof(int),
+	.value_size = sizeof(struct msg_kmod_load),
+	.max_entries = 1,
+};
+
+/* From include/linux/perf_event.h */
+#define PERF_BPF_EVENT_PROG_LOAD 1
+
+static inline __attribute__((always_inline)) struct execve_map_value *
+integrity_enter(void)
+{
+	struct integrity_config *config;
+	struct execve_map_value *enter;
+	int zero = 0;
+	__u32 pid;
+
+	pid = (get_current_pid_tgid() >> 32);
+	config = map_lookup_elem(&integrity_config_map, &zero);
+	if (config && config->exclude_pid == pid)
+		return 0;
+
+	enter = execve_map_get_noinit(pid);
+	if (!enter || !enter->key.ktime)
+		return 0;
+	return enter;
+}
+
+static inline __attribute__((always_inline)) void
+integrity_common(struct msg_common *common, struct msg_execve_key *current,
+		 struct execve_map_value *enter, __u8 op, __u32 size)
+{
+	common->op = op;
+	common->flags = 0;
+	common->pad[0] = 0;
+	common->pad[1] = 0;
+	common->size = size;
+	common->ktime = ktime_get_ns();
+	current->pid = enter->key.pid;
+	current->pad[0] = 0;
+	current->pad[1] = 0;
+	current->pad[2] = 0;
+	current->pad[3] = 0;
+	current->ktime = enter->key.ktime;
+}
+
+/* do_init_module() is called by both init_module() and finit_module() once
+ * the module was loaded and its signature checked, right before its init
+ * function runs.
+ */
+__attribute__((section("kprobe/do_init_module"), used)) int
+BPF_KPROBE(event_kmod_load, struct module *mod)
+{
+	struct execve_map_value *enter;
+	struct task_struct *task;
+	struct msg_kmod_load *msg;
+	int zero = 0;
+
+	enter = integrity_enter();
+	if (!enter)
+		return 0;
+
+	msg = map_lookup_elem(&integrity_heap_map, &zero);
+	if (!msg)
+		return 0;
+
+	probe_read(msg->name, sizeof(msg->name), _(mod->name));
+	probe_read(&msg->taints, sizeof(msg->taints), _(&mod->taints));
+	msg->sig_ok = 0;
+	msg->sig_known = 0;
+	if (bpf_core_field_exists(mod->sig_ok)) {
+		probe_read(&msg->sig_ok, sizeof(msg->sig_ok), _(&mod->sig_ok));
+		msg->sig_known = 1;
+	}
+	msg->pad[0] = 0;
+	msg->pad[1] = 0;
+	msg->pad[2] = 0;
+	msg->pad[3] = 0;
+	msg->pad[4] = 0;
+	msg->pad[5] = 0;
+
+	task = (struct task_struct *)get_current_task();
+	msg->syscall = get_task_syscall(task);
+
+	integrity_common(&msg->common, &msg->current, enter, MSG_OP_KMOD_LOAD,
+			 sizeof(struct msg_kmod_load));
+	event_output(ctx, msg, sizeof(struct msg_kmod_load));
+	return 0;
+}
+
+/* perf_event_bpf_event() is called with PERF_BPF_EVENT_PROG_LOAD once per
+ * loaded program, after verification and JIT, so unlike bpf_check() it
+ * reports only programs that were actually loaded.
+ */
+__attribute__((section("kprobe/perf_event_bpf_event"), used)) int
+BPF_KPROBE(event_bpf_prog_load, struct bpf_prog *prog, int type)
+{
+	struct execve_map_value *enter;
+	struct msg_bpf_prog_load *msg;
+	struct bpf_prog_aux *aux;
+	int zero = 0;
+
+	if (type != PERF_BPF_EVENT_PROG_LOAD)
+		return 0;
+
+	enter = integrity_enter();
+	if (!enter)
+		return 0;
+
+	msg = map_lookup_elem(&integrity_heap_map, &zero);
+	if (!msg)
+		return 0;
+
+	probe_read(&msg->prog_type, sizeof(msg->prog_type), _(&prog->type));
+	probe_read(&msg->attach_type, sizeof(msg->attach_type),
+		   _(&prog->expected_attach_type));
+	probe_read(&msg->insn_cnt, sizeof(msg->insn_cnt), _(&prog->len));
+	probe_read(msg->tag, sizeof(msg->tag), _(prog->tag));
+	probe_read(&aux, sizeof(aux), _(&prog->aux));
+	probe_read(&msg->id, sizeof(msg->id), _(&aux->id));
+	probe_read(msg->name, sizeof(msg->name), _(aux->name));
+
+	integrity_common(&msg->common, &msg->current, enter,
+			 MSG_OP_BPF_PROG_LOAD, sizeof(struct msg_bpf_prog_load));
+	event_output(ctx, msg, sizeof(struct msg_bpf_prog_load));
+	return 0;
+}
+
+/* bpf_map_init_from_attr() is called by the map allocators on BPF_MAP_CREATE.
+ * The map is not fully set up at this point, so read everything from attr.
+ */
+__attribute__((section("kprobe/bpf_map_init_from_attr"), used)) int
+BPF_KPROBE(event_bpf_map_create, struct bpf_map *map, union bpf_attr *attr)
+{
+	struct execve_map_value *enter;
+	struct msg_bpf_map_create *msg;
+	int zero = 0;
+
+	enter = integrity_enter();
+	if (!enter)
+		return 0;
+
+	msg = map_lookup_elem(&integrity_heap_map, &zero);
+	if (!msg)
+		return 0;
+
+	probe_read(&msg->map_type, sizeof(msg->map_type), _(&attr->map_type));
+	probe_read(&msg->key_size, sizeof(msg->key_size), _(&attr->key_size));
+	probe_read(&msg->value_size, sizeof(msg->value_size),
+		   _(&attr->value_size));
+	probe_read(&msg->max_entries, sizeof(msg->max_entries),
+		   _(&attr->max_entries));
+	probe_read(&msg->map_flags, sizeof(msg->map_flags),
+		   _(&attr->map_flags));
+	probe_read(msg->name, sizeof(msg->name), _(attr->map_name));
+	msg->pad = 0;
+
+	integrity_common(&msg->common, &msg->current, enter,
+			 MSG_OP_BPF_MAP_CREATE, sizeof(struct msg_bpf_map_create));
+	event_output(ctx, msg, sizeof(struct msg_bpf_map_create));
+	return 0;
+}
@@ -0,0 +1,311 @@
+// SPDX-License-Identifier: GPL-2.0
+/* Copyright Authors of Cilium */
+
+#include "vmlinux.h"
+#include "api.h"
+#include "bpf_tracing.h"
+
+#include "hubble_msg.h"
+#include "bpf_events.h"
+#include "bpf_process_event.h"
+
+char _license[] __attribute__((section("license"), used)) = "GPL";
+#ifdef VMLINUX_KERNEL_VERSION
+int _version __attribute__((section(("version")), used)) =
+	VMLINUX_KERNEL_VERSION;
+#endif
+
+#define MOUNT_FILTER_MAX	4
+#define MOUNT_FILTER_PREFIX_LEN 64
+
+/* System call numbers of fsopen() and fsmount(), the same on all
+ * architectures.
+ */
+#define SYSCALL_FSOPEN	430
+#define SYSCALL_FSMOUNT 432
+
+/* mount_filter holds the target path prefixes to report. If count is zero
+ * all events are reported, otherwise only events whose target starts with
+ * one of the first count prefixes.
+ */
+struct mount_filter {
+	__u32 count;
+	__u32 len[MOUNT_FILTER_MAX];
+	char prefix[MOUNT_FILTER_MAX][MOUNT_FILTER_PREFIX_LEN];
+};
+
+struct bpf_map_def __attribute__((section("maps"), used)) mount_filter_map = {
+	.type = BPF_MAP_TYPE_ARRAY,
+	.key_size = sizeof(int),
+	.value_size = sizeof(struct mount_filter),
+	.max_entries = 1,
+};
+
+struct bpf_map_def __attribute__((section("maps"), used)) mount_heap_map = {
+	.type = BPF_MAP_TYPE_PERCPU_ARRAY,
+	.key_size = sizeof(int),
+	.value_size = sizeof(struct msg_mount),
+	.max_entries = 1,
+};
+
+static inline __attribute__((always_inline)) struct msg_mount *
+mount_prepare(__u32 op)
+{
+	struct execve_map_value *enter;
+	struct task_struct *task;
+	struct nsproxy *nsproxy;
+	struct mnt_namespace *mnt_ns;
+	struct msg_mount *msg;
+	int zero = 0;
+	__u32 pid;
+
+	pid = (get_current_pid_tgid() >> 32);
+	enter = execve_map_get_noinit(pid);
+	if (!enter || !enter->key.ktime)
+		return 0;
+
+	msg = map_lookup_elem(&mount_heap_map, &zero);
+	if (!msg)
+		return 0;
+
+	msg->common.op = MSG_OP_MOUNT;
+	msg->common.flags = 0;
+	msg->common.pad[0] = 0;
+	msg->common.pad[1] = 0;
+	msg->common.size = sizeof(struct msg_mount);
+	msg->common.ktime = ktime_get_ns();
+	msg->current.pid = enter->key.pid;
+	msg->current.pad[0] = 0;
+	msg->current.pad[1] = 0;
+	msg->current.pad[2] = 0;
+	msg->current.pad[3] = 0;
+	msg->current.ktime = enter->key.ktime;
+
+	msg->op = op;
+	msg->target_flags = 0;
+	msg->flags = 0;
+	msg->source_len = 0;
+	msg->target_len = 0;
+	msg->pad = 0;
+	msg->fstype[0] = 0;
+
+	msg->mnt_ns = 0;
+	task = (struct task_struct *)get_current_task();
+	probe_read(&nsproxy, sizeof(nsproxy), _(&task->nsproxy));
+	if (nsproxy) {
+		probe_read(&mnt_ns, sizeof(mnt_ns), _(&nsproxy->mnt_ns));
+		if (mnt_ns)
+			probe_read(&msg->mnt_ns, sizeof(msg->mnt_ns),
+				   _(&mnt_ns->ns.inum));
+	}
+	return msg;
+}
+
+/* mount_read_path resolves path relative to the root of the current process
+ * into dst and returns its length.
+ */
+static inline __attribute__((always_inline)) __u32
+mount_read_path(char *dst, const struct path *path, __u32 *flags)
+{
+	int size = 0, error = 0;
+	char *buffer;
+
+	buffer = d_path_local(path, &size, &error);
+	if (!buffer)
+		return 0;
+
+	asm volatile("%[size] &= 0xff;\n" ::[size] "+r"(size) :);
+	probe_read(dst, size, buffer);
+	if (flags && (error & UNRESOLVED_PATH_COMPONENTS))
+		*flags |= UNRESOLVED_PATH_COMPONENTS;
+	return size;
+}
+
+static inline __attribute__((always_inline)) __u32
+mount_read_str(char *dst, __u32 size, const char *src)
+{
+	long ret;
+
+	if (!src)
+		return 0;
+	ret = probe_read_str(dst, size, src);
+	if (ret <= 0)
+		return 0;
+	/* Do not count the terminating NUL. */
+	return ret - 1;
+}
+
+static inline __attribute__((always_inline)) bool
+mount_filter_match(struct msg_mount *msg)
+{
+	struct mount_filter *filter;
+	int zero = 0, i, j;
+
+	filter = map_lookup_elem(&mount_filter_map, &zero);
+	if (!filter || !filter->count)
+		return true;
+
+#pragma unroll
+	for (i = 0; i < MOUNT_FILTER_MAX; i++) {
+		bool match = true;
+		__u32 len;
+
+		if (i >= filter->count)
+			break;
+		len = filter->len[i];
+		if (len > msg->target_len)
+			continue;
+#pragma unroll
+		for (j = 0; j < MOUNT_FILTER_PREFIX_LEN; j++) {
+			if (j >= len)
+				break;
+			if (filter->prefix[i][j] != msg->target[j]) {
+				match = false;
+				break;
+			}
+		}
+		if (match)
+			return true;
+	}
+	return false;
+}
+
+static inline __attribute__((always_inline)) void
+mount_send(void *ctx, struct msg_mount *msg)
+{
+	if (!mount_filter_match(msg))
+		return;
+	event_output(ctx, msg, sizeof(struct msg_mount));
+}
+
+/* path_mount() does the work of mount(2) once the strings were copied from
+ * user-space, so dev_name and type_page are kernel strings.
+ */
+__attribute__((section("kprobe/path_mount"), used)) int
+BPF_KPROBE(event_path_mount, const char *dev_name, struct path *path,
+	   const char *type_page, unsigned long flags)
+{
+	struct msg_mount *msg;
+
+	msg = mount_prepare(MOUNT_OP_MOUNT);
+	if (!msg)
+		return 0;
+
+	msg->flags = flags;
+	msg->source_len = mount_read_str(msg->source, MOUNT_PATH_LEN, dev_name);
+	msg->target_len =
+		mount_read_path(msg->target, path, &msg->target_flags);
+	mount_read_str(msg->fstype, MOUNT_FSTYPE_LEN, type_page);
+	mount_send(ctx, msg);
+	return 0;
+}
+
+__attribute__((section("kprobe/path_umount"), used)) int
+BPF_KPROBE(event_path_umount, struct path *path, int flags)
+{
+	struct msg_mount *msg;
+
+	msg = mount_prepare(MOUNT_OP_UMOUNT);
+	if (!msg)
+		return 0;
+
+	msg->flags = flags;
+	msg->target_len =
+		mount_read_path(msg->target, path, &msg->target_flags);
+	mount_send(ctx, msg);
+	return 0;
+}
+
+/* The target of pivot_root(2) is the new root, the source is where the old
+ * root is moved to.
+ */
+__attribute__((section("kprobe/security_sb_pivotroot"), used)) int
+BPF_KPROBE(event_pivot_root, const struct path *old_path,
+	   const struct path *new_path)
+{
+	struct msg_mount *msg;
+
+	msg = mount_prepare(MOUNT_OP_PIVOT_ROOT);
+	if (!msg)
+		return 0;
+
+	msg->source_len = mount_read_path(msg->source, old_path, 0);
+	msg->target_len =
+		mount_read_path(msg->target, new_path, &msg->target_flags);
+	mount_send(ctx, msg);
+	return 0;
+}
+
+__attribute__((section("kprobe/security_move_mount"), used)) int
+BPF_KPROBE(event_move_mount, const struct path *from_path,
+	   const struct path *to_path)
+{
+	struct msg_mount *msg;
+
+	msg = mount_prepare(MOUNT_OP_MOVE_MOUNT);
+	if (!msg)
+		return 0;
+
+	msg->source_len = mount_read_path(msg->source, from_path, 0);
+	msg->target_len =
+		mount_read_path(msg->target, to_path, &msg->target_flags);
+	mount_send(ctx, msg);
+	return 0;
+}
+
+/* fs_context_for_mount() is also called by mount(2), only report it when
+ * called by fsopen(2). fsopen() has no target, so it is dropped when a
+ * target prefix filter is set.
+ */
+__attribute__((section("kprobe/fs_context_for_mount"), used)) int
+BPF_KPROBE(event_fsopen, struct file_system_type *fs_type,
+	   unsigned int sb_flags)
+{
+	struct task_struct *task;
+	struct msg_mount *msg;
+	const char *name;
+
+	task = (struct task_struct *)get_current_task();
+	if (get_task_syscall(task) != SYSCALL_FSOPEN)
+		return 0;
+
+	msg = mount_prepare(MOUNT_OP_FSOPEN);
+	if (!msg)
+		return 0;
+
+	msg->flags = sb_flags;
+	probe_read(&name, sizeof(name), _(&fs_type->name));
+	mount_read_str(msg->fstype, MOUNT_FSTYPE_LEN, name);
+	mount_send(ctx, msg);
+	return 0;
+}
+
+/* vfs_create_mount() is also called by mount(2), only report it when called
+ * by fsmount(2). The mount is detached, so there is no target either.
+ */
+__attribute__((section("kprobe/vfs_create_mount"), used)) int
+BPF_KPROBE(event_fsmount, struct fs_context *fc)
+{
+	struct file_system_type *fs_type;
+	struct task_struct *task;
+	struct msg_mount *msg;
+	const char *str;
+
+	task = (struct task_struct *)get_current_task();
+	if (get_task_syscall(task) != SYSCALL_FSMOUNT)
+		return 0;
+
+	msg = mount_prepare(MOUNT_OP_FSMOUNT);
+	if (!msg)
+		return 0;
+
+	probe_read(&str, sizeof(str), _(&fc->source));
+	msg->source_len = mount_read_str(msg->source, MOUNT_PATH_LEN, str);
+	probe_read(&fs_type, sizeof(fs_type), _(&fc->fs_type));
+	if (fs_type) {
+		probe_read(&str, sizeof(str), _(&fs_type->name));
+		mount_read_str(msg->fstype, MOUNT_FSTYPE_LEN, str);
+	}
+	mount_send(ctx, msg);
+	return 0;
+}
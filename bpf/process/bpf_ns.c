@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: GPL-2.0
+/* Copyright Authors of Cilium */
+
+#include "vmlinux.h"
+#include "api.h"
+#include "bpf_tracing.h"
+
+#include "hubble_msg.h"
+#include "bpf_events.h"
+#include "bpf_process_event.h"
+
+char _license[] __attribute__((section("license"), used)) = "GPL";
+#ifdef VMLINUX_KERNEL_VERSION
+int _version __attribute__((section(("version")), used)) =
+	VMLINUX_KERNEL_VERSION;
+#endif
+
+struct bpf_map_def __attribute__((section("maps"), used)) ns_heap_map = {
+	.type = BPF_MAP_TYPE_PERCPU_ARRAY,
+	.key_size = sizeof(int),
+	.value_size = sizeof(struct msg_ns_change),
+	.max_entries = 1,
+};
+
+static inline __attribute__((always_inline)) bool
+ns_changed(struct msg_ns_change *msg)
+{
+	bool changed = false;
+	int i;
+
+#pragma unroll
+	for (i = 0; i < ns_max_types; i++) {
+		if (msg->old_ns.inum[i] != msg->new_ns.inum[i])
+			changed = true;
+	}
+	return changed;
+}
+
+/* The user namespace of a task is the one of its credentials, which setns()
+ * and unshare() install with commit_creds(), and clone() with copy_creds().
+ * mm->user_ns, as read by get_namespaces(), does not change then.
+ */
+static inline __attribute__((always_inline)) __u32
+get_cred_user_ns(const struct cred *cred)
+{
+	struct user_namespace *user_ns = 0;
+	__u32 inum = 0;
+
+	probe_read(&user_ns, sizeof(user_ns), _(&cred->user_ns));
+	probe_read(&inum, sizeof(inum), _(&user_ns->ns.inum));
+	return inum;
+}
+
+static inline __attribute__((always_inline)) __u32
+get_task_user_ns(struct task_struct *task)
+{
+	const struct cred *cred = 0;
+
+	probe_read(&cred, sizeof(cred), _(&task->cred));
+	return get_cred_user_ns(cred);
+}
+
+static inline __attribute__((always_inline)) void
+ns_change_send(void *ctx, struct msg_ns_change *msg,
+	       struct execve_map_value *enter, struct task_struct *task)
+{
+	msg->common.op = MSG_OP_NS_CHANGE;
+	msg->common.flags = 0;
+	msg->common.pad[0] = 0;
+	msg->common.pad[1] = 0;
+	msg->common.size = sizeof(struct msg_ns_change);
+	msg->common.ktime = ktime_get_ns();
+	msg->current.pid = enter->key.pid;
+	msg->current.pad[0] = 0;
+	msg->current.pad[1] = 0;
+	msg->current.pad[2] = 0;
+	msg->current.pad[3] = 0;
+	msg->current.ktime = enter->key.ktime;
+	msg->pad = 0;
+	msg->syscall = get_task_syscall(task);
+
+	event_output(ctx, msg, sizeof(struct msg_ns_change));
+}
+
+/* switch_task_namespaces() installs the new nsproxy for setns() and
+ * unshare(). It is also called with a NULL nsproxy on exit, which we ignore.
+ * The user namespace was already installed by commit_creds(), and reported
+ * by event_ns_commit_creds.
+ */
+__attribute__((section("kprobe/switch_task_namespaces"), used)) int
+BPF_KPROBE(event_switch_task_namespaces, struct task_struct *p,
+	   struct nsproxy *new)
+{
+	struct execve_map_value *enter;
+	struct msg_ns_change *msg;
+	int zero = 0;
+	__u32 pid;
+
+	if (!new)
+		return 0;
+
+	pid = (get_current_pid_tgid() >> 32);
+	enter = execve_map_get_noinit(pid);
+	if (!enter || !enter->key.ktime)
+		return 0;
+
+	msg = map_lookup_elem(&ns_heap_map, &zero);
+	if (!msg)
+		return 0;
+
+	get_namespaces(&msg->old_ns, p);
+	__get_namespaces(&msg->new_ns, p, new);
+	msg->old_ns.user_inum = get_task_user_ns(p);
+	msg->new_ns.user_inum = msg->old_ns.user_inum;
+	if (!ns_changed(msg))
+		return 0;
+
+	msg->child_pid = 0;
+	ns_change_send(ctx, msg, enter, p);
+	return 0;
+}
+
+/* On clone() the child gets its own nsproxy only if new namespaces were
+ * requested, otherwise it shares the one of its parent.
+ */
+__attribute__((section("kprobe/wake_up_new_task"), used)) int
+BPF_KPROBE(event_ns_wake_up_new_task, struct task_struct *task)
+{
+	struct nsproxy *parent_nsproxy, *child_nsproxy;
+	__u32 pid, tgid, cpid = 0, parent_user, child_user;
+	struct execve_map_value *enter;
+	struct task_struct *current;
+	struct msg_ns_change *msg;
+	int zero = 0;
+
+	if (!task)
+		return 0;
+
+	/* threads can not be created in new namespaces */
+	probe_read(&cpid, sizeof(cpid), _(&task->pid));
+	probe_read(&tgid, sizeof(tgid), _(&task->tgid));
+	if (cpid != tgid)
+		return 0;
+
+	current = (struct task_struct *)get_current_task();
+	probe_read(&parent_nsproxy, sizeof(parent_nsproxy),
+		   _(&current->nsproxy));
+	probe_read(&child_nsproxy, sizeof(child_nsproxy), _(&task->nsproxy));
+	/* CLONE_NEWUSER alone does not give the child its own nsproxy */
+	parent_user = get_task_user_ns(current);
+	child_user = get_task_user_ns(task);
+	if (parent_nsproxy == child_nsproxy && parent_user == child_user)
+		return 0;
+
+	pid = (get_current_pid_tgid() >> 32);
+	enter = execve_map_get_noinit(pid);
+	if (!enter || !enter->key.ktime)
+		return 0;
+
+	msg = map_lookup_elem(&ns_heap_map, &zero);
+	if (!msg)
+		return 0;
+
+	get_namespaces(&msg->old_ns, current);
+	get_namespaces(&msg->new_ns, task);
+	msg->old_ns.user_inum = parent_user;
+	msg->new_ns.user_inum = child_user;
+	if (!ns_changed(msg))
+		return 0;
+
+	msg->child_pid = tgid;
+	ns_change_send(ctx, msg, enter, current);
+	return 0;
+}
+
+/* commit_creds() installs the new user namespace of setns() and unshare()
+ * with CLONE_NEWUSER, before switch_task_namespaces() installs the other
+ * namespaces.
+ */
+__attribute__((section("kprobe/commit_creds"), used)) int
+BPF_KPROBE(event_ns_commit_creds, struct cred *new)
+{
+	struct execve_map_value *enter;
+	struct task_struct *task;
+	struct msg_ns_change *msg;
+	__u32 pid, old_user, new_user;
+	int zero = 0;
+
+	task = (struct task_struct *)get_current_task();
+	old_user = get_task_user_ns(task);
+	new_user = get_cred_user_ns(new);
+	if (old_user == new_user)
+		return 0;
+
+	pid = (get_current_pid_tgid() >> 32);
+	enter = execve_map_get_noinit(pid);
+	if (!enter || !enter->key.ktime)
+		return 0;
+
+	msg = map_lookup_elem(&ns_heap_map, &zero);
+	if (!msg)
+		return 0;
+
+	get_namespaces(&msg->old_ns, task);
+	get_namespaces(&msg->new_ns, task);
+	msg->old_ns.user_inum = old_user;
+	msg->new_ns.user_inum = new_user;
+
+	msg->child_pid = 0;
+	ns_change_send(ctx, msg, enter, task);
+	return 0;
+}
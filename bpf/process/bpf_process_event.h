@@ -455,13 +455,68 @@ get_caps(struct msg_capabilities *msg, struct task_struct *task)
 	probe_read(&msg->permitted, sizeof(__u64), _(&cred->cap_permitted));
 }
 
+/* Local flavors of struct pt_regs, relocated against the kernel's own
+ * struct pt_regs. Only the field holding the system call number is needed.
+ */
+struct pt_regs___x86 {
+	long unsigned int orig_ax;
+} __attribute__((preserve_access_index));
+
+struct pt_regs___arm64 {
+	int syscallno;
+} __attribute__((preserve_access_index));
+
+/* Size in bytes of a type in the running kernel, BPF_TYPE_SIZE relocation. */
+#define bpf_core_type_size(type)                                               \
+	__builtin_preserve_type_info(*(typeof(type) *)0, 1)
+
+/* get_task_syscall returns the number of the system call task is currently
+ * executing, or -1 if it can not be determined.
+ *
+ * The user registers are saved at the top of the kernel stack, see
+ * task_pt_regs(). The stack size (THREAD_SIZE) depends on the kernel
+ * configuration, e.g. KASAN doubles it, so take it from the size of
+ * thread_union::stack in the kernel BTF instead of hard-coding it.
+ */
+static inline __attribute__((always_inline)) __s64
+get_task_syscall(struct task_struct *task)
+{
+#if defined(__TARGET_ARCH_x86) || defined(__TARGET_ARCH_arm64)
+	union thread_union *tu = NULL;
+	__u64 thread_size, regs_size;
+	__s64 nr = -1;
+	void *stack, *regs;
+
+	probe_read(&stack, sizeof(stack), _(&task->stack));
+	if (!stack)
+		return -1;
+
+	thread_size = bpf_core_field_size(tu->stack);
+	regs_size = bpf_core_type_size(struct pt_regs);
+	regs = stack + thread_size - regs_size;
+#if defined(__TARGET_ARCH_x86)
+	probe_read(&nr, sizeof(nr), _(&((struct pt_regs___x86 *)regs)->orig_ax));
+#else
+	{
+		int syscallno = -1;
+
+		probe_read(&syscallno, sizeof(syscallno),
+			   _(&((struct pt_regs___arm64 *)regs)->syscallno));
+		nr = syscallno;
+	}
+#endif
+	return nr;
+#else
+	return -1;
+#endif
+}
+
 static inline __attribute__((always_inline)) void
-get_namespaces(struct msg_ns *msg, struct task_struct *task)
+__get_namespaces(struct msg_ns *msg, struct task_struct *task,
+		 struct nsproxy *nsproxy)
 {
-	struct nsproxy *nsproxy;
 	struct nsproxy nsp;
 
-	probe_read(&nsproxy, sizeof(nsproxy), _(&task->nsproxy));
 	probe_read(&nsp, sizeof(nsp), _(nsproxy));
 
 	probe_read(&msg->uts_inum, sizeof(msg->uts_inum),
@@ -513,6 +568,15 @@ get_namespaces(struct msg_ns *msg, struct task_struct *task)
 	}
 }
 
+static inline __attribute__((always_inline)) void
+get_namespaces(struct msg_ns *msg, struct task_struct *task)
+{
+	struct nsproxy *nsproxy;
+
+	probe_read(&nsproxy, sizeof(nsproxy), _(&task->nsproxy));
+	__get_namespaces(msg, task, nsproxy);
+}
+
 /* Pahole bug does not convert to btf correctly with arbitrary byte holes not
  * near a cacheline. To work-around this we can specify a define with the
  * CGROUPS_OFFSET we read directly out of debug_info section. Note other
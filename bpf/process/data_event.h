@@ -21,8 +21,7 @@ a:
 		return err;
 
 	msg->common.size = offsetof(struct msg_data, arg) + bytes;
-	perf_event_output(ctx, &tcpmon_map, BPF_F_CURRENT_CPU, msg,
-			  msg->common.size);
+	event_output(ctx, msg, msg->common.size);
 	return bytes;
 b:
 	return -1;
@@ -82,7 +81,7 @@ __do_str(void *ctx, struct msg_data *msg, unsigned long arg,
 
 	/* Code movement from clang forces us to inline bounds checks here */
 	asm volatile("%[size] &= 0x7fff;\n" : : [size] "+r"(size) :);
-	perf_event_output(ctx, &tcpmon_map, BPF_F_CURRENT_CPU, msg, size);
+	event_output(ctx, msg, size);
 	return err == max ? 0 : 1;
 }
 
@@ -35,6 +35,8 @@ generic_process_event0(struct pt_regs *ctx, struct bpf_map_def *heap_map,
 		return 0;
 
 	a0 = e->a0;
+	if (config->btf_arg[0][0].is_initialized)
+		extract_arg_depth(config, 0, &a0);
 
 	e->common.flags = 0;
 	e->common.pad[0] = 0;
@@ -155,6 +157,8 @@ generic_process_event1(void *ctx, struct bpf_map_def *heap_map,
 	total = e->common.size;
 
 	a1 = e->a1;
+	if (config->btf_arg[1][0].is_initialized)
+		extract_arg_depth(config, 1, &a1);
 
 	ty = config->arg1;
 	if (total < MAX_TOTAL) {
@@ -205,6 +209,8 @@ generic_process_event2(void *ctx, struct bpf_map_def *heap_map,
 	total = e->common.size;
 
 	a2 = e->a2;
+	if (config->btf_arg[2][0].is_initialized)
+		extract_arg_depth(config, 2, &a2);
 
 	ty = config->arg2;
 	if (total < MAX_TOTAL) {
@@ -255,6 +261,8 @@ generic_process_event3(void *ctx, struct bpf_map_def *heap_map,
 	total = e->common.size;
 
 	a3 = e->a3;
+	if (config->btf_arg[3][0].is_initialized)
+		extract_arg_depth(config, 3, &a3);
 
 	/* Arg filter and copy logic */
 	ty = config->arg3;
@@ -306,6 +314,8 @@ generic_process_event4(void *ctx, struct bpf_map_def *heap_map,
 	total = e->common.size;
 
 	a4 = e->a4;
+	if (config->btf_arg[4][0].is_initialized)
+		extract_arg_depth(config, 4, &a4);
 
 	ty = config->arg4;
 	if (total < MAX_TOTAL) {
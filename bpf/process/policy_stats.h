@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: GPL-2.0
+/* Copyright Authors of Cilium */
+
+#ifndef __POLICY_STATS_H__
+#define __POLICY_STATS_H__
+
+#include "bpf_helpers.h"
+
+/* Per hook counters, read by user space to report metrics labeled by the
+ * tracing policy and hook, see pkg/observer/observer_stats.go. Actions are
+ * counted at POLICY_STATS_ACTION + action id.
+ */
+enum {
+	POLICY_STATS_FILTERED = 0,
+	POLICY_STATS_LOST = 1,
+	POLICY_STATS_ACTION = 2,
+	POLICY_STATS_MAX = 8,
+};
+
+struct bpf_map_def __attribute__((section("maps"), used)) policy_stats = {
+	.type = BPF_MAP_TYPE_PERCPU_ARRAY,
+	.key_size = sizeof(__u32),
+	.value_size = sizeof(__u64),
+	.max_entries = POLICY_STATS_MAX,
+};
+
+static inline __attribute__((always_inline)) void policy_stats_inc(__u32 idx)
+{
+	__u64 *cntr;
+
+	cntr = map_lookup_elem(&policy_stats, &idx);
+	if (cntr)
+		*cntr = *cntr + 1;
+}
+
+#endif // __POLICY_STATS_H__
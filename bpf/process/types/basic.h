@@ -8,6 +8,8 @@
 #include "../bpf_process_event.h"
 #include "bpfattr.h"
 #include "perfevent.h"
+#include "syscall.h"
+#include "../policy_stats.h"
 
 /* Type IDs form API with user space generickprobe.go */
 enum {
@@ -39,6 +41,15 @@ enum {
 	bpf_attr_type = 19,
 	perf_event_type = 20,
 
+	/* syscall_id_type is the id of the raw_syscalls/sys_enter tracepoint
+	 * in syscall audit mode, filtered with a bitmap of syscall ids.
+	 */
+	syscall_id_type = 21,
+	/* syscall_args_type is the args array of the raw_syscalls/sys_enter
+	 * tracepoint in syscall audit mode, followed by the string arguments.
+	 */
+	syscall_args_type = 22,
+
 	nop_s64_ty = -10,
 	nop_u64_ty = -11,
 	nop_u32_ty = -12,
@@ -86,6 +97,20 @@ struct selector_arg_filter {
 	__u8 value;
 } __attribute__((packed));
 
+#define MAX_BTF_ARG_DEPTH 10
+
+/* One step of a BTF field path, as resolved by userspace against the
+ * kernel BTF: add offset to the current address and, if size is set,
+ * read size bytes from there. A zero size keeps the address, which is
+ * what we want for embedded structs and arrays.
+ */
+struct config_btf_arg {
+	__u32 offset;
+	__u16 size;
+	__u8 is_signed;
+	__u8 is_initialized;
+} __attribute__((packed));
+
 struct event_config {
 	__u32 func_id;
 	__s32 arg0;
@@ -107,8 +132,14 @@ struct event_config {
 	__u32 syscall;
 	__s32 argreturncopy;
 	__s32 argreturn;
+	__u32 stack;
+	struct config_btf_arg btf_arg[5][MAX_BTF_ARG_DEPTH];
 } __attribute__((packed));
 
+/* event_config stack flags */
+#define STACK_KERNEL (1 << 0)
+#define STACK_USER   (1 << 1)
+
 #define MAX_ARGS_SIZE	 80
 #define MAX_ARGS_ENTRIES 8
 #define MAX_MATCH_VALUES 4
@@ -392,6 +423,47 @@ copy_strings(char *args, unsigned long arg)
 	return size + 4;
 }
 
+/* copy_syscall_args copies the arguments of the raw_syscalls/sys_enter
+ * tracepoint followed by its string arguments, see struct syscall_strings.
+ * arg points to the args field of the tracepoint, right after its id field.
+ */
+static inline __attribute__((always_inline)) long
+copy_syscall_args(char *args, unsigned long arg)
+{
+	unsigned long *sysargs = (unsigned long *)args;
+	struct syscall_strings *strings;
+	long size = SYSCALL_MAX_ARGS * sizeof(__u64), ssize;
+	int zero = 0, i;
+	__u8 mask;
+	long id;
+
+	probe_read(&id, sizeof(id), (char *)arg - sizeof(id));
+	probe_read(sysargs, SYSCALL_MAX_ARGS * sizeof(__u64), (char *)arg);
+
+	/* the string masks are indexed by native syscall ids */
+	if (in_compat_syscall())
+		return size;
+	strings = map_lookup_elem(&syscall_strings, &zero);
+	if (!strings || id < 0 || id >= SYSCALL_MAX)
+		return size;
+	mask = strings->mask[id & (SYSCALL_MAX - 1)];
+
+#pragma unroll
+	for (i = 0; i < SYSCALL_MAX_ARGS; i++) {
+		if (!(mask & (1 << i)))
+			continue;
+		asm volatile("%[size] &= 0x1fff;\n" ::[size] "+r"(size) :);
+		ssize = copy_strings(&args[size], sysargs[i]);
+		/* report strings that cannot be read as empty */
+		if (ssize < 0) {
+			*(int *)&args[size] = 0;
+			ssize = 4;
+		}
+		size += ssize;
+	}
+	return size;
+}
+
 static inline __attribute__((always_inline)) long copy_skb(char *args,
 							   unsigned long arg)
 {
@@ -705,6 +777,30 @@ filter_64ty(struct selector_arg_filter *filter, char *args)
 	return 0;
 }
 
+/* syscall_id_type filters hold a bitmap of SYSCALL_MAX bits, set for the
+ * matching native syscall ids. Compat syscalls match neither In nor NotIn
+ * filters, since their ids have another meaning.
+ */
+static inline __attribute__((always_inline)) long
+filter_syscall_id(struct selector_arg_filter *filter, char *args)
+{
+	__u8 *bitmap = (__u8 *)&filter->value;
+	__s64 id = *(__s64 *)args;
+	bool res = false;
+
+	if (id & SYSCALL_COMPAT)
+		return 0;
+
+	if (id >= 0 && id < SYSCALL_MAX)
+		res = bitmap[(id >> 3) & (SYSCALL_MAX / 8 - 1)] & (1 << (id & 7));
+
+	if (filter->op == op_filter_in)
+		return res;
+	if (filter->op == op_filter_notin)
+		return !res;
+	return 0;
+}
+
 static inline __attribute__((always_inline)) long
 filter_32ty(struct selector_arg_filter *filter, char *args)
 {
@@ -755,6 +851,10 @@ static inline __attribute__((always_inline)) size_t type_to_min_size(int type,
 		return 4;
 	case const_buf_type:
 		return argm;
+	case syscall_id_type:
+		return 8;
+	case syscall_args_type:
+		return SYSCALL_MAX_ARGS * (8 + MAX_STRING + 4);
 	case bpf_attr_type:
 		return sizeof(struct bpf_info_type);
 	case perf_event_type:
@@ -880,6 +980,9 @@ selector_arg_offset(__u8 *f, struct msg_generic_kprobe *e, __u32 selector)
 	case u64_ty:
 		pass = filter_64ty(filter, args);
 		break;
+	case syscall_id_type:
+		pass = filter_syscall_id(filter, args);
+		break;
 	case size_type:
 	case int_type:
 	case s32_ty:
@@ -1097,6 +1200,8 @@ __do_action(long i, struct msg_generic_kprobe *e,
 	}
 	if (!err) {
 		e->action = action;
+		if (action >= 0 && action < POLICY_STATS_MAX - POLICY_STATS_ACTION)
+			policy_stats_inc(POLICY_STATS_ACTION + action);
 		return ++i;
 	}
 	return -1;
@@ -1118,6 +1223,26 @@ out:
 
 #define MAX_SELECTORS 8
 
+#ifdef GENERIC_KPROBE
+/* copy_stack copies the stack trace of the current task after the args of
+ * the event, and returns its size or a negative error. The stack trace is
+ * not captured when it does not fit in the event.
+ */
+static inline __attribute__((always_inline)) long
+copy_stack(void *ctx, struct msg_generic_kprobe *e, __u64 flags)
+{
+	long off = e->common.size, size;
+
+	if (off + STACK_SIZE + generic_kprobe_common_size() > 9000)
+		return -7; /* -E2BIG */
+	asm volatile("%[off] &= 0x3fff;\n" ::[off] "+r"(off) :);
+	size = get_stack(ctx, &e->args[off], STACK_SIZE, flags);
+	if (size > 0)
+		e->common.size += size;
+	return size;
+}
+#endif
+
 static inline __attribute__((always_inline)) long
 filter_read_arg(void *ctx, int index, struct bpf_map_def *heap,
 		struct bpf_map_def *filter, struct bpf_map_def *tailcalls,
@@ -1134,11 +1259,14 @@ filter_read_arg(void *ctx, int index, struct bpf_map_def *heap,
 	pass = filter_args(e, index, filter);
 	if (!pass) {
 		index++;
-		if (index > MAX_SELECTORS || !e->active[index])
+		if (index > MAX_SELECTORS || !e->active[index]) {
+			policy_stats_inc(POLICY_STATS_FILTERED);
 			return filter_args_reject();
+		}
 		tail_call(ctx, tailcalls, index + 5);
 		return 2;
 	}
+	e->selector = e->active[SELECTORS_ACTIVE] ? index + 1 : 0;
 
 	// If pass >1 then we need to consult the selector actions
 	// otherwise pass==1 indicates using default action.
@@ -1195,6 +1323,18 @@ filter_read_arg(void *ctx, int index, struct bpf_map_def *heap,
 	}
 #endif
 
+#ifdef GENERIC_KPROBE
+	{
+		struct event_config *config;
+
+		config = map_lookup_elem(config_map, &zero);
+		if (config && (config->stack & STACK_KERNEL))
+			e->kernel_stack_size = copy_stack(ctx, e, 0);
+		if (config && (config->stack & STACK_USER))
+			e->user_stack_size = copy_stack(ctx, e, BPF_F_USER_STACK);
+	}
+#endif
+
 	total = e->common.size + generic_kprobe_common_size();
 	/* Code movement from clang forces us to inline bounds checks here */
 	asm volatile("%[total] &= 0x7fff;\n"
@@ -1203,10 +1343,58 @@ filter_read_arg(void *ctx, int index, struct bpf_map_def *heap,
 		     :
 		     : [total] "+r"(total)
 		     :);
-	perf_event_output(ctx, &tcpmon_map, BPF_F_CURRENT_CPU, e, total);
+	if (event_output(ctx, e, total) < 0)
+		policy_stats_inc(POLICY_STATS_LOST);
 	return 1;
 }
 
+/* extract_arg_depth walks the BTF field path configured for argument
+ * index, replacing the raw argument with the resolved field: either its
+ * value, for scalars and pointers, or its address.
+ */
+static inline __attribute__((always_inline)) void
+extract_arg_depth(struct event_config *config, int index, unsigned long *a)
+{
+	struct config_btf_arg *btf;
+	int i;
+
+	if (index < 0 || index > 4)
+		return;
+
+#pragma unroll
+	for (i = 0; i < MAX_BTF_ARG_DEPTH; i++) {
+		__u64 val = 0;
+		int shift;
+
+		btf = &config->btf_arg[index][i];
+		if (!btf->is_initialized)
+			break;
+
+		*a += btf->offset;
+		switch (btf->size) {
+		case 1:
+			probe_read(&val, 1, (void *)*a);
+			break;
+		case 2:
+			probe_read(&val, 2, (void *)*a);
+			break;
+		case 4:
+			probe_read(&val, 4, (void *)*a);
+			break;
+		case 8:
+			probe_read(&val, 8, (void *)*a);
+			break;
+		default:
+			continue;
+		}
+		if (btf->is_signed && btf->size < 8) {
+			shift = 64 - btf->size * 8;
+			val = (__s64)(val << shift) >> shift;
+		}
+		*a = val;
+	}
+}
+
 /**
  * Read a generic argument
  *
@@ -1245,6 +1433,8 @@ read_call_arg(void *ctx, struct msg_generic_kprobe *e, int index, int type,
 	}
 		// fallthrough to copy_path
 	case path_ty:
+		if (!path_arg)
+			probe_read(&path_arg, sizeof(path_arg), &arg);
 		size = copy_path(args, path_arg);
 		break;
 	case fd_ty: {
@@ -1294,6 +1484,18 @@ read_call_arg(void *ctx, struct msg_generic_kprobe *e, int index, int type,
 		probe_read(args, sizeof(__u64), &arg);
 		size = sizeof(__u64);
 		break;
+	case syscall_id_type: {
+		__s64 id = arg;
+
+		if (in_compat_syscall())
+			id |= SYSCALL_COMPAT;
+		probe_read(args, sizeof(__u64), &id);
+		size = sizeof(__u64);
+		break;
+	}
+	case syscall_args_type:
+		size = copy_syscall_args(args, arg);
+		break;
 	/* Consolidate all the types to save instructions */
 	case int_type:
 	case s32_ty:
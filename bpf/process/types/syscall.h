@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: GPL-2.0
+/* Copyright Authors of Cilium */
+
+#ifndef __SYSCALL_H__
+#define __SYSCALL_H__
+
+/* Bounds the syscall ids, needs to match syscallinfo.MaxSyscalls */
+#define SYSCALL_MAX	 512
+#define SYSCALL_MAX_ARGS 6
+
+/* Marks the ids of compat (e.g. ia32) syscalls, whose numbering differs from
+ * the native one. Needs to match syscallCompat of
+ * pkg/sensors/tracing/generictracepoint.go.
+ */
+#define SYSCALL_COMPAT (1LL << 32)
+
+/* Local flavors of struct thread_info, only the fields telling whether the
+ * task executes a compat syscall are needed.
+ */
+struct thread_info___x86 {
+	__u32 status;
+} __attribute__((preserve_access_index));
+
+struct thread_info___arm64 {
+	unsigned long flags;
+} __attribute__((preserve_access_index));
+
+#define X86_TS_COMPAT	0x0002 /* in_ia32_syscall() */
+#define ARM64_TIF_32BIT 22 /* is_compat_task() */
+
+/* in_compat_syscall returns whether the current task executes a compat
+ * syscall, e.g. through int 0x80 on x86_64.
+ */
+static inline __attribute__((always_inline)) bool in_compat_syscall(void)
+{
+#if defined(__TARGET_ARCH_x86) || defined(__TARGET_ARCH_arm64)
+	struct task_struct *task = (struct task_struct *)get_current_task();
+	void *ti = _(&task->thread_info);
+#if defined(__TARGET_ARCH_x86)
+	__u32 status = 0;
+
+	probe_read(&status, sizeof(status),
+		   _(&((struct thread_info___x86 *)ti)->status));
+	return status & X86_TS_COMPAT;
+#else
+	unsigned long flags = 0;
+
+	probe_read(&flags, sizeof(flags),
+		   _(&((struct thread_info___arm64 *)ti)->flags));
+	return flags & (1UL << ARM64_TIF_32BIT);
+#endif
+#else
+	return false;
+#endif
+}
+
+/* Bitmasks of the string arguments of the syscalls, indexed by syscall id,
+ * used by the syscall_args_type arguments. Loaded by user space, see
+ * pkg/sensors/tracing/generictracepoint.go.
+ */
+struct syscall_strings {
+	__u8 mask[SYSCALL_MAX];
+};
+
+struct bpf_map_def __attribute__((section("maps"), used)) syscall_strings = {
+	.type = BPF_MAP_TYPE_ARRAY,
+	.key_size = sizeof(__u32),
+	.value_size = sizeof(struct syscall_strings),
+	.max_entries = 1,
+};
+
+#endif
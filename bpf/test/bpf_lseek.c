@@ -49,8 +49,7 @@ test_lseek(struct sys_enter_lseek_args *ctx)
 		msg.common.ktime = ktime_get_ns();
 		msg.common.size = size;
 		msg.arg0 = get_smp_processor_id();
-		perf_event_output(ctx, &tcpmon_map, BPF_F_CURRENT_CPU, &msg,
-				  size);
+		event_output(ctx, &msg, size);
 	}
 
 	return 0;
@@ -15,13 +15,18 @@
 package bugtool
 
 import (
+	"time"
+
+	"github.com/cilium/tetragon/cmd/tetra/common"
 	"github.com/cilium/tetragon/pkg/bugtool"
+	"github.com/cilium/tetragon/pkg/logger"
 
 	"github.com/spf13/cobra"
 )
 
 var (
 	outFile string
+	capture time.Duration
 )
 
 func New() *cobra.Command {
@@ -29,11 +34,18 @@ func New() *cobra.Command {
 		Use:   "bugtool",
 		Short: "Produce a tar archive with debug information",
 		Run: func(cmd *cobra.Command, args []string) {
-			bugtool.Bugtool(outFile)
+			creds, err := common.TransportCredentials()
+			if err != nil {
+				logger.GetLogger().WithError(err).Fatal("Failed to configure TLS")
+			}
+			if err := bugtool.Bugtool(outFile, capture, creds); err != nil {
+				logger.GetLogger().WithError(err).Fatal("Bugtool failed")
+			}
 		},
 	}
 
 	flags := bugtoolCmd.Flags()
 	flags.StringVarP(&outFile, "out", "o", "tetragon-bugtool.tar.gz", "Output filename")
+	flags.DurationVar(&capture, "capture", 0, "Also include the raw BPF samples read by the agent for this long, to replay them with tetragon --replay. Disabled by default")
 	return bugtoolCmd
 }
@@ -5,6 +5,10 @@ package common
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
 	"os/signal"
 	"time"
 
@@ -13,23 +17,71 @@ import (
 	"github.com/spf13/viper"
 	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-func CliRunErr(fn func(ctx context.Context, cli tetragon.FineGuidanceSensorsClient), fnErr func(err error)) {
+// TransportCredentials returns TLS credentials if any of the TLS flags is set,
+// or insecure credentials otherwise.
+func TransportCredentials() (credentials.TransportCredentials, error) {
+	caFile := viper.GetString(KeyTLSCAFile)
+	certFile := viper.GetString(KeyTLSCertFile)
+	keyFile := viper.GetString(KeyTLSKeyFile)
+	serverName := viper.GetString(KeyTLSServerName)
+	if caFile == "" && certFile == "" && keyFile == "" && serverName == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	cfg := &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in CA file %s", caFile)
+		}
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
+// CliRunConn connects to the server and calls fn with the connection, for
+// commands using other services than FineGuidanceSensors.
+func CliRunConn(fn func(ctx context.Context, conn *grpc.ClientConn), fnErr func(err error)) {
 	ctx, cancel := signal.NotifyContext(context.Background(), unix.SIGINT, unix.SIGTERM)
 	defer cancel()
 
 	connCtx, connCancel := context.WithTimeout(ctx, 10*time.Second)
 	defer connCancel()
-	conn, err := grpc.DialContext(connCtx, viper.GetString(KeyServerAddress), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	creds, err := TransportCredentials()
+	if err != nil {
+		fnErr(err)
+		logger.GetLogger().WithError(err).Fatal("Failed to configure TLS")
+	}
+	conn, err := grpc.DialContext(connCtx, viper.GetString(KeyServerAddress), grpc.WithTransportCredentials(creds), grpc.WithBlock())
 	if err != nil {
 		fnErr(err)
 		logger.GetLogger().WithError(err).Fatal("Failed to connect")
 	}
 	defer conn.Close()
-	client := tetragon.NewFineGuidanceSensorsClient(conn)
-	fn(ctx, client)
+	fn(ctx, conn)
+}
+
+func CliRunErr(fn func(ctx context.Context, cli tetragon.FineGuidanceSensorsClient), fnErr func(err error)) {
+	CliRunConn(func(ctx context.Context, conn *grpc.ClientConn) {
+		fn(ctx, tetragon.NewFineGuidanceSensorsClient(conn))
+	}, fnErr)
 }
 
 func CliRun(fn func(ctx context.Context, cli tetragon.FineGuidanceSensorsClient)) {
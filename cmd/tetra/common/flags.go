@@ -8,4 +8,9 @@ const (
 	KeyDebug         = "debug"          // bool
 	KeyOutput        = "output"         // string
 	KeyServerAddress = "server-address" // string
+
+	KeyTLSCAFile     = "tls-ca-file"     // string
+	KeyTLSCertFile   = "tls-cert-file"   // string
+	KeyTLSKeyFile    = "tls-key-file"    // string
+	KeyTLSServerName = "tls-server-name" // string
 )
@@ -7,13 +7,18 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/cmd/tetra/common"
 	"github.com/cilium/tetragon/pkg/encoder"
+	"github.com/cilium/tetragon/pkg/filters"
 	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/multiplexer"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc/codes"
@@ -21,14 +26,27 @@ import (
 )
 
 // GetEncoder returns an encoder for an event stream based on configuration options.
-var GetEncoder = func(w io.Writer, colorMode encoder.ColorMode, timestamps bool, compact bool) encoder.EventEncoder {
-	if compact {
-		return encoder.NewCompactEncoder(w, colorMode, timestamps)
+// output is json, compact, csv, table or template=TEMPLATE, columns are the
+// columns of the csv and table outputs.
+var GetEncoder = func(w io.Writer, output string, colorMode encoder.ColorMode, timestamps bool, nodeNames bool, columns []string) (encoder.EventEncoder, error) {
+	switch {
+	case output == "json":
+		return json.NewEncoder(w), nil
+	case output == "compact":
+		e := encoder.NewCompactEncoder(w, colorMode, timestamps)
+		e.NodeNames = nodeNames
+		return e, nil
+	case output == "csv":
+		return encoder.NewCSVEncoder(w, columns)
+	case output == "table":
+		return encoder.NewTableEncoder(w, columns)
+	case strings.HasPrefix(output, "template="):
+		return encoder.NewTemplateEncoder(w, strings.TrimPrefix(output, "template="))
 	}
-	return json.NewEncoder(w)
+	return nil, fmt.Errorf("unknown output format %q, expected json, compact, csv, table or template=TEMPLATE", output)
 }
 
-func getRequest(namespaces []string, host bool, processes []string, pods []string) *tetragon.GetEventsRequest {
+func getRequest(namespaces []string, host bool, processes []string, pods []string, expression string) *tetragon.GetEventsRequest {
 	if host {
 		// Host events can be matched by an empty namespace string.
 		namespaces = append(namespaces, "")
@@ -38,25 +56,72 @@ func getRequest(namespaces []string, host bool, processes []string, pods []strin
 			BinaryRegex: processes,
 			Namespace:   namespaces,
 			PodRegex:    pods,
+			Expression:  expression,
 		}},
 	}
 }
 
-func getEvents(ctx context.Context, client tetragon.FineGuidanceSensorsClient) {
+// addProbeFilters adds the kprobe and tracepoint filters set on the command
+// line to filter.
+func addProbeFilters(filter *tetragon.Filter) error {
+	filter.FunctionName = viper.GetStringSlice("function-name")
+	filter.PolicyName = viper.GetStringSlice("policy-name")
+	filter.Tracepoint = viper.GetStringSlice("tracepoint")
+	for _, name := range viper.GetStringSlice("kprobe-action") {
+		name = strings.ToUpper(name)
+		if !strings.HasPrefix(name, "KPROBE_ACTION_") {
+			name = "KPROBE_ACTION_" + name
+		}
+		action, ok := tetragon.KprobeAction_value[name]
+		if !ok {
+			return fmt.Errorf("unknown kprobe action %q", name)
+		}
+		filter.KprobeAction = append(filter.KprobeAction, tetragon.KprobeAction(action))
+	}
+	for _, arg := range viper.GetStringSlice("arg") {
+		argFilter, err := filters.ParseArgFilter(arg)
+		if err != nil {
+			return err
+		}
+		filter.Arg = append(filter.Arg, argFilter)
+	}
+	return nil
+}
+
+// getEventsRequest returns the GetEvents request for the filters set on the
+// command line.
+func getEventsRequest() *tetragon.GetEventsRequest {
 	host := viper.GetBool("host")
 	namespaces := viper.GetStringSlice("namespace")
 	processes := viper.GetStringSlice("process")
 	pods := viper.GetStringSlice("pod")
+	expression := viper.GetString("filter")
+
+	request := getRequest(namespaces, host, processes, pods, expression)
+	if err := addProbeFilters(request.AllowList[0]); err != nil {
+		logger.GetLogger().WithError(err).Fatal("Invalid filter")
+	}
+	return request
+}
+
+func getEventEncoder(nodeNames bool) encoder.EventEncoder {
 	timestamps := viper.GetBool("timestamps")
-	compact := viper.GetString(common.KeyOutput) == "compact"
+	output := viper.GetString(common.KeyOutput)
 	colorMode := encoder.ColorMode(viper.GetString(common.KeyColor))
+	columns := viper.GetStringSlice("columns")
+	eventEncoder, err := GetEncoder(os.Stdout, output, colorMode, timestamps, nodeNames, columns)
+	if err != nil {
+		logger.GetLogger().WithError(err).Fatal("Invalid output format")
+	}
+	return eventEncoder
+}
 
-	request := getRequest(namespaces, host, processes, pods)
-	stream, err := client.GetEvents(ctx, request)
+func getEvents(ctx context.Context, client tetragon.FineGuidanceSensorsClient) {
+	stream, err := client.GetEvents(ctx, getEventsRequest())
 	if err != nil {
 		logger.GetLogger().WithError(err).Fatal("Failed to call GetEvents")
 	}
-	eventEncoder := GetEncoder(os.Stdout, colorMode, timestamps, compact)
+	eventEncoder := getEventEncoder(false)
 	for {
 		res, err := stream.Recv()
 		if err != nil {
@@ -71,23 +136,83 @@ func getEvents(ctx context.Context, client tetragon.FineGuidanceSensorsClient) {
 	}
 }
 
+// getEventsLong documents the output formats, with the columns and the
+// template functions.
+func getEventsLong() string {
+	var sb strings.Builder
+	sb.WriteString(`Print events.
+
+Output formats (-o):
+  json               one JSON event per line (default)
+  compact            a short human readable line per event
+  csv                CSV records of --columns, after a header record
+  table              aligned rows of --columns, after a header row
+  template=TEMPLATE  a Go text/template executed on each event, e.g.
+                     -o 'template={{.NodeName}} {{type .}} {{(process .).Binary}} {{arg 0 .}}'
+
+Columns (--columns):
+`)
+	for _, c := range encoder.Columns() {
+		fmt.Fprintf(&sb, "  %-18s %s\n", c.Name, c.Description)
+	}
+	fmt.Fprintf(&sb, "  %-18s %s\n", "argN", "Kprobe and tracepoint argument N, e.g. arg0")
+	sb.WriteString(`
+Template functions, besides the text/template builtins:
+  process EVENT      the process of the event
+  parent EVENT       the parent process of the event
+  type EVENT         the event type, e.g. process_exec
+  time EVENT         the event time in RFC 3339 format
+  pod EVENT          NAMESPACE/NAME of the pod of the process
+  function EVENT     the kprobe function or tracepoint SUBSYS/EVENT
+  args EVENT         the kprobe or tracepoint arguments, as a list
+  arg N EVENT        the kprobe or tracepoint argument N
+  column NAME EVENT  the value of a column
+  compact EVENT      the event in the compact format, without colors
+  caps CAPS          capabilities as a comma separated list of names
+  ns NS              the name of a CLONE_NEW* namespace flag
+  sock SOCK          a socket as "tcp SADDR:SPORT -> DADDR:DPORT"
+
+Arguments are formatted the same way in all the outputs but json.`)
+	return sb.String()
+}
+
 func New() *cobra.Command {
 	cmd := cobra.Command{
 		Use:   "getevents",
 		Short: "Print events",
+		Long:  getEventsLong(),
 		Run: func(cmd *cobra.Command, args []string) {
+			if len(viper.GetStringSlice("servers")) > 0 || viper.GetBool("kubernetes") {
+				getEventsMultiNode()
+				return
+			}
 			common.CliRun(getEvents)
 		},
 	}
 
 	flags := cmd.Flags()
-	flags.StringP("output", "o", "json", "Output format. json or compact")
+	flags.StringP("output", "o", "json", "Output format. json, compact, csv, table or template=TEMPLATE")
+	flags.StringSlice("columns", nil, "Columns of the csv and table outputs, see the help for the list. Defaults to "+strings.Join(encoder.DefaultColumns, ","))
 	flags.String("color", "auto", "Colorize compact output. auto, always, or never")
 	flags.StringSliceP("namespace", "n", nil, "Get events by Kubernetes namespaces")
 	flags.StringSlice("process", nil, "Get events by process name regex")
 	flags.StringSlice("pod", nil, "Get events by pod name regex")
 	flags.Bool("host", false, "Get host events")
+	flags.StringSlice("function-name", nil, "Get kprobe events by kernel function name")
+	flags.StringSlice("policy-name", nil, "Get kprobe and tracepoint events by tracing policy name")
+	flags.StringSlice("tracepoint", nil, "Get tracepoint events by subsys/event, e.g. syscalls/sys_enter_openat, or by subsys")
+	flags.StringSlice("kprobe-action", nil, "Get kprobe events by action, e.g. sigkill or override")
+	flags.StringArray("arg", nil, "Get kprobe and tracepoint events by argument value. INDEX:prefix=VALUE, INDEX:regex=VALUE, INDEX:saddr=CIDR, INDEX:daddr=CIDR or an integer comparison like INDEX:>=1024. Can be repeated, all must match")
+	flags.String("filter", "", "Get events matching a filter expression, e.g. 'process_kprobe.function_name == \"tcp_connect\" && process.uid == 0'")
 	flags.Bool("timestamps", false, "Include timestamps in compact output")
+	flags.StringSlice("servers", nil, "Get events from several gRPC servers, merged by event time. Overrides --server-address")
+	flags.BoolP("kubernetes", "k", false, "Get events from all the tetragon pods of the cluster, merged by event time")
+	flags.String("kubeconfig", "", "Path to the kubeconfig file, for --kubernetes")
+	flags.String("k8s-namespace", "kube-system", "Namespace of the tetragon pods, for --kubernetes")
+	flags.String("k8s-selector", "app.kubernetes.io/name=tetragon", "Label selector of the tetragon pods, for --kubernetes")
+	flags.Int("k8s-port", 54321, "gRPC port of the tetragon pods, for --kubernetes")
+	flags.String("k8s-connect", multiplexer.ConnectPortForward, "How to connect to the tetragon pods: port-forward, or pod-ip if their gRPC server listens on the pod IP")
+	flags.Duration("sort-window", time.Second, "How long events from several servers are held to be sorted by time. Set to 0 to print them as they arrive")
 	viper.BindPFlags(flags)
 	return &cmd
 }
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package getevents
+
+import (
+	"context"
+	"errors"
+	"os/signal"
+	"time"
+
+	"github.com/cilium/tetragon/cmd/tetra/common"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/multiplexer"
+	"github.com/spf13/viper"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getServers returns the addresses of the gRPC servers set with --servers, or
+// discovered in the cluster with --kubernetes, and a function to call once
+// done with them.
+func getServers(ctx context.Context) ([]string, func(), error) {
+	if servers := viper.GetStringSlice("servers"); len(servers) > 0 {
+		return servers, func() {}, nil
+	}
+	discovery := multiplexer.K8sDiscovery{
+		Kubeconfig:    viper.GetString("kubeconfig"),
+		Namespace:     viper.GetString("k8s-namespace"),
+		LabelSelector: viper.GetString("k8s-selector"),
+		Port:          viper.GetInt("k8s-port"),
+		Connect:       viper.GetString("k8s-connect"),
+	}
+	return discovery.Discover(ctx)
+}
+
+// getEventsMultiNode prints the events of several gRPC servers, sorted by
+// event time and prefixed by node name in compact output.
+func getEventsMultiNode() {
+	ctx, cancel := signal.NotifyContext(context.Background(), unix.SIGINT, unix.SIGTERM)
+	defer cancel()
+	log := logger.GetLogger()
+
+	servers, done, err := getServers(ctx)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to find gRPC servers")
+	}
+	defer done()
+	log.WithField("servers", servers).Debug("Getting events from gRPC servers")
+
+	creds, err := common.TransportCredentials()
+	if err != nil {
+		log.WithError(err).Fatal("Failed to configure TLS")
+	}
+	cm := multiplexer.NewClientMultiplexer().
+		WithConnectRetries(1).
+		WithDialOptions(grpc.WithTransportCredentials(creds), grpc.WithBlock())
+	if err := cm.Connect(ctx, 10*time.Second, servers...); err != nil {
+		log.WithError(err).Fatal("Failed to connect")
+	}
+
+	events, err := cm.GetEventsWithRequest(ctx, getEventsRequest())
+	if err != nil {
+		log.WithError(err).Fatal("Failed to call GetEvents")
+	}
+	results := (<-chan multiplexer.GetEventsResult)(events)
+	if window := viper.GetDuration("sort-window"); window > 0 {
+		results = multiplexer.SortByTime(ctx, events, window, 0)
+	}
+
+	eventEncoder := getEventEncoder(true)
+	streams := cm.NumClients()
+	for {
+		var res multiplexer.GetEventsResult
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return
+		case res, ok = <-results:
+			if !ok {
+				return
+			}
+		}
+		if res.Error != nil {
+			if errors.Is(res.Error, context.Canceled) || status.Code(res.Error) == codes.Canceled {
+				return
+			}
+			// Keep printing the events of the other servers.
+			log.WithError(res.Error).Warn("Failed to receive events")
+			if streams--; streams == 0 {
+				return
+			}
+			continue
+		}
+		if err := eventEncoder.Encode(res.GetEventsResponse); err != nil {
+			log.WithError(err).WithField("event", res.GetEventsResponse).Debug("Failed to encode event")
+		}
+	}
+}
@@ -55,7 +55,11 @@ func new() *cobra.Command {
 
 	flags := rootCmd.PersistentFlags()
 	flags.BoolP(common.KeyDebug, "d", false, "Enable debug messages")
-	flags.String(common.KeyServerAddress, "localhost:54321", "gRPC server address")
+	flags.String(common.KeyServerAddress, "localhost:54321", "gRPC server address, or unix:///PATH for a unix socket")
+	flags.String(common.KeyTLSCAFile, "", "Verify the gRPC server certificate with this CA file instead of the system CAs. Enables TLS")
+	flags.String(common.KeyTLSCertFile, "", "Client certificate file for gRPC servers requiring client authentication. Enables TLS")
+	flags.String(common.KeyTLSKeyFile, "", "Private key file of the client certificate")
+	flags.String(common.KeyTLSServerName, "", "Expected name in the gRPC server certificate, if different from the server address. Enables TLS")
 	viper.BindPFlags(flags)
 	return rootCmd
 }
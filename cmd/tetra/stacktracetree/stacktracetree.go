@@ -18,10 +18,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/cmd/tetra/common"
+	"github.com/cilium/tetragon/pkg/logger"
+	stt "github.com/cilium/tetragon/pkg/stacktracetree"
+	"google.golang.org/grpc"
 
 	"github.com/spf13/cobra"
 )
@@ -30,8 +37,14 @@ func New() *cobra.Command {
 	sttCmd := &cobra.Command{
 		Use:   "stacktrace-tree",
 		Short: "Manage stacktrace trees",
+		Long: `Manage stacktrace trees.
+
+The stack traces of the kprobe events of a tracing policy, for kprobe specs
+with kernelStack or userStack set, are in the trees POLICY/kernel and
+POLICY/user.`,
 	}
 
+	var printReset bool
 	sttPrintCmd := &cobra.Command{
 		Use:   "print <tree-name>",
 		Short: "Print stacktrace tree",
@@ -39,17 +52,105 @@ func New() *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			stt := args[0]
 			common.CliRun(func(ctx context.Context, cli tetragon.FineGuidanceSensorsClient) {
-				sttPrint(ctx, cli, stt)
+				sttPrint(ctx, cli, stt, printReset)
 			})
 		},
 	}
+	sttPrintCmd.Flags().BoolVar(&printReset, "reset", false, "Remove the stack traces of the tree once read")
 	sttCmd.AddCommand(sttPrintCmd)
 
+	var exportFormat, exportFile string
+	var exportReset bool
+	var exportServers []string
+	sttExportCmd := &cobra.Command{
+		Use:   "export <tree-name>",
+		Short: "Export stacktrace tree as a pprof profile or folded stacks",
+		Long: `Export stacktrace tree as a pprof profile or folded stacks.
+
+The pprof output can be read by go tool pprof, the folded output by Brendan
+Gregg's flamegraph.pl. With --servers, the trees of all the servers are merged.`,
+		Example: `  tetra stacktrace-tree export creds/kernel -o pprof -f creds.pb.gz && go tool pprof -http :8080 creds.pb.gz
+  tetra stacktrace-tree export creds/kernel -o folded | flamegraph.pl > creds.svg`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			format, ok := exportFormats[exportFormat]
+			if !ok {
+				logger.GetLogger().Fatalf("Unknown export format %q, expected pprof or folded", exportFormat)
+			}
+			w, closeFn := outputFile(exportFile)
+			defer closeFn()
+			if len(exportServers) > 0 {
+				sttExportServers(exportServers, args[0], format, exportReset, w)
+				return
+			}
+			common.CliRun(func(ctx context.Context, cli tetragon.FineGuidanceSensorsClient) {
+				sttExport(ctx, cli, args[0], format, exportReset, w)
+			})
+		},
+	}
+	sttExportCmd.Flags().StringVarP(&exportFormat, "output", "o", "pprof", "Export format. pprof or folded")
+	sttExportCmd.Flags().StringVarP(&exportFile, "file", "f", "-", "File to write the export to, - for stdout")
+	sttExportCmd.Flags().BoolVar(&exportReset, "reset", false, "Remove the stack traces of the tree once exported")
+	sttExportCmd.Flags().StringSliceVar(&exportServers, "servers", nil, "Merge the trees of several gRPC servers. Overrides --server-address")
+	sttCmd.AddCommand(sttExportCmd)
+
+	var diffServers []string
+	sttDiffCmd := &cobra.Command{
+		Use:   "diff <base-tree-name> <tree-name>",
+		Short: "Compare two stacktrace trees",
+		Long: `Compare two stacktrace trees.
+
+Prints the folded stacks of both trees with their counts in the base tree and
+in the other tree, as difffolded.pl does, for flamegraph.pl to draw a
+differential flame graph. With --servers, the trees of all the servers are
+merged.`,
+		Example: `  tetra stacktrace-tree diff before/kernel after/kernel | flamegraph.pl > diff.svg`,
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			var base, tree *stt.Sttree
+			if len(diffServers) > 0 {
+				base = getTreeServers(diffServers, args[0], false)
+				tree = getTreeServers(diffServers, args[1], false)
+			} else {
+				common.CliRun(func(ctx context.Context, cli tetragon.FineGuidanceSensorsClient) {
+					var err error
+					if base, err = getTree(ctx, cli, args[0], false); err != nil {
+						logger.GetLogger().WithError(err).Fatalf("Failed to get tree %s", args[0])
+					}
+					if tree, err = getTree(ctx, cli, args[1], false); err != nil {
+						logger.GetLogger().WithError(err).Fatalf("Failed to get tree %s", args[1])
+					}
+				})
+			}
+			if err := writeFoldedDiff(os.Stdout, base, tree); err != nil {
+				logger.GetLogger().WithError(err).Fatal("Failed to write diff")
+			}
+		},
+	}
+	sttDiffCmd.Flags().StringSliceVar(&diffServers, "servers", nil, "Merge the trees of several gRPC servers. Overrides --server-address")
+	sttCmd.AddCommand(sttDiffCmd)
+
 	return sttCmd
 }
 
-func sttPrint(ctx context.Context, client tetragon.FineGuidanceSensorsClient, stt string) {
-	res, err := client.GetStackTraceTree(ctx, &tetragon.GetStackTraceTreeRequest{Name: stt})
+var exportFormats = map[string]tetragon.StackTraceExportFormat{
+	"pprof":  tetragon.StackTraceExportFormat_STACK_TRACE_EXPORT_FORMAT_PPROF,
+	"folded": tetragon.StackTraceExportFormat_STACK_TRACE_EXPORT_FORMAT_FOLDED,
+}
+
+func outputFile(name string) (io.Writer, func()) {
+	if name == "-" {
+		return os.Stdout, func() {}
+	}
+	f, err := os.Create(name)
+	if err != nil {
+		logger.GetLogger().WithError(err).Fatal("Failed to create output file")
+	}
+	return f, func() { f.Close() }
+}
+
+func sttPrint(ctx context.Context, client tetragon.FineGuidanceSensorsClient, stt string, reset bool) {
+	res, err := client.GetStackTraceTree(ctx, &tetragon.GetStackTraceTreeRequest{Name: stt, ResetOnRead: reset})
 	if err != nil {
 		fmt.Printf("error printing stt %s: %s\n", stt, err)
 		return
@@ -85,3 +186,92 @@ func sttPrintNodeTree(node *tetragon.StackTraceNode, level int) {
 		}
 	}
 }
+
+func sttExport(ctx context.Context, client tetragon.FineGuidanceSensorsClient, name string, format tetragon.StackTraceExportFormat, reset bool, w io.Writer) {
+	res, err := client.ExportStackTraceTree(ctx, &tetragon.ExportStackTraceTreeRequest{
+		Name:        name,
+		Format:      format,
+		ResetOnRead: reset,
+	})
+	if err != nil {
+		logger.GetLogger().WithError(err).Fatalf("Failed to export tree %s", name)
+	}
+	if _, err := w.Write(res.Data); err != nil {
+		logger.GetLogger().WithError(err).Fatal("Failed to write export")
+	}
+}
+
+// sttExportServers merges the trees of several servers and exports the result.
+func sttExportServers(servers []string, name string, format tetragon.StackTraceExportFormat, reset bool, w io.Writer) {
+	tree := getTreeServers(servers, name, reset)
+	var err error
+	switch format {
+	case tetragon.StackTraceExportFormat_STACK_TRACE_EXPORT_FORMAT_PPROF:
+		err = tree.WritePprof(w)
+	case tetragon.StackTraceExportFormat_STACK_TRACE_EXPORT_FORMAT_FOLDED:
+		err = tree.WriteFolded(w)
+	}
+	if err != nil {
+		logger.GetLogger().WithError(err).Fatal("Failed to write export")
+	}
+}
+
+func getTree(ctx context.Context, client tetragon.FineGuidanceSensorsClient, name string, reset bool) (*stt.Sttree, error) {
+	res, err := client.GetStackTraceTree(ctx, &tetragon.GetStackTraceTreeRequest{Name: name, ResetOnRead: reset})
+	if err != nil {
+		return nil, err
+	}
+	return stt.SttreeFromProto(res.Root), nil
+}
+
+// getTreeServers returns the tree name merged from several servers. Servers
+// that fail are skipped with a warning.
+func getTreeServers(servers []string, name string, reset bool) *stt.Sttree {
+	creds, err := common.TransportCredentials()
+	if err != nil {
+		logger.GetLogger().WithError(err).Fatal("Failed to configure TLS")
+	}
+	tree := stt.CreateSttree()
+	for _, server := range servers {
+		log := logger.GetLogger().WithField("server", server)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		conn, err := grpc.DialContext(ctx, server, grpc.WithTransportCredentials(creds), grpc.WithBlock())
+		if err != nil {
+			cancel()
+			log.WithError(err).Warn("Failed to connect")
+			continue
+		}
+		t, err := getTree(ctx, tetragon.NewFineGuidanceSensorsClient(conn), name, reset)
+		conn.Close()
+		cancel()
+		if err != nil {
+			log.WithError(err).Warnf("Failed to get tree %s", name)
+			continue
+		}
+		tree.Merge(t)
+	}
+	return tree
+}
+
+// writeFoldedDiff writes the folded stacks of base and tree followed by their
+// counts in both, sorted by stack.
+func writeFoldedDiff(w io.Writer, base, tree *stt.Sttree) error {
+	baseFolded := base.Folded()
+	folded := tree.Folded()
+	var stacks []string
+	for stack := range baseFolded {
+		stacks = append(stacks, stack)
+	}
+	for stack := range folded {
+		if _, ok := baseFolded[stack]; !ok {
+			stacks = append(stacks, stack)
+		}
+	}
+	sort.Strings(stacks)
+	for _, stack := range stacks {
+		if _, err := fmt.Fprintf(w, "%s %d %d\n", stack, baseFolded[stack], folded[stack]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
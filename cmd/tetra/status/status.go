@@ -10,6 +10,8 @@ import (
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/cmd/tetra/common"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 func getStatus(ctx context.Context, client tetragon.FineGuidanceSensorsClient) {
@@ -21,12 +23,39 @@ func getStatus(ctx context.Context, client tetragon.FineGuidanceSensorsClient) {
 	fmt.Printf("Health Status: %s\n", response.GetHealthStatus()[0].Details)
 }
 
+// checkReady returns an error if the agent is not ready, according to the
+// overall status of the grpc.health.v1 service.
+func checkReady(ctx context.Context, conn *grpc.ClientConn) error {
+	response, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if response.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("agent is not ready: %s", response.Status)
+	}
+	return nil
+}
+
 func New() *cobra.Command {
-	return &cobra.Command{
+	var ready bool
+	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Print health status",
-		Run: func(cmd *cobra.Command, args []string) {
-			common.CliRun(getStatus)
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !ready {
+				common.CliRun(getStatus)
+				return nil
+			}
+			var err error
+			common.CliRunConn(func(ctx context.Context, conn *grpc.ClientConn) {
+				err = checkReady(ctx, conn)
+			}, func(_ error) {})
+			if err == nil {
+				fmt.Println("Ready")
+			}
+			return err
 		},
 	}
+	cmd.Flags().BoolVar(&ready, "ready", false, "Check that the agent is ready, and exit with an error if it is not")
+	return cmd
 }
@@ -30,12 +30,40 @@ const (
 	keyEnableCiliumAPI        = "enable-cilium-api"
 	keyEnableProcessAncestors = "enable-process-ancestors"
 
-	keyMetricsServer     = "metrics-server"
-	keyServerAddress     = "server-address"
-	keyCiliumBPF         = "cilium-bpf"
-	keyEnableProcessCred = "enable-process-cred"
-	keyEnableProcessNs   = "enable-process-ns"
-	keyConfigFile        = "config-file"
+	keyMetricsServer          = "metrics-server"
+	keyMetricsConfig          = "metrics-config"
+	keyServerAddress          = "server-address"
+	keyCiliumBPF              = "cilium-bpf"
+	keyEnableProcessCred      = "enable-process-cred"
+	keyEnableProcessNs        = "enable-process-ns"
+	keyEnableProcessNsChanges = "enable-process-ns-changes"
+	keyConfigFile             = "config-file"
+
+	keyEnableProcessBinaryInfo = "enable-process-binary-info"
+	keyBinaryHashWorkers       = "binary-hash-workers"
+	keyBinaryHashCacheSize     = "binary-hash-cache-size"
+
+	keyEnableProcessCredChanges = "enable-process-cred-changes"
+	keyProcessCredChangesFilter = "process-cred-changes-filter"
+
+	keyEnableKernelIntegrity = "enable-kernel-integrity"
+
+	keyEnableProcessMount = "enable-process-mount"
+
+	keyEnableStackTraceTrees = "enable-stack-trace-trees"
+
+	keyEventQueueSize           = "event-queue-size"
+	keyEventQueueOverflowPolicy = "event-queue-overflow-policy"
+	keyEventRingSize            = "event-ring-size"
+	keyEventRingFile            = "event-ring-file"
+
+	keyBPFRingBufSize     = "bpf-ringbuf-size"
+	keyEventDecodeWorkers = "event-decode-workers"
+	keyEnableBPFStats     = "enable-bpf-stats"
+
+	keyRecordSamples    = "record-samples"
+	keyRecordSamplesMax = "record-samples-max"
+	keyReplay           = "replay"
 
 	keyRunStandalone      = "run-standalone"
 	keyIgnoreMissingProgs = "ignore-missing-progs"
@@ -56,18 +84,52 @@ const (
 	keyExportAllowlist = "export-allowlist"
 	keyExportDenylist  = "export-denylist"
 
+	keyExportFieldMaskInclude = "export-field-mask-include"
+	keyExportFieldMaskExclude = "export-field-mask-exclude"
+
 	keyNetnsDir = "netns-dir"
+
+	keyServerTLSCertFile      = "server-tls-cert-file"
+	keyServerTLSKeyFile       = "server-tls-key-file"
+	keyServerTLSClientCAFiles = "server-tls-client-ca-files"
+	keyServerUnixSocket       = "server-unix-socket"
+	keyServerUnixSocketMode   = "server-unix-socket-mode"
+	keyServerUnixSocketGroup  = "server-unix-socket-group"
+	keyServerAdminClients     = "server-admin-clients"
+
+	keyServerAllowInsecureMutations = "server-allow-insecure-mutations"
 )
 
 var (
 	processCacheSize int
 
+	processCredChangesFilter string
+
 	metricsServer string
+	metricsConfig string
 	serverAddress string
 	configFile    string
 
+	// gRPC server transport and authorization options
+	serverTLSCertFile      string
+	serverTLSKeyFile       string
+	serverTLSClientCAFiles []string
+	serverUnixSocket       string
+	serverUnixSocketMode   string
+	serverUnixSocketGroup  string
+	serverAdminClients     []string
+
+	serverAllowInsecureMutations bool
+
 	runStandalone bool
 
+	enableBPFStats bool
+
+	// Raw BPF samples recording and replay
+	recordSamplesFile string
+	recordSamplesMax  int
+	replayFile        string
+
 	exportFilename             string
 	exportFileMaxSizeMB        int
 	exportFileRotationInterval time.Duration
@@ -96,9 +158,32 @@ func readAndSetFlags() {
 
 	option.Config.EnableProcessCred = viper.GetBool(keyEnableProcessCred)
 	option.Config.EnableProcessNs = viper.GetBool(keyEnableProcessNs)
+	option.Config.EnableProcessNsChanges = viper.GetBool(keyEnableProcessNsChanges)
 	option.Config.EnableCilium = viper.GetBool(keyEnableCiliumAPI)
 	option.Config.EnableK8s = viper.GetBool(keyEnableK8sAPI)
 
+	option.Config.EnableProcessBinaryInfo = viper.GetBool(keyEnableProcessBinaryInfo)
+	option.Config.BinaryHashWorkers = viper.GetInt(keyBinaryHashWorkers)
+	option.Config.BinaryHashCacheSize = viper.GetInt(keyBinaryHashCacheSize)
+
+	option.Config.EnableProcessCredChanges = viper.GetBool(keyEnableProcessCredChanges)
+	processCredChangesFilter = viper.GetString(keyProcessCredChangesFilter)
+
+	option.Config.EnableKernelIntegrity = viper.GetBool(keyEnableKernelIntegrity)
+
+	option.Config.EnableProcessMount = viper.GetBool(keyEnableProcessMount)
+
+	option.Config.EnableStackTraceTrees = viper.GetBool(keyEnableStackTraceTrees)
+
+	option.Config.EventQueueSize = viper.GetInt(keyEventQueueSize)
+	option.Config.EventQueueOverflowPolicy = viper.GetString(keyEventQueueOverflowPolicy)
+	option.Config.EventRingSize = viper.GetInt(keyEventRingSize)
+	option.Config.EventRingFile = viper.GetString(keyEventRingFile)
+
+	option.Config.BPFRingBufSize = viper.GetInt(keyBPFRingBufSize)
+	option.Config.EventDecodeWorkers = viper.GetInt(keyEventDecodeWorkers)
+	enableBPFStats = viper.GetBool(keyEnableBPFStats)
+
 	logLevel := viper.GetString(keyLogLevel)
 	logFormat := viper.GetString(keyLogFormat)
 	logger.PopulateLogOpts(option.Config.LogOpts, logLevel, logFormat)
@@ -106,12 +191,25 @@ func readAndSetFlags() {
 	processCacheSize = viper.GetInt(keyProcessCacheSize)
 
 	metricsServer = viper.GetString(keyMetricsServer)
+	metricsConfig = viper.GetString(keyMetricsConfig)
 	serverAddress = viper.GetString(keyServerAddress)
+	serverTLSCertFile = viper.GetString(keyServerTLSCertFile)
+	serverTLSKeyFile = viper.GetString(keyServerTLSKeyFile)
+	serverTLSClientCAFiles = viper.GetStringSlice(keyServerTLSClientCAFiles)
+	serverUnixSocket = viper.GetString(keyServerUnixSocket)
+	serverUnixSocketMode = viper.GetString(keyServerUnixSocketMode)
+	serverUnixSocketGroup = viper.GetString(keyServerUnixSocketGroup)
+	serverAdminClients = viper.GetStringSlice(keyServerAdminClients)
+	serverAllowInsecureMutations = viper.GetBool(keyServerAllowInsecureMutations)
 	option.Config.CiliumDir = viper.GetString(keyCiliumBPF)
 	configFile = viper.GetString(keyConfigFile)
 
 	runStandalone = viper.GetBool(keyRunStandalone)
 
+	recordSamplesFile = viper.GetString(keyRecordSamples)
+	recordSamplesMax = viper.GetInt(keyRecordSamplesMax)
+	replayFile = viper.GetString(keyReplay)
+
 	exportFilename = viper.GetString(keyExportFilename)
 	exportFileMaxSizeMB = viper.GetInt(keyExportFileMaxSizeMB)
 	exportFileRotationInterval = viper.GetDuration(keyExportFileRotationInterval)
@@ -9,8 +9,11 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"os/user"
+	"path/filepath"
 	"runtime"
 	"runtime/pprof"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -26,14 +29,21 @@ import (
 	"github.com/cilium/tetragon/pkg/exporter"
 	"github.com/cilium/tetragon/pkg/filters"
 	tetragonGrpc "github.com/cilium/tetragon/pkg/grpc"
+	"github.com/cilium/tetragon/pkg/health"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/metrics"
+	"github.com/cilium/tetragon/pkg/metrics/derivedmetrics"
 	"github.com/cilium/tetragon/pkg/observer"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/process"
 	"github.com/cilium/tetragon/pkg/ratelimit"
 	"github.com/cilium/tetragon/pkg/sensors"
 	"github.com/cilium/tetragon/pkg/sensors/base"
+	"github.com/cilium/tetragon/pkg/sensors/cred"
+	"github.com/cilium/tetragon/pkg/sensors/integrity"
+	"github.com/cilium/tetragon/pkg/sensors/mount"
+	"github.com/cilium/tetragon/pkg/sensors/ns"
 	"github.com/cilium/tetragon/pkg/server"
 	"github.com/cilium/tetragon/pkg/version"
 	"github.com/cilium/tetragon/pkg/watcher"
@@ -42,13 +52,19 @@ import (
 	// Imported to allow sensors to be initialized inside init().
 	_ "github.com/cilium/tetragon/pkg/sensors"
 
+	"github.com/cilium/ebpf"
 	"github.com/cilium/lumberjack/v2"
 	gops "github.com/google/gops/agent"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
@@ -69,6 +85,24 @@ func getExportFilters() ([]*tetragon.Filter, []*tetragon.Filter, error) {
 	return allowList, denyList, nil
 }
 
+func getExportFieldMask() (*tetragon.EventFieldMask, error) {
+	include := viper.GetStringSlice(keyExportFieldMaskInclude)
+	exclude := viper.GetStringSlice(keyExportFieldMaskExclude)
+	if len(include) == 0 && len(exclude) == 0 {
+		return nil, nil
+	}
+	mask := &tetragon.EventFieldMask{
+		Include: &fieldmaskpb.FieldMask{Paths: include},
+		Exclude: &fieldmaskpb.FieldMask{Paths: exclude},
+	}
+	// Report invalid paths at startup rather than when the exporter starts
+	// reading events.
+	if _, err := server.NewFieldMask(mask); err != nil {
+		return nil, err
+	}
+	return mask, nil
+}
+
 func saveInitInfo() error {
 	info := bugtool.InitInfo{
 		ExportFname: exportFilename,
@@ -76,6 +110,8 @@ func saveInitInfo() error {
 		BtfFname:    option.Config.BTF,
 		MetricsAddr: metricsServer,
 		ServerAddr:  serverAddress,
+
+		ServerUnixSocket: serverUnixSocket,
 	}
 	return bugtool.SaveInitInfo(&info)
 }
@@ -97,6 +133,16 @@ func readConfig(file string) (*config.GenericTracingConf, error) {
 	return cnf, nil
 }
 
+func getCredSensor(filter string) (*sensors.Sensor, error) {
+	var selectors []v1alpha1.CapabilitiesSelector
+	if filter != "" {
+		if err := json.Unmarshal([]byte(filter), &selectors); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", keyProcessCredChangesFilter, err)
+		}
+	}
+	return cred.GetCredSensor(selectors)
+}
+
 func stopProfile() {
 	if memProfile != "" {
 		log.WithField("file", memProfile).Info("Stopping mem profiling")
@@ -135,6 +181,10 @@ func tetragonExecute() error {
 		defaults.NetnsDir = viper.GetString(keyNetnsDir)
 	}
 
+	if replayFile != "" {
+		return tetragonReplay(sigs)
+	}
+
 	if memProfile != "" {
 		log.WithField("file", memProfile).Info("Starting mem profiling")
 	}
@@ -158,7 +208,20 @@ func tetragonExecute() error {
 
 	sensors.LogRegisteredSensorsAndProbes()
 
+	if _, err := server.ParseOverflowPolicy(option.Config.EventQueueOverflowPolicy); err != nil {
+		return err
+	}
+
 	bpf.ConfigureResourceLimits()
+	if enableBPFStats {
+		// The statistics stay enabled as long as the returned fd is open.
+		stats, err := ebpf.EnableStats(uint32(unix.BPF_STATS_RUN_TIME))
+		if err != nil {
+			log.WithError(err).Warn("Failed to enable BPF statistics, set kernel.bpf_stats_enabled instead")
+		} else {
+			defer stats.Close()
+		}
+	}
 	observerDir := getObserverDir()
 	option.Config.BpfDir = observerDir
 	option.Config.MapDir = observerDir
@@ -167,6 +230,16 @@ func tetragonExecute() error {
 		return err
 	}
 
+	// Start recording before the /proc scan of base.LoadDefault, so that
+	// replaying the samples starts with the same processes.
+	stopRecording := func() {}
+	if recordSamplesFile != "" {
+		var err error
+		if stopRecording, err = startRecording(obs); err != nil {
+			return err
+		}
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	var cancelWg sync.WaitGroup
@@ -195,19 +268,44 @@ func tetragonExecute() error {
 		go metrics.EnableMetrics(metricsServer)
 	}
 
+	if metricsConfig != "" {
+		specs, err := derivedmetrics.ReadConfigFile(metricsConfig)
+		if err != nil {
+			return err
+		}
+		if err := derivedmetrics.Set(derivedmetrics.ConfigSource, specs); err != nil {
+			return fmt.Errorf("failed to set metrics of %s: %w", metricsConfig, err)
+		}
+	}
+
 	watcher, err := getWatcher()
 	if err != nil {
 		return err
 	}
+	if option.Config.EnableCilium {
+		health.SetStatus(health.CiliumState, health.Starting, "")
+	}
 	ciliumState, err := cilium.InitCiliumState(ctx, option.Config.EnableCilium)
 	if err != nil {
+		if option.Config.EnableCilium {
+			health.SetStatus(health.CiliumState, health.Failed, err.Error())
+		}
 		return err
 	}
+	if option.Config.EnableCilium {
+		health.SetStatus(health.CiliumState, health.Serving, "")
+	}
 
 	if err := process.InitCache(ctx, watcher, option.Config.EnableCilium, processCacheSize); err != nil {
 		return err
 	}
 
+	if option.Config.EnableProcessBinaryInfo {
+		if err := process.InitBinaryInfo(option.Config.BinaryHashWorkers, option.Config.BinaryHashCacheSize); err != nil {
+			return err
+		}
+	}
+
 	pm, err := tetragonGrpc.NewProcessManager(
 		ctx,
 		&cancelWg,
@@ -216,7 +314,8 @@ func tetragonExecute() error {
 	if err != nil {
 		return err
 	}
-	if err = Serve(ctx, serverAddress, pm.Server); err != nil {
+	pm.Server.SetSampleRecorder(obs)
+	if err = Serve(ctx, pm.Server); err != nil {
 		return err
 	}
 	if exportFilename != "" {
@@ -229,6 +328,7 @@ func tetragonExecute() error {
 		<-sigs
 		obs.PrintStats()
 		obs.RemovePrograms()
+		stopRecording()
 		stopProfile()
 		cancel()
 		cancelWg.Wait()
@@ -249,15 +349,42 @@ func tetragonExecute() error {
 			return err
 		}
 
-		startSensors, err = sensors.GetSensorsFromParserPolicy(&cnf.Spec)
+		startSensors, err = sensors.GetSensorsFromParserPolicy(cnf.Metadata.Name, &cnf.Spec)
+		if err != nil {
+			return err
+		}
+	}
+
+	if option.Config.EnableProcessCredChanges {
+		credSensor, err := getCredSensor(processCredChangesFilter)
+		if err != nil {
+			return err
+		}
+		startSensors = append(startSensors, credSensor)
+	}
+
+	if option.Config.EnableProcessNsChanges {
+		startSensors = append(startSensors, ns.GetNsSensor())
+	}
+
+	if option.Config.EnableKernelIntegrity {
+		startSensors = append(startSensors, integrity.GetIntegritySensor())
+	}
+
+	if option.Config.EnableProcessMount {
+		mountSensor, err := mount.GetMountSensor("", nil)
 		if err != nil {
 			return err
 		}
+		startSensors = append(startSensors, mountSensor)
 	}
 
 	if err := base.LoadDefault(ctx, observerDir, observerDir, option.Config.CiliumDir); err != nil {
 		return err
 	}
+	// Loading the base sensors populated the process cache with the running
+	// processes.
+	health.SetReady()
 
 	return obs.Start(ctx, startSensors)
 }
@@ -274,6 +401,10 @@ func startExporter(ctx context.Context, server *server.Server) error {
 	if err != nil {
 		return err
 	}
+	fieldMask, err := getExportFieldMask()
+	if err != nil {
+		return err
+	}
 	writer := &lumberjack.Logger{
 		Filename:   exportFilename,
 		MaxSize:    exportFileMaxSizeMB,
@@ -310,31 +441,114 @@ func startExporter(ctx context.Context, server *server.Server) error {
 			ChannelBufferSize: exportAggregationBufferSize,
 		}
 	}
-	req := tetragon.GetEventsRequest{AllowList: allowList, DenyList: denyList, AggregationOptions: aggregationOptions}
+	req := tetragon.GetEventsRequest{AllowList: allowList, DenyList: denyList, AggregationOptions: aggregationOptions, FieldMask: fieldMask}
 	log.WithFields(logrus.Fields{"logger": writer, "request": &req}).Info("Starting JSON exporter")
 	exporter := exporter.NewExporter(ctx, &req, server, encoder, writer, rateLimiter)
 	exporter.Start()
 	return nil
 }
 
-func Serve(ctx context.Context, address string, server *server.Server) error {
-	grpcServer := grpc.NewServer()
-	tetragon.RegisterFineGuidanceSensorsServer(grpcServer, server)
-	go func(address string) {
-		listener, err := net.Listen("tcp", address)
+// Serve starts the gRPC servers on the TCP address and the unix socket, if
+// configured.
+//
+// Without --server-admin-clients, mutating RPCs are denied. Plaintext TCP
+// listeners cannot authenticate clients, they only allow mutating RPCs to
+// every client with --server-allow-insecure-mutations.
+func Serve(ctx context.Context, srv *server.Server) error {
+	if len(serverAdminClients) == 0 && !serverAllowInsecureMutations {
+		log.Info("Mutating RPCs such as AddTracingPolicy are denied, set --server-admin-clients to allow them")
+	}
+
+	if serverAddress != "" {
+		opts, err := tcpServerOptions()
+		if err != nil {
+			return err
+		}
+		authorizer := server.NewAuthorizer(serverAdminClients, len(opts) > 0 || !serverAllowInsecureMutations)
+		if authorizer == nil {
+			log.WithField("address", serverAddress).Warn("All gRPC clients are allowed to call mutating RPCs on plaintext TCP (--server-allow-insecure-mutations)")
+		}
+		listener, err := net.Listen("tcp", serverAddress)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %s: %w", serverAddress, err)
+		}
+		serveGRPC(ctx, listener, srv, authorizer, opts...)
+	}
+
+	if serverUnixSocket != "" {
+		listener, err := listenUnixSocket()
 		if err != nil {
-			log.WithError(err).WithField("address", address).Fatal("Failed to start gRPC server")
+			return err
 		}
+		authorizer := server.NewAuthorizer(serverAdminClients, true)
+		serveGRPC(ctx, listener, srv, authorizer, grpc.Creds(server.NewUnixPeerCredentials()))
+	}
+	return nil
+}
+
+func tcpServerOptions() ([]grpc.ServerOption, error) {
+	if serverTLSCertFile == "" && serverTLSKeyFile == "" {
+		if len(serverTLSClientCAFiles) > 0 {
+			return nil, fmt.Errorf("--%s requires --%s and --%s", keyServerTLSClientCAFiles, keyServerTLSCertFile, keyServerTLSKeyFile)
+		}
+		return nil, nil
+	}
+	tlsConfig, err := server.NewTLSConfig(serverTLSCertFile, serverTLSKeyFile, serverTLSClientCAFiles)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("clientAuth", tlsConfig.ClientAuth.String()).Info("Enabling gRPC TLS")
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}, nil
+}
+
+func listenUnixSocket() (net.Listener, error) {
+	mode, err := strconv.ParseUint(serverUnixSocketMode, 8, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s %q: %w", keyServerUnixSocketMode, serverUnixSocketMode, err)
+	}
+	gid := -1
+	if serverUnixSocketGroup != "" {
+		if gid, err = strconv.Atoi(serverUnixSocketGroup); err != nil {
+			group, err := user.LookupGroup(serverUnixSocketGroup)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --%s: %w", keyServerUnixSocketGroup, err)
+			}
+			if gid, err = strconv.Atoi(group.Gid); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(serverUnixSocket), 0755); err != nil {
+		return nil, err
+	}
+	listener, err := server.ListenUnix(serverUnixSocket, os.FileMode(mode), gid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", serverUnixSocket, err)
+	}
+	return listener, nil
+}
+
+func serveGRPC(ctx context.Context, listener net.Listener, srv *server.Server, authorizer *server.Authorizer, opts ...grpc.ServerOption) {
+	if authorizer != nil {
+		opts = append(opts,
+			grpc.UnaryInterceptor(authorizer.UnaryInterceptor()),
+			grpc.StreamInterceptor(authorizer.StreamInterceptor()))
+	}
+	grpcServer := grpc.NewServer(opts...)
+	tetragon.RegisterFineGuidanceSensorsServer(grpcServer, srv)
+	healthpb.RegisterHealthServer(grpcServer, health.GRPCServer())
+	reflection.Register(grpcServer)
+	address := listener.Addr().String()
+	go func() {
 		log.WithField("address", address).Info("Starting gRPC server")
-		if err = grpcServer.Serve(listener); err != nil {
-			log.WithError(err).Error("Failed to close gRPC server")
+		if err := grpcServer.Serve(listener); err != nil {
+			log.WithError(err).WithField("address", address).Error("Failed to close gRPC server")
 		}
-	}(address)
+	}()
 	go func() {
 		<-ctx.Done()
 		grpcServer.Stop()
 	}()
-	return nil
 }
 
 func getWatcher() (watcher.K8sResourceWatcher, error) {
@@ -345,7 +559,11 @@ func getWatcher() (watcher.K8sResourceWatcher, error) {
 			return nil, err
 		}
 		k8sClient := kubernetes.NewForConfigOrDie(config)
-		return watcher.NewK8sWatcher(k8sClient, 60*time.Second), nil
+		health.SetStatus(health.K8sWatcher, health.Starting, "waiting for the pod cache to sync")
+		// NewK8sWatcher returns once the pod cache is synced.
+		k8sWatcher := watcher.NewK8sWatcher(k8sClient, 60*time.Second)
+		health.SetStatus(health.K8sWatcher, health.Serving, "")
+		return k8sWatcher, nil
 
 	}
 	log.Info("Disabling Kubernetes API")
@@ -414,10 +632,38 @@ func execute() error {
 	flags.Bool(keyEnableCiliumAPI, false, "Access Cilium API to associate Tetragon events with Cilium endpoints and DNS cache")
 	flags.Bool(keyEnableProcessAncestors, true, "Include ancestors in process exec events")
 	flags.String(keyMetricsServer, "", "Metrics server address (e.g. ':2112'). Set it to an empty string to disable.")
-	flags.String(keyServerAddress, "localhost:54321", "gRPC server address")
+	flags.String(keyMetricsConfig, "", "YAML file declaring metrics derived from the events, with the format of the metrics of tracing policies")
+	flags.String(keyServerAddress, "localhost:54321", "gRPC server address. Set it to an empty string to disable the TCP listener")
+	flags.String(keyServerTLSCertFile, "", "Serve gRPC over TLS with this certificate file on the TCP listener")
+	flags.String(keyServerTLSKeyFile, "", "Private key file of the gRPC server TLS certificate")
+	flags.StringSlice(keyServerTLSClientCAFiles, nil, "Require gRPC clients to present a certificate signed by one of these CA files")
+	flags.String(keyServerUnixSocket, "", "Also serve gRPC on this unix socket (e.g. '/var/run/tetragon/tetragon.sock')")
+	flags.String(keyServerUnixSocketMode, "0660", "File permissions of the gRPC unix socket")
+	flags.String(keyServerUnixSocketGroup, "", "Group name or id owning the gRPC unix socket")
+	flags.StringSlice(keyServerAdminClients, nil, "Only allow these clients to call mutating RPCs such as AddTracingPolicy: TLS client certificate common names, or uid:UID for unix socket peers. By default mutating RPCs are denied")
+	flags.Bool(keyServerAllowInsecureMutations, false, "Allow every client of a plaintext TCP listener to call mutating RPCs such as AddTracingPolicy. Plaintext TCP clients cannot be authenticated, only use this when the gRPC address is not reachable by untrusted users")
+	flags.Int(keyEventQueueSize, server.DefaultEventQueueSize, "Number of events queued for each GetEvents client, including the exporter")
+	flags.String(keyEventQueueOverflowPolicy, "drop-newest", "What to do when the event queue of a client is full: drop-newest, drop-oldest or disconnect. Lost events are reported with events_lost messages")
+	flags.Int(keyEventRingSize, 4096, "Number of recent events kept for GetEvents clients resuming a stream with since_sequence or since_time. Set to 0 to disable")
+	flags.String(keyEventRingFile, "", "Persist the recent events to this file, so that they and the sequence numbers survive restarts")
+	flags.Int(keyBPFRingBufSize, defaults.DefaultBPFRingBufSize, "Size in bytes of the BPF ring buffer events are sent through, rounded up to a power of 2 number of pages. Kernels without BPF ring buffers use per-CPU perf buffers instead")
+	flags.Int(keyEventDecodeWorkers, 0, "Number of workers decoding BPF events. Events are assigned to workers by process, so that the events of a process keep their order. Set to 0 to decode events on the reader goroutine (default)")
+	flags.Bool(keyEnableBPFStats, false, "Enable the run count and run time statistics of BPF programs while Tetragon runs, as kernel.bpf_stats_enabled does, for the bpf_prog_runs_total and bpf_prog_run_time_seconds_total metrics. This adds some overhead to every BPF program")
+	flags.String(keyRecordSamples, "", "Record the raw BPF samples to this file, to replay them with --replay")
+	flags.Int(keyRecordSamplesMax, 0, "Stop recording raw BPF samples after this many samples. Set to 0 for no limit")
+	flags.String(keyReplay, "", "Replay the raw BPF samples of this file, recorded with --record-samples or tetra bugtool --capture, instead of loading BPF programs. Use the --config-file of the recording agent so that kprobe and tracepoint events are decoded the same way")
 	flags.String(keyCiliumBPF, "", "Cilium BPF directory")
 	flags.Bool(keyEnableProcessCred, false, "Enable process_cred events")
 	flags.Bool(keyEnableProcessNs, false, "Enable namespace information in process_exec and process_kprobe events")
+	flags.Bool(keyEnableProcessNsChanges, false, "Enable process_namespace_change events for setns, unshare and clone")
+	flags.Bool(keyEnableProcessBinaryInfo, false, "Enable binary hash and file metadata in process_exec events")
+	flags.Int(keyBinaryHashWorkers, 2, "Number of workers computing binary hashes. Set to 0 to disable hashing")
+	flags.Int(keyBinaryHashCacheSize, 4096, "Size of the binary hash cache")
+	flags.Bool(keyEnableProcessCredChanges, false, "Enable process_credentials_change events")
+	flags.String(keyProcessCredChangesFilter, "", "Report capability-only credentials changes matching this list of matchCapabilityChanges selectors (JSON). By default all changes are reported")
+	flags.Bool(keyEnableKernelIntegrity, false, "Enable kernel module load, BPF program load and BPF map creation events")
+	flags.Bool(keyEnableProcessMount, false, "Enable process_mount events for mount, umount, pivot_root, move_mount, fsopen and fsmount")
+	flags.Bool(keyEnableStackTraceTrees, false, "Merge the stack traces of kprobe events into per policy trees, see 'tetra stacktrace-tree'")
 
 	// Config files
 	flags.String(keyConfigFile, "", "Configuration file to load from")
@@ -444,6 +690,10 @@ func execute() error {
 	flags.String(keyExportAllowlist, "", "JSON export allowlist")
 	flags.String(keyExportDenylist, "", "JSON export denylist")
 
+	// JSON export field mask options
+	flags.StringSlice(keyExportFieldMaskInclude, nil, "Fields to include in JSON export, e.g. process_kprobe.function_name")
+	flags.StringSlice(keyExportFieldMaskExclude, nil, "Fields to exclude from JSON export, e.g. process_kprobe.process.pod.labels")
+
 	// Network namespace options
 	flags.String(keyNetnsDir, "/var/run/docker/netns/", "Network namespace dir")
 
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/cilium/tetragon/pkg/btf"
+	"github.com/cilium/tetragon/pkg/cilium"
+	tetragonGrpc "github.com/cilium/tetragon/pkg/grpc"
+	"github.com/cilium/tetragon/pkg/ktime"
+	"github.com/cilium/tetragon/pkg/observer"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/process"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/watcher"
+)
+
+// startRecording records the raw BPF samples read by obs to --record-samples.
+// The returned function stops recording.
+func startRecording(obs *observer.Observer) (func(), error) {
+	f, err := os.Create(recordSamplesFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create samples file: %w", err)
+	}
+	if err := obs.StartRecording(f, recordSamplesMax); err != nil {
+		f.Close()
+		return nil, err
+	}
+	log.WithField("file", recordSamplesFile).Info("Recording BPF samples")
+
+	return func() {
+		n, err := obs.StopRecording()
+		if err != nil {
+			log.WithError(err).WithField("file", recordSamplesFile).Warn("Failed to record BPF samples")
+		}
+		f.Close()
+		log.WithField("file", recordSamplesFile).WithField("samples", n).Info("Recorded BPF samples")
+	}, nil
+}
+
+// tetragonReplay runs tetragon on the raw BPF samples of --replay instead of
+// the ones of BPF programs: events are decoded and enriched as when they were
+// recorded, and served over gRPC and exported as usual until tetragon is
+// stopped. Kubernetes and Cilium enrichment is disabled.
+func tetragonReplay(sigs <-chan os.Signal) error {
+	f, err := os.Open(replayFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	sr, err := observer.NewSampleReader(f)
+	if err != nil {
+		return fmt.Errorf("failed to read samples file %s: %w", replayFile, err)
+	}
+	// Event times are decoded relative to the recording clocks.
+	ktime.SetClockReference(&sr.Clocks)
+	option.Config.EnableK8s = false
+	option.Config.EnableCilium = false
+
+	obs := observer.NewObserver(configFile)
+	if err := obs.InitSensorManager(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var cancelWg sync.WaitGroup
+
+	if err := btf.InitCachedBTF(ctx, option.Config.HubbleLib, option.Config.BTF); err != nil {
+		return err
+	}
+	ciliumState, err := cilium.InitCiliumState(ctx, false)
+	if err != nil {
+		return err
+	}
+	if err := process.InitCache(ctx, watcher.NewFakeK8sWatcher(nil), false, processCacheSize); err != nil {
+		return err
+	}
+	pm, err := tetragonGrpc.NewProcessManager(ctx, &cancelWg, ciliumState, observer.SensorManager)
+	if err != nil {
+		return err
+	}
+	if err = Serve(ctx, pm.Server); err != nil {
+		return err
+	}
+	if exportFilename != "" {
+		if err = startExporter(ctx, pm.Server); err != nil {
+			return err
+		}
+	}
+	obs.AddListener(pm)
+
+	// Creating the sensors of the tracing policy, without loading them,
+	// sets up the decoding of its kprobe and tracepoint events.
+	if len(configFile) > 0 {
+		cnf, err := readConfig(configFile)
+		if err != nil {
+			return err
+		}
+		if _, err := sensors.GetSensorsFromParserPolicy(cnf.Metadata.Name, &cnf.Spec); err != nil {
+			return err
+		}
+	}
+
+	log.WithField("file", replayFile).WithField("recorded", sr.Clocks.Wall).Info("Replaying BPF samples")
+	// Samples are decoded in order on this goroutine, as the reader of a
+	// running agent does, so that replays produce the same events in the
+	// same order.
+	n, err := obs.ReplaySampleReader(sr, 0)
+	if err != nil {
+		log.WithError(err).WithField("file", replayFile).Warn("Failed to read samples")
+	}
+	log.WithField("file", replayFile).WithField("samples", n).Info("Replayed BPF samples, stop tetragon to exit")
+
+	<-sigs
+	obs.PrintStats()
+	cancel()
+	cancelWg.Wait()
+	return nil
+}
@@ -73,6 +73,12 @@
       command:
       - tetra
       - status
+  readinessProbe:
+    exec:
+      command:
+      - tetra
+      - status
+      - --ready
 {{- end -}}
 
 {{- define "container.tetragon.init-operator" -}}
@@ -27,11 +27,18 @@ func CheckStructAlignments(path string) error {
 	// Validate alignments of C and Go equivalent structs
 	toCheck := map[string][]reflect.Type{
 		// from perf_event_output
-		"msg_exit":         {reflect.TypeOf(processapi.MsgExitEvent{})},
-		"msg_test":         {reflect.TypeOf(testapi.MsgTestEvent{})},
-		"msg_execve_key":   {reflect.TypeOf(processapi.MsgExecveKey{})},
-		"execve_map_value": {reflect.TypeOf(execvemap.ExecveValue{})},
-		"event_config":     {reflect.TypeOf(tracingapi.EventConfig{})},
+		"msg_exit":           {reflect.TypeOf(processapi.MsgExitEvent{})},
+		"msg_cred_change":    {reflect.TypeOf(processapi.MsgCredChangeEvent{})},
+		"msg_ns_change":      {reflect.TypeOf(processapi.MsgNsChangeEvent{})},
+		"msg_kmod_load":      {reflect.TypeOf(processapi.MsgKmodLoadEvent{})},
+		"msg_bpf_prog_load":  {reflect.TypeOf(processapi.MsgBpfProgLoadEvent{})},
+		"msg_bpf_map_create": {reflect.TypeOf(processapi.MsgBpfMapCreateEvent{})},
+		"msg_mount":          {reflect.TypeOf(processapi.MsgMountEvent{})},
+		"msg_test":           {reflect.TypeOf(testapi.MsgTestEvent{})},
+		"msg_execve_key":     {reflect.TypeOf(processapi.MsgExecveKey{})},
+		"execve_map_value":   {reflect.TypeOf(execvemap.ExecveValue{})},
+		"event_config":       {reflect.TypeOf(tracingapi.EventConfig{})},
+		"config_btf_arg":     {reflect.TypeOf(tracingapi.ConfigBTFArg{})},
 	}
 	return check.CheckStructAlignments(path, toCheck, true)
 }
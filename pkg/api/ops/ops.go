@@ -21,6 +21,29 @@ const (
 
 	MSG_OP_DATA = 24
 
+	// MSG_OP_CRED_CHANGE notifies user-space that the credentials of a
+	// process changed.
+	MSG_OP_CRED_CHANGE = 25
+
+	// MSG_OP_NS_CHANGE notifies user-space that setns(), unshare() or
+	// clone() changed the namespaces of a process.
+	MSG_OP_NS_CHANGE = 26
+
+	// Kernel integrity events: kernel module loads, BPF program loads and
+	// BPF map creation.
+	MSG_OP_KMOD_LOAD      = 27
+	MSG_OP_BPF_PROG_LOAD  = 28
+	MSG_OP_BPF_MAP_CREATE = 29
+
+	// MSG_OP_MOUNT notifies user-space of mount table changes done by
+	// mount(), umount2(), pivot_root(), move_mount() and fsopen()/fsmount().
+	MSG_OP_MOUNT = 30
+
+	// MSG_OP_EXECVE_PROCFS is not sent by BPF programs. It holds the
+	// execve events of the processes found in /proc at startup, in the
+	// sample files recorded by the observer.
+	MSG_OP_EXECVE_PROCFS = 253
+
 	// just for testing
 	MSG_OP_TEST = 254
 )
@@ -46,6 +69,13 @@ func (op OpCode) String() string {
 		14:  "GenericTracepoint",
 		23:  "Clone",
 		24:  "Data",
+		25:  "CredChange",
+		26:  "NsChange",
+		27:  "KmodLoad",
+		28:  "BpfProgLoad",
+		29:  "BpfMapCreate",
+		30:  "Mount",
+		253: "ExecveProcfs",
 		254: "Test",
 	}[op]
 }
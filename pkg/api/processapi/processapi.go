@@ -73,6 +73,7 @@ type MsgExecveEvent struct {
 	ParentFlags  uint64
 	Capabilities MsgCapabilities
 	Namespaces   MsgNamespaces
+	Exe          MsgExe
 }
 
 type MsgExecveEventUnix struct {
@@ -82,6 +83,7 @@ type MsgExecveEventUnix struct {
 	ParentFlags  uint64
 	Capabilities MsgCapabilities
 	Namespaces   MsgNamespaces
+	Exe          MsgExe
 	Process      MsgProcess
 }
 
@@ -100,6 +102,14 @@ type MsgCapabilities struct {
 	Inheritable uint64
 }
 
+// MsgExe identifies the executed file. Dev is the kernel (not the stat(2))
+// encoding of the device number.
+type MsgExe struct {
+	Ino uint64
+	Dev uint32
+	Pad uint32
+}
+
 type MsgNamespaces struct {
 	UtsInum       uint32
 	IpcInum       uint32
@@ -136,3 +146,96 @@ type MsgExitEvent struct {
 	ProcessKey MsgExecveKey `align:"current"`
 	Info       MsgExitInfo  `align:"info"`
 }
+
+type MsgCredIds struct {
+	Uid        uint32
+	Gid        uint32
+	Suid       uint32
+	Sgid       uint32
+	Euid       uint32
+	Egid       uint32
+	Fsuid      uint32
+	Fsgid      uint32
+	Securebits uint32
+	Pad        uint32
+}
+
+type MsgCredChangeEvent struct {
+	Common     MsgCommon       `align:"common"`
+	ProcessKey MsgExecveKey    `align:"current"`
+	OldIds     MsgCredIds      `align:"old_ids"`
+	NewIds     MsgCredIds      `align:"new_ids"`
+	OldCaps    MsgCapabilities `align:"old_caps"`
+	NewCaps    MsgCapabilities `align:"new_caps"`
+	Syscall    int64           `align:"syscall"`
+}
+
+type MsgNsChangeEvent struct {
+	Common     MsgCommon     `align:"common"`
+	ProcessKey MsgExecveKey  `align:"current"`
+	OldNs      MsgNamespaces `align:"old_ns"`
+	NewNs      MsgNamespaces `align:"new_ns"`
+	ChildPid   uint32        `align:"child_pid"`
+	Pad        uint32        `align:"pad"`
+	Syscall    int64         `align:"syscall"`
+}
+
+const (
+	KmodNameLen = 56
+	BpfTagLen   = 8
+	BpfNameLen  = 16
+)
+
+type MsgKmodLoadEvent struct {
+	Common     MsgCommon         `align:"common"`
+	ProcessKey MsgExecveKey      `align:"current"`
+	Name       [KmodNameLen]byte `align:"name"`
+	Taints     uint64            `align:"taints"`
+	SigOk      uint8             `align:"sig_ok"`
+	SigKnown   uint8             `align:"sig_known"`
+	Pad        [6]uint8          `align:"pad"`
+	Syscall    int64             `align:"syscall"`
+}
+
+type MsgBpfProgLoadEvent struct {
+	Common     MsgCommon        `align:"common"`
+	ProcessKey MsgExecveKey     `align:"current"`
+	ProgType   uint32           `align:"prog_type"`
+	AttachType uint32           `align:"attach_type"`
+	InsnCnt    uint32           `align:"insn_cnt"`
+	Id         uint32           `align:"id"`
+	Tag        [BpfTagLen]byte  `align:"tag"`
+	Name       [BpfNameLen]byte `align:"name"`
+}
+
+type MsgBpfMapCreateEvent struct {
+	Common     MsgCommon        `align:"common"`
+	ProcessKey MsgExecveKey     `align:"current"`
+	MapType    uint32           `align:"map_type"`
+	KeySize    uint32           `align:"key_size"`
+	ValueSize  uint32           `align:"value_size"`
+	MaxEntries uint32           `align:"max_entries"`
+	MapFlags   uint32           `align:"map_flags"`
+	Pad        uint32           `align:"pad"`
+	Name       [BpfNameLen]byte `align:"name"`
+}
+
+const (
+	MountPathLen   = 256
+	MountFstypeLen = 32
+)
+
+type MsgMountEvent struct {
+	Common      MsgCommon            `align:"common"`
+	ProcessKey  MsgExecveKey         `align:"current"`
+	Op          uint32               `align:"op"`
+	TargetFlags uint32               `align:"target_flags"`
+	Flags       uint64               `align:"flags"`
+	MntNs       uint32               `align:"mnt_ns"`
+	SourceLen   uint32               `align:"source_len"`
+	TargetLen   uint32               `align:"target_len"`
+	Pad         uint32               `align:"pad"`
+	Source      [MountPathLen]byte   `align:"source"`
+	Target      [MountPathLen]byte   `align:"target"`
+	Fstype      [MountFstypeLen]byte `align:"fstype"`
+}
@@ -11,6 +11,12 @@ const (
 	ReturnArgIndex   = MaxArgsSupported - 1
 )
 
+const (
+	// MaxBTFArgDepth is the maximum number of steps of a BTF field path
+	// (see ConfigBTFArg), it must match MAX_BTF_ARG_DEPTH in bpf.
+	MaxBTFArgDepth = 10
+)
+
 const (
 	ActionPost       = 0
 	ActionFollowFd   = 1
@@ -28,6 +34,11 @@ type MsgGenericKprobe struct {
 	Id           uint64
 	ThreadId     uint64
 	ActionId     uint64
+	Selector     uint64
+	// Sizes in bytes of the stack traces copied after the args, 0 if
+	// they were not captured and negative on errors.
+	KernelStackSize int64
+	UserStackSize   int64
 }
 
 type MsgGenericKprobeArgPath struct {
@@ -98,6 +109,19 @@ func (m MsgGenericKprobeArgInt) IsReturnArg() bool {
 	return m.Index == ReturnArgIndex
 }
 
+type MsgGenericKprobeArgLong struct {
+	Index uint64
+	Value int64
+}
+
+func (m MsgGenericKprobeArgLong) GetIndex() uint64 {
+	return m.Index
+}
+
+func (m MsgGenericKprobeArgLong) IsReturnArg() bool {
+	return m.Index == ReturnArgIndex
+}
+
 type MsgGenericKprobeArgSize struct {
 	Index uint64
 	Value uint64
@@ -272,12 +296,23 @@ type KprobeArgs struct {
 }
 
 type EventConfig struct {
-	FuncId        uint32    `align:"func_id"`
-	Arg           [5]int32  `align:"arg0"`
-	ArgM          [5]uint32 `align:"arg0m"`
-	ArgTpCtxOff   [5]uint32 `align:"t_arg0_ctx_off"`
-	Sigkill       uint32    `align:"sigkill"`
-	Syscall       uint32    `align:"syscall"`
-	ArgReturnCopy int32     `align:"argreturncopy"`
-	ArgReturn     int32     `align:"argreturn"`
+	FuncId        uint32                          `align:"func_id"`
+	Arg           [5]int32                        `align:"arg0"`
+	ArgM          [5]uint32                       `align:"arg0m"`
+	ArgTpCtxOff   [5]uint32                       `align:"t_arg0_ctx_off"`
+	Sigkill       uint32                          `align:"sigkill"`
+	Syscall       uint32                          `align:"syscall"`
+	ArgReturnCopy int32                           `align:"argreturncopy"`
+	ArgReturn     int32                           `align:"argreturn"`
+	Stack         uint32                          `align:"stack"`
+	BTFArg        [5][MaxBTFArgDepth]ConfigBTFArg `align:"btf_arg"`
+}
+
+// ConfigBTFArg is one step of a BTF field path: the kernel adds Offset to
+// the current address and, if Size is not zero, reads Size bytes from it.
+type ConfigBTFArg struct {
+	Offset        uint32 `align:"offset"`
+	Size          uint16 `align:"size"`
+	IsSigned      uint8  `align:"is_signed"`
+	IsInitialized uint8  `align:"is_initialized"`
 }
@@ -15,4 +15,8 @@ type MsgGenericTracepoint struct {
 	Id           int64
 	ThreadId     uint64
 	ActionId     uint64
+	Selector     uint64
+	// Unused, tracepoints do not capture stack traces.
+	KernelStackSize int64
+	UserStackSize   int64
 }
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package binaryinfo
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"golang.org/x/sys/unix"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// memfdPrefix is how the kernel names the target of /proc/<pid>/exe for
+// binaries executed from a memfd_create(2) file descriptor.
+const memfdPrefix = "/memfd:"
+
+// ErrExeMismatch is returned by Open when <procfs>/<pid>/exe is not the file
+// reported by the kernel, for example because the pid was reused.
+var ErrExeMismatch = errors.New("executable does not match the exec event")
+
+// KernelDev converts a device number from its kernel encoding (MKDEV, as
+// in super_block->s_dev) to the encoding used by stat(2).
+func KernelDev(dev uint32) uint64 {
+	return unix.Mkdev(dev>>20, dev&0xfffff)
+}
+
+// StatDev converts a device number from its stat(2) encoding to the kernel
+// encoding.
+func StatDev(dev uint64) uint32 {
+	return unix.Major(dev)<<20 | unix.Minor(dev)&0xfffff
+}
+
+// Key identifies a specific version of a file. A binary that is modified in
+// place changes its mtime and (usually) its size, so a hash cached under a
+// Key is never reused for different contents.
+type Key struct {
+	Dev   uint64
+	Inode uint64
+	Mtime int64
+	Size  int64
+}
+
+// Open opens the binary executed by pid through <procfs>/<pid>/exe and
+// returns its properties. Going through procfs instead of the binary path
+// works for binaries that were deleted after exec and for memfd based
+// fileless execution, and also avoids resolving paths inside containers.
+//
+// ino and dev (in kernel encoding) identify the file reported by the exec
+// event. If the opened file is a different one, Open fails with
+// ErrExeMismatch instead of describing whatever the pid points to now.
+//
+// The returned file can be passed to Hasher.Submit and must otherwise be
+// closed by the caller.
+func Open(procFS string, pid uint32, ino uint64, dev uint32) (*os.File, *tetragon.BinaryProperties, Key, error) {
+	exe := filepath.Join(procFS, strconv.FormatUint(uint64(pid), 10), "exe")
+	f, err := os.Open(exe)
+	if err != nil {
+		return nil, nil, Key{}, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, Key{}, err
+	}
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		f.Close()
+		return nil, nil, Key{}, fmt.Errorf("unexpected stat type for %s", exe)
+	}
+	if ino == 0 || st.Ino != ino || uint64(st.Dev) != KernelDev(dev) {
+		f.Close()
+		return nil, nil, Key{}, ErrExeMismatch
+	}
+
+	// The link target is only used to detect memfds, so an error here is
+	// not fatal.
+	target, _ := os.Readlink(exe)
+	memfd := strings.HasPrefix(target, memfdPrefix)
+
+	props := &tetragon.BinaryProperties{
+		Inode:   st.Ino,
+		Dev:     uint64(st.Dev),
+		Uid:     &wrapperspb.UInt32Value{Value: st.Uid},
+		Gid:     &wrapperspb.UInt32Value{Value: st.Gid},
+		Mode:    st.Mode,
+		Size:    uint64(st.Size),
+		Setuid:  st.Mode&syscall.S_ISUID != 0,
+		Setgid:  st.Mode&syscall.S_ISGID != 0,
+		Deleted: st.Nlink == 0 && !memfd,
+		Memfd:   memfd,
+	}
+	key := Key{
+		Dev:   uint64(st.Dev),
+		Inode: st.Ino,
+		Mtime: st.Mtim.Nano(),
+		Size:  st.Size,
+	}
+	return f, props, key, nil
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package binaryinfo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen(t *testing.T) {
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	fi, err := os.Stat(exe)
+	require.NoError(t, err)
+	st := fi.Sys().(*syscall.Stat_t)
+
+	_, _, _, err = Open("/proc", uint32(os.Getpid()), st.Ino+1, StatDev(uint64(st.Dev)))
+	assert.ErrorIs(t, err, ErrExeMismatch)
+	_, _, _, err = Open("/proc", uint32(os.Getpid()), 0, 0)
+	assert.ErrorIs(t, err, ErrExeMismatch)
+
+	f, props, key, err := Open("/proc", uint32(os.Getpid()), st.Ino, StatDev(uint64(st.Dev)))
+	require.NoError(t, err)
+	defer f.Close()
+
+	assert.Equal(t, uint64(fi.Size()), props.Size)
+	assert.Equal(t, fi.Size(), key.Size)
+	assert.Equal(t, props.Inode, key.Inode)
+	assert.Equal(t, uint32(os.Geteuid()), props.Uid.Value)
+	assert.False(t, props.Setuid)
+	assert.False(t, props.Deleted)
+	assert.False(t, props.Memfd)
+	assert.Empty(t, props.Sha256)
+}
+
+func TestHasher(t *testing.T) {
+	data := []byte("#!/bin/sh\necho hello\n")
+	sum := sha256.Sum256(data)
+	expected := hex.EncodeToString(sum[:])
+
+	path := filepath.Join(t.TempDir(), "bin")
+	require.NoError(t, os.WriteFile(path, data, 0755))
+
+	h, err := NewHasher(1, 16)
+	require.NoError(t, err)
+	defer h.Stop()
+
+	key := Key{Dev: 1, Inode: 2, Mtime: 3, Size: int64(len(data))}
+	_, ok := h.Lookup(key)
+	assert.False(t, ok)
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	done := make(chan string, 1)
+	ok = h.Submit(f, key, func(s string, err error) {
+		assert.NoError(t, err)
+		done <- s
+	})
+	require.True(t, ok)
+	assert.Equal(t, expected, <-done)
+
+	cached, ok := h.Lookup(key)
+	assert.True(t, ok)
+	assert.Equal(t, expected, cached)
+
+	// A different mtime must not hit the cache.
+	key.Mtime++
+	_, ok = h.Lookup(key)
+	assert.False(t, ok)
+}
+
+func TestHasherQueueFull(t *testing.T) {
+	// Without workers the queue has no room, so submissions are rejected
+	// instead of blocking.
+	h, err := NewHasher(0, 16)
+	require.NoError(t, err)
+	defer h.Stop()
+
+	f, err := os.Open("/proc/self/exe")
+	require.NoError(t, err)
+	defer f.Close()
+	assert.False(t, h.Submit(f, Key{}, func(string, error) {}))
+}
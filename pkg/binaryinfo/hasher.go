@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package binaryinfo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// queueLenPerWorker is the number of pending hash requests allowed per
+// worker before Submit starts rejecting new ones. Every pending request
+// holds an open file, so this also bounds the number of extra fds.
+const queueLenPerWorker = 64
+
+// DoneFunc is called from a worker goroutine once a hash request completes.
+type DoneFunc func(sum string, err error)
+
+type request struct {
+	file *os.File
+	key  Key
+	done DoneFunc
+}
+
+// Hasher computes SHA-256 digests of binaries on a bounded pool of workers
+// and caches the results by Key.
+type Hasher struct {
+	cache *lru.Cache
+	queue chan request
+	wg    sync.WaitGroup
+}
+
+// NewHasher starts a Hasher with the given number of workers and a cache of
+// cacheSize digests.
+func NewHasher(workers, cacheSize int) (*Hasher, error) {
+	cache, err := lru.New(cacheSize)
+	if err != nil {
+		return nil, err
+	}
+	h := &Hasher{
+		cache: cache,
+		queue: make(chan request, workers*queueLenPerWorker),
+	}
+	for i := 0; i < workers; i++ {
+		h.wg.Add(1)
+		go h.worker()
+	}
+	return h, nil
+}
+
+// Lookup returns the cached digest for key, if any.
+func (h *Hasher) Lookup(key Key) (string, bool) {
+	sum, ok := h.cache.Get(key)
+	if !ok {
+		return "", false
+	}
+	return sum.(string), true
+}
+
+// Submit queues file for hashing. It never blocks: if the queue is full the
+// request is rejected and false is returned, in which case the caller still
+// owns file. Otherwise the file is closed once hashed and done is called
+// with the result.
+func (h *Hasher) Submit(file *os.File, key Key, done DoneFunc) bool {
+	select {
+	case h.queue <- request{file: file, key: key, done: done}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Stop waits for pending requests to complete and terminates the workers.
+// Submit must not be called after Stop.
+func (h *Hasher) Stop() {
+	close(h.queue)
+	h.wg.Wait()
+}
+
+func (h *Hasher) worker() {
+	defer h.wg.Done()
+	for req := range h.queue {
+		sum, err := h.hash(req)
+		req.done(sum, err)
+	}
+}
+
+func (h *Hasher) hash(req request) (string, error) {
+	defer req.file.Close()
+
+	// Another request for the same file may have completed while this one
+	// was queued.
+	if sum, ok := h.Lookup(req.key); ok {
+		return sum, nil
+	}
+	sha := sha256.New()
+	if _, err := io.Copy(sha, req.file); err != nil {
+		return "", err
+	}
+	sum := hex.EncodeToString(sha.Sum(nil))
+	h.cache.Add(req.key, sum)
+	return sum, nil
+}
@@ -7,6 +7,8 @@
 package bpf
 
 import (
+	"os"
+
 	"github.com/cilium/ebpf"
 	"github.com/cilium/ebpf/asm"
 )
@@ -18,6 +20,7 @@ type Feature struct {
 
 var (
 	overrideHelper = Feature{false, false}
+	ringBuf        = Feature{false, false}
 )
 
 func HasOverrideHelper() bool {
@@ -44,3 +47,22 @@ func HasOverrideHelper() bool {
 	overrideHelper.detected = true
 	return overrideHelper.detected
 }
+
+// HasRingBuf returns true if the kernel supports BPF_MAP_TYPE_RINGBUF.
+func HasRingBuf() bool {
+	if ringBuf.initialized {
+		return ringBuf.detected
+	}
+	ringBuf.initialized = true
+	m, err := ebpf.NewMap(&ebpf.MapSpec{
+		Type:       ebpf.RingBuf,
+		MaxEntries: uint32(os.Getpagesize()),
+	})
+	if err != nil {
+		ringBuf.detected = false
+		return false
+	}
+	m.Close()
+	ringBuf.detected = true
+	return ringBuf.detected
+}
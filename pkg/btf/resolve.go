@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package btf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cilium/ebpf/btf"
+	api "github.com/cilium/tetragon/pkg/api/tracingapi"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+// structArgTypes maps the kernel structs that have a dedicated generic type
+// to the name of that type.
+var structArgTypes = map[string]string{
+	"file":       "file",
+	"path":       "path",
+	"cred":       "cred",
+	"sock":       "sock",
+	"sk_buff":    "skb",
+	"filename":   "filename",
+	"perf_event": "perf_event",
+	"bpf_attr":   "bpf_attr",
+}
+
+// ResolveBTFArg resolves the BTF field path (e.g. "f_inode.i_mode") of a
+// kprobe argument against the prototype of the hooked function. It returns
+// the steps the kernel needs to follow to read the field, and the type of
+// the resolved argument.
+func ResolveBTFArg(bspec *btf.Spec, kspec *v1alpha1.KProbeSpec, arg *v1alpha1.KProbeArg) ([api.MaxBTFArgDepth]api.ConfigBTFArg, string, error) {
+	var steps [api.MaxBTFArgDepth]api.ConfigBTFArg
+	var fn *btf.Func
+
+	if kspec.Syscall {
+		return steps, "", fmt.Errorf("resolve is not supported for syscall arguments")
+	}
+
+	if err := bspec.TypeByName(kspec.Call, &fn); err != nil {
+		return steps, "", fmt.Errorf("call %s not found", kspec.Call)
+	}
+	proto, ok := fn.Type.(*btf.FuncProto)
+	if !ok {
+		return steps, "", fmt.Errorf("proto for call %s not found", kspec.Call)
+	}
+	if arg.Index >= uint32(len(proto.Params)) {
+		return steps, "", fmt.Errorf("arg index %d out of bounds for prototype: %s", arg.Index, proto)
+	}
+
+	path, ty, err := resolveBTFPath(bspec, proto.Params[arg.Index].Type, arg.Resolve)
+	if err != nil {
+		return steps, "", fmt.Errorf("failed to resolve %q of arg %d: %w", arg.Resolve, arg.Index, err)
+	}
+	copy(steps[:], path)
+
+	if arg.Type != "" && arg.Type != ty {
+		return steps, "", fmt.Errorf("resolved field %q has type %s, which does not match spec type %s", arg.Resolve, ty, arg.Type)
+	}
+	return steps, ty, nil
+}
+
+// resolveBTFPath walks the dot-separated field path starting from the
+// argument type ty, which must be a pointer to a struct or union.
+func resolveBTFPath(bspec *btf.Spec, ty btf.Type, fieldPath string) ([]api.ConfigBTFArg, string, error) {
+	fields := strings.Split(fieldPath, ".")
+	if len(fields) > api.MaxBTFArgDepth {
+		return nil, "", fmt.Errorf("path has more than %d fields", api.MaxBTFArgDepth)
+	}
+
+	ptr, ok := btf.UnderlyingType(ty).(*btf.Pointer)
+	if !ok {
+		return nil, "", fmt.Errorf("argument is not a pointer")
+	}
+	ty = ptr.Target
+
+	steps := make([]api.ConfigBTFArg, 0, len(fields))
+	for i, field := range fields {
+		comp, err := compositeType(bspec, ty)
+		if err != nil {
+			return nil, "", fmt.Errorf("cannot access %q: %w", field, err)
+		}
+		member, off, ok := findMember(comp, field)
+		if !ok {
+			return nil, "", fmt.Errorf("%s has no member %q", comp.TypeName(), field)
+		}
+		if member.BitfieldSize != 0 {
+			return nil, "", fmt.Errorf("member %q is a bitfield", field)
+		}
+
+		step := api.ConfigBTFArg{Offset: off.Bytes(), IsInitialized: 1}
+		mty := btf.UnderlyingType(member.Type)
+
+		if i == len(fields)-1 {
+			argTy, size, signed, err := fieldArgType(mty)
+			if err != nil {
+				return nil, "", fmt.Errorf("member %q: %w", field, err)
+			}
+			step.Size = size
+			if signed {
+				step.IsSigned = 1
+			}
+			return append(steps, step), argTy, nil
+		}
+
+		switch t := mty.(type) {
+		case *btf.Pointer:
+			step.Size = 8
+			ty = t.Target
+		case *btf.Struct, *btf.Union:
+			ty = t
+		default:
+			return nil, "", fmt.Errorf("member %q is not a struct, a union or a pointer to one", field)
+		}
+		steps = append(steps, step)
+	}
+	return nil, "", fmt.Errorf("empty path")
+}
+
+// compositeType returns the struct or union behind ty, looking up forward
+// declarations by name.
+func compositeType(bspec *btf.Spec, ty btf.Type) (btf.Type, error) {
+	switch t := btf.UnderlyingType(ty).(type) {
+	case *btf.Struct, *btf.Union:
+		return t, nil
+	case *btf.Fwd:
+		if bspec == nil {
+			break
+		}
+		if t.Kind == btf.FwdUnion {
+			var u *btf.Union
+			if err := bspec.TypeByName(t.Name, &u); err == nil {
+				return u, nil
+			}
+		} else {
+			var s *btf.Struct
+			if err := bspec.TypeByName(t.Name, &s); err == nil {
+				return s, nil
+			}
+		}
+		return nil, fmt.Errorf("no definition for %s", t.Name)
+	}
+	return nil, fmt.Errorf("type %s is not a struct or a union", ty)
+}
+
+// findMember looks for a member by name, including the members of
+// anonymous structs and unions. It returns the offset of the member from
+// the start of comp.
+func findMember(comp btf.Type, name string) (btf.Member, btf.Bits, bool) {
+	var members []btf.Member
+
+	switch t := comp.(type) {
+	case *btf.Struct:
+		members = t.Members
+	case *btf.Union:
+		members = t.Members
+	}
+
+	for _, m := range members {
+		if m.Name == name {
+			return m, m.Offset, true
+		}
+		if m.Name != "" {
+			continue
+		}
+		switch t := btf.UnderlyingType(m.Type).(type) {
+		case *btf.Struct, *btf.Union:
+			if found, off, ok := findMember(t, name); ok {
+				return found, m.Offset + off, true
+			}
+		}
+	}
+	return btf.Member{}, 0, false
+}
+
+// fieldArgType returns the argument type of the last field of a path,
+// along with the number of bytes to read from it (0 to pass its address)
+// and whether the value needs to be sign extended.
+func fieldArgType(ty btf.Type) (string, uint16, bool, error) {
+	switch t := ty.(type) {
+	case *btf.Int:
+		signed := t.Encoding.IsSigned()
+		switch t.Size {
+		case 1, 2, 4:
+			if signed {
+				return "int", uint16(t.Size), true, nil
+			}
+			return "uint32", uint16(t.Size), false, nil
+		case 8:
+			if signed {
+				return "int64", 8, false, nil
+			}
+			return "uint64", 8, false, nil
+		}
+		return "", 0, false, fmt.Errorf("unsupported integer size %d", t.Size)
+	case *btf.Enum:
+		return "int", 4, true, nil
+	case *btf.Pointer:
+		target := btf.UnderlyingType(t.Target)
+		if isChar(target) {
+			return "string", 8, false, nil
+		}
+		if argTy, ok := structArgTypes[target.TypeName()]; ok {
+			return argTy, 8, false, nil
+		}
+		return "", 0, false, fmt.Errorf("unsupported pointer type %s", t)
+	case *btf.Array:
+		if isChar(btf.UnderlyingType(t.Type)) {
+			return "string", 0, false, nil
+		}
+		return "", 0, false, fmt.Errorf("unsupported array type %s", t)
+	case *btf.Struct, *btf.Union:
+		// embedded structs are passed by address, e.g. file->f_path
+		if argTy, ok := structArgTypes[t.TypeName()]; ok {
+			return argTy, 0, false, nil
+		}
+	}
+	return "", 0, false, fmt.Errorf("unsupported type %s", ty)
+}
+
+func isChar(ty btf.Type) bool {
+	i, ok := ty.(*btf.Int)
+	return ok && i.Size == 1 && (i.Encoding.IsChar() || strings.HasSuffix(i.Name, "char"))
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package btf
+
+import (
+	"testing"
+
+	"github.com/cilium/ebpf/btf"
+	api "github.com/cilium/tetragon/pkg/api/tracingapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveBTFPath(t *testing.T) {
+	char := &btf.Int{Name: "char", Size: 1, Encoding: btf.Char}
+	short := &btf.Int{Name: "short int", Size: 2, Encoding: btf.Signed}
+	umode := &btf.Typedef{Name: "umode_t", Type: &btf.Int{Name: "short unsigned int", Size: 2}}
+	ulong := &btf.Int{Name: "long unsigned int", Size: 8}
+
+	path := &btf.Struct{Name: "path", Size: 16}
+	inode := &btf.Struct{
+		Name: "inode",
+		Size: 64,
+		Members: []btf.Member{
+			{Name: "i_mode", Type: umode, Offset: 0},
+			{Name: "i_opflags", Type: short, Offset: 16},
+			{Name: "i_ino", Type: ulong, Offset: 64},
+			{Name: "i_flags", Type: ulong, Offset: 128, BitfieldSize: 3},
+		},
+	}
+	file := &btf.Struct{
+		Name: "file",
+		Size: 128,
+		Members: []btf.Member{
+			{Name: "", Type: &btf.Union{
+				Size: 16,
+				Members: []btf.Member{
+					{Name: "fu_llist", Type: ulong, Offset: 0},
+					{Name: "fu_name", Type: &btf.Pointer{Target: &btf.Const{Type: char}}, Offset: 0},
+				},
+			}, Offset: 0},
+			{Name: "f_path", Type: path, Offset: 128},
+			{Name: "f_inode", Type: &btf.Pointer{Target: inode}, Offset: 256},
+			{Name: "f_comm", Type: &btf.Array{Type: char, Nelems: 16}, Offset: 320},
+		},
+	}
+	arg := &btf.Pointer{Target: file}
+
+	steps, ty, err := resolveBTFPath(nil, arg, "f_inode.i_mode")
+	assert.NoError(t, err)
+	assert.Equal(t, "uint32", ty)
+	assert.Equal(t, []api.ConfigBTFArg{
+		{Offset: 32, Size: 8, IsInitialized: 1},
+		{Offset: 0, Size: 2, IsInitialized: 1},
+	}, steps)
+
+	steps, ty, err = resolveBTFPath(nil, arg, "f_inode.i_opflags")
+	assert.NoError(t, err)
+	assert.Equal(t, "int", ty)
+	assert.Equal(t, api.ConfigBTFArg{Offset: 2, Size: 2, IsSigned: 1, IsInitialized: 1}, steps[1])
+
+	steps, ty, err = resolveBTFPath(nil, arg, "f_inode.i_ino")
+	assert.NoError(t, err)
+	assert.Equal(t, "uint64", ty)
+	assert.Equal(t, api.ConfigBTFArg{Offset: 8, Size: 8, IsInitialized: 1}, steps[1])
+
+	// members of anonymous unions
+	steps, ty, err = resolveBTFPath(nil, arg, "fu_name")
+	assert.NoError(t, err)
+	assert.Equal(t, "string", ty)
+	assert.Equal(t, []api.ConfigBTFArg{{Offset: 0, Size: 8, IsInitialized: 1}}, steps)
+
+	// arrays and embedded structs are passed by address
+	steps, ty, err = resolveBTFPath(nil, arg, "f_comm")
+	assert.NoError(t, err)
+	assert.Equal(t, "string", ty)
+	assert.Equal(t, []api.ConfigBTFArg{{Offset: 40, Size: 0, IsInitialized: 1}}, steps)
+
+	steps, ty, err = resolveBTFPath(nil, arg, "f_path")
+	assert.NoError(t, err)
+	assert.Equal(t, "path", ty)
+	assert.Equal(t, []api.ConfigBTFArg{{Offset: 16, Size: 0, IsInitialized: 1}}, steps)
+
+	for _, p := range []string{
+		"f_inode.i_nosuchfield",
+		"f_inode.i_flags",         // bitfield
+		"f_inode",                 // unsupported pointer
+		"f_comm.foo",              // not a struct
+		"f_inode.i_mode.foo",      // not a struct
+		"a.b.c.d.e.f.g.h.i.j.k.l", // too deep
+	} {
+		_, _, err = resolveBTFPath(nil, arg, p)
+		assert.Error(t, err, p)
+	}
+
+	_, _, err = resolveBTFPath(nil, file, "f_inode.i_mode")
+	assert.Error(t, err, "argument is not a pointer")
+}
@@ -139,6 +139,13 @@ func ValidateKprobeSpec(bspec *btf.Spec, kspec *v1alpha1.KProbeSpec) error {
 		if specArg.Index >= fnNArgs {
 			return fmt.Errorf("kprobe arg %d has an invalid index: %d based on prototype: %s", i, specArg.Index, proto)
 		}
+		// arguments with a field path are typed by the resolved field
+		if specArg.Resolve != "" {
+			if _, _, err := ResolveBTFArg(bspec, kspec, specArg); err != nil {
+				return &ValidationFailed{s: fmt.Sprintf("kprobe arg %d: %s", i, err)}
+			}
+			continue
+		}
 		arg := proto.Params[int(specArg.Index)]
 		paramTyStr := getKernelType(arg.Type)
 		if !typesCompatible(specArg.Type, paramTyStr) {
@@ -238,6 +245,10 @@ func validateSycall(kspec *v1alpha1.KProbeSpec, name string) error {
 			return fmt.Errorf("kprobe arg %d has an invalid index: %d based on prototype: %s", i, specArg.Index, argsInfo.Proto(name))
 		}
 
+		if specArg.Resolve != "" {
+			return &ValidationFailed{s: fmt.Sprintf("kprobe arg %d: resolve is not supported for syscall arguments", i)}
+		}
+
 		argTy := argsInfo[specArg.Index].Type
 		if !typesCompatible(specArg.Type, argTy) {
 			return &ValidationWarn{s: fmt.Sprintf("type (%s) of syscall argument %d does not match spec type (%s)\n", argTy, specArg.Index, specArg.Type)}
@@ -21,11 +21,16 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/defaults"
 	"github.com/cilium/tetragon/pkg/logger"
 
 	"github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
@@ -41,6 +46,8 @@ type InitInfo struct {
 	BtfFname    string `json:"btf_fname"`
 	ServerAddr  string `json:"server_address"`
 	MetricsAddr string `json:"metrics_address"`
+	// ServerUnixSocket is the path of the gRPC unix socket, if any.
+	ServerUnixSocket string `json:"server_unix_socket,omitempty"`
 }
 
 // LoadInitInfo returns the InitInfo by reading the info file from its default location
@@ -100,6 +107,8 @@ type bugtoolInfo struct {
 	info      *InitInfo
 	prefixDir string
 	multiLog  MultiLog
+	// creds are the transport credentials of the gRPC TCP address.
+	creds credentials.TransportCredentials
 }
 
 func doTarAddBuff(tarWriter *tar.Writer, fname string, buff *bytes.Buffer) error {
@@ -161,17 +170,28 @@ func (s *bugtoolInfo) tarAddFile(tarWriter *tar.Writer, fnameSrc string, fnameDs
 	return nil
 }
 
-// Bugtool gathers information and writes it as a tar archive in the given filename
-func Bugtool(outFname string) error {
+const (
+	// maxCaptureSamples bounds the number of samples of captures.
+	maxCaptureSamples = 50000
+	// maxCaptureSize bounds the size of captures.
+	maxCaptureSize = 256 << 20
+)
+
+// Bugtool gathers information and writes it as a tar archive in the given
+// filename. If capture is not 0, it also includes the raw BPF samples
+// recorded by the agent for that long, to replay them with tetragon --replay.
+// The samples are read from the gRPC unix socket of the agent if it has one,
+// or from its TCP address using creds.
+func Bugtool(outFname string, capture time.Duration, creds credentials.TransportCredentials) error {
 	info, err := LoadInitInfo()
 	if err != nil {
 		return err
 	}
 
-	return doBugtool(info, outFname)
+	return doBugtool(info, outFname, capture, creds)
 }
 
-func doBugtool(info *InitInfo, outFname string) error {
+func doBugtool(info *InitInfo, outFname string, capture time.Duration, creds credentials.TransportCredentials) error {
 	// we log into two logs, one is the standard one and another one is a
 	// buffer that we are going to include as a file into the bugtool archive.
 	bugtoolLogger := logrus.New()
@@ -197,6 +217,7 @@ func doBugtool(info *InitInfo, outFname string) error {
 		info:      info,
 		prefixDir: prefixDir,
 		multiLog:  multiLog,
+		creds:     creds,
 	}
 
 	gzWriter := gzip.NewWriter(outFile)
@@ -216,6 +237,13 @@ func doBugtool(info *InitInfo, outFname string) error {
 	si.execCmd(tarWriter, "dmesg.out", "dmesg")
 	si.addTcInfo(tarWriter)
 	si.addBpftoolInfo(tarWriter)
+	if capture > 0 {
+		// the archive is still useful without the capture, so it
+		// is written before reporting the error
+		if err := si.addCapture(tarWriter, capture); err != nil {
+			return fmt.Errorf("failed to capture BPF samples: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -448,3 +476,42 @@ func (s *bugtoolInfo) addBpftoolInfo(tarWriter *tar.Writer) {
 	s.execCmd(tarWriter, "progs.dump", "bpftool", "prog", "show")
 	s.execCmd(tarWriter, "cgroups.dump", "bpftool", "cgroup", "tree")
 }
+
+// addCapture adds the raw BPF samples recorded by the agent for duration
+func (s *bugtoolInfo) addCapture(tarWriter *tar.Writer, duration time.Duration) error {
+	// The unix socket authenticates its peers by their credentials,
+	// TCP listeners might require TLS.
+	addr, creds := s.info.ServerAddr, s.creds
+	if s.info.ServerUnixSocket != "" {
+		addr, creds = "unix://"+s.info.ServerUnixSocket, insecure.NewCredentials()
+	}
+	if addr == "" {
+		s.multiLog.Warn("no gRPC server address in tetragon config, skipping capture")
+		return errors.New("no gRPC server address")
+	}
+	if creds == nil {
+		creds = insecure.NewCredentials()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), duration+30*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds), grpc.WithBlock())
+	if err != nil {
+		s.multiLog.WithField("serverAddr", addr).WithError(err).Warn("failed to connect to gRPC server")
+		return err
+	}
+	defer conn.Close()
+
+	s.multiLog.WithField("serverAddr", addr).WithField("duration", duration).Info("capturing BPF samples")
+	client := tetragon.NewFineGuidanceSensorsClient(conn)
+	res, err := client.RecordSamples(ctx, &tetragon.RecordSamplesRequest{
+		Duration:   durationpb.New(duration),
+		MaxSamples: maxCaptureSamples,
+	}, grpc.MaxCallRecvMsgSize(maxCaptureSize))
+	if err != nil {
+		s.multiLog.WithError(err).Warn("failed to capture BPF samples")
+		return err
+	}
+	s.multiLog.WithField("samples", res.Samples).Info("captured BPF samples")
+	return s.tarAddBuff(tarWriter, "samples.capture", bytes.NewBuffer(res.Data))
+}
@@ -4,22 +4,37 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"sync"
 	"unsafe"
 
 	"github.com/cilium/tetragon/pkg/api/dataapi"
 	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/processapi"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/observer"
 )
 
 func init() {
 	observer.RegisterEventHandlerAtInit(ops.MSG_OP_DATA, HandleData)
+	observer.RegisterShardKeyAtInit(ops.MSG_OP_DATA, shardKey)
 }
 
 var (
-	dataMap map[dataapi.DataEventId][]byte = make(map[dataapi.DataEventId][]byte)
+	// dataMap is shared by the decoding workers of the observer.
+	dataMapLock sync.Mutex
+	dataMap     map[dataapi.DataEventId][]byte = make(map[dataapi.DataEventId][]byte)
 )
 
+// shardKey returns the tgid of the pid_tgid of data messages, for them to be
+// decoded by the worker of the event they belong to.
+func shardKey(b []byte) uint32 {
+	off := binary.Size(processapi.MsgCommon{})
+	if len(b) < off+8 {
+		return 0
+	}
+	return uint32(binary.LittleEndian.Uint64(b[off:]) >> 32)
+}
+
 func add(r *bytes.Reader, m *dataapi.MsgData) error {
 	size := m.Common.Size - uint32(unsafe.Sizeof(*m))
 	msgData := make([]byte, size)
@@ -30,6 +45,8 @@ func add(r *bytes.Reader, m *dataapi.MsgData) error {
 		return err
 	}
 
+	dataMapLock.Lock()
+	defer dataMapLock.Unlock()
 	data := dataMap[m.Id]
 	if data == nil {
 		dataMap[m.Id] = msgData
@@ -43,6 +60,8 @@ func add(r *bytes.Reader, m *dataapi.MsgData) error {
 }
 
 func Get(id dataapi.DataEventId) ([]byte, error) {
+	dataMapLock.Lock()
+	defer dataMapLock.Unlock()
 	data := dataMap[id]
 	if data == nil {
 		return nil, fmt.Errorf("failed to find data for id: %v", id)
@@ -24,6 +24,10 @@ const (
 	// DefaultEventMap is the default name of the Event map
 	DefaultEventMap = "tcpmon"
 
+	// DefaultBPFRingBufSize is the default size of the BPF ring buffer
+	// events are sent through
+	DefaultBPFRingBufSize = 8 * 1024 * 1024
+
 	// DefaultMapRootFallback is the path which is used when /sys/fs/bpf has
 	// a mount, but with the other filesystem than BPFFS.
 	DefaultMapRootFallback = "/run/cilium/bpffs"
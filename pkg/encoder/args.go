@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+// SockString formats a socket as "tcp SADDR:SPORT -> DADDR:DPORT".
+func SockString(sa *tetragon.KprobeSock) string {
+	return fmt.Sprintf("tcp %s:%d -> %s:%d", sa.GetSaddr(), sa.GetSport(), sa.GetDaddr(), sa.GetDport())
+}
+
+// SkbString formats a socket buffer as "SADDR:SPORT -> DADDR:DPORT".
+func SkbString(skb *tetragon.KprobeSkb) string {
+	return fmt.Sprintf("%s:%d -> %s:%d", skb.GetSaddr(), skb.GetSport(), skb.GetDaddr(), skb.GetDport())
+}
+
+// CapsString formats capabilities as a comma separated list of names.
+func CapsString(caps []tetragon.CapabilitiesType) string {
+	names := make([]string, 0, len(caps))
+	for _, c := range caps {
+		names = append(names, c.String())
+	}
+	return strings.Join(names, ",")
+}
+
+// bytesString formats bytes as a quoted string if they are printable, and in
+// hexadecimal otherwise.
+func bytesString(b []byte) string {
+	s := string(b)
+	if strconv.CanBackquote(s) {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprintf("0x%x", b)
+}
+
+// ArgString formats a kprobe or tracepoint argument. It is the rendering of
+// arguments shared by all the encoders.
+func ArgString(arg *tetragon.KprobeArgument) string {
+	switch arg.GetArg().(type) {
+	case *tetragon.KprobeArgument_StringArg:
+		return arg.GetStringArg()
+	case *tetragon.KprobeArgument_IntArg:
+		return strconv.FormatInt(int64(arg.GetIntArg()), 10)
+	case *tetragon.KprobeArgument_LongArg:
+		return strconv.FormatInt(arg.GetLongArg(), 10)
+	case *tetragon.KprobeArgument_SizeArg:
+		return strconv.FormatUint(arg.GetSizeArg(), 10)
+	case *tetragon.KprobeArgument_BytesArg:
+		return bytesString(arg.GetBytesArg())
+	case *tetragon.KprobeArgument_TruncatedBytesArg:
+		return fmt.Sprintf("%s...(%d bytes)", bytesString(arg.GetTruncatedBytesArg().GetBytesArg()), arg.GetTruncatedBytesArg().GetOrigSize())
+	case *tetragon.KprobeArgument_PathArg:
+		return arg.GetPathArg().GetPath()
+	case *tetragon.KprobeArgument_FileArg:
+		return arg.GetFileArg().GetPath()
+	case *tetragon.KprobeArgument_SockArg:
+		return SockString(arg.GetSockArg())
+	case *tetragon.KprobeArgument_SkbArg:
+		return SkbString(arg.GetSkbArg())
+	case *tetragon.KprobeArgument_CredArg:
+		return CapsString(arg.GetCredArg().GetEffective())
+	case *tetragon.KprobeArgument_BpfAttrArg:
+		return fmt.Sprintf("%s %s instruction count %d", arg.GetBpfAttrArg().GetProgType(), arg.GetBpfAttrArg().GetProgName(), arg.GetBpfAttrArg().GetInsnCnt())
+	case *tetragon.KprobeArgument_PerfEventArg:
+		return fmt.Sprintf("%s %s", arg.GetPerfEventArg().GetType(), arg.GetPerfEventArg().GetKprobeFunc())
+	}
+	return ""
+}
+
+// argsString formats arguments separated by spaces.
+func argsString(args []*tetragon.KprobeArgument) string {
+	strs := make([]string, 0, len(args))
+	for _, arg := range args {
+		strs = append(strs, ArgString(arg))
+	}
+	return strings.Join(strs, " ")
+}
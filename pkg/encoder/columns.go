@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/api/v1/tetragon/codegen/helpers"
+)
+
+// Column is a value extracted from events by the csv and table encoders.
+type Column struct {
+	Name        string
+	Description string
+	// Width is the minimal width of the column in tables.
+	Width int
+	Value func(*tetragon.GetEventsResponse) string
+}
+
+// DefaultColumns are the columns of the csv and table encoders when none is
+// specified.
+var DefaultColumns = []string{"time", "node", "type", "pod", "binary", "function", "args"}
+
+var columns = map[string]Column{}
+
+func addColumn(c Column) {
+	columns[c.Name] = c
+}
+
+func init() {
+	addColumn(Column{"time", "Event time in RFC 3339 format", 30, EventTime})
+	addColumn(Column{"node", "Node name", 12, func(res *tetragon.GetEventsResponse) string {
+		return res.NodeName
+	}})
+	addColumn(Column{"type", "Event type, e.g. process_exec", 18, EventType})
+	addColumn(Column{"namespace", "Kubernetes namespace of the process", 12, func(res *tetragon.GetEventsResponse) string {
+		return helpers.ResponseGetProcess(res).GetPod().GetNamespace()
+	}})
+	addColumn(Column{"pod", "NAMESPACE/NAME of the pod of the process", 30, EventPod})
+	addColumn(Column{"container", "Container name", 12, func(res *tetragon.GetEventsResponse) string {
+		return helpers.ResponseGetProcess(res).GetPod().GetContainer().GetName()
+	}})
+	addColumn(Column{"binary", "Binary of the process", 20, func(res *tetragon.GetEventsResponse) string {
+		return helpers.ResponseGetProcess(res).GetBinary()
+	}})
+	addColumn(Column{"arguments", "Arguments of the process", 20, func(res *tetragon.GetEventsResponse) string {
+		return helpers.ResponseGetProcess(res).GetArguments()
+	}})
+	addColumn(Column{"pid", "PID of the process", 8, func(res *tetragon.GetEventsResponse) string {
+		if pid := helpers.ResponseGetProcess(res).GetPid(); pid != nil {
+			return strconv.FormatUint(uint64(pid.Value), 10)
+		}
+		return ""
+	}})
+	addColumn(Column{"uid", "UID of the process", 6, func(res *tetragon.GetEventsResponse) string {
+		if uid := helpers.ResponseGetProcess(res).GetUid(); uid != nil {
+			return strconv.FormatUint(uint64(uid.Value), 10)
+		}
+		return ""
+	}})
+	addColumn(Column{"caps", "Effective capabilities of the process", 20, func(res *tetragon.GetEventsResponse) string {
+		return CapsString(helpers.ResponseGetProcess(res).GetCap().GetEffective())
+	}})
+	addColumn(Column{"parent_binary", "Binary of the parent process", 20, func(res *tetragon.GetEventsResponse) string {
+		return helpers.ResponseGetParent(res).GetBinary()
+	}})
+	addColumn(Column{"function", "Kprobe function name, or tracepoint SUBSYS/EVENT", 20, EventFunction})
+	addColumn(Column{"policy", "Tracing policy of kprobe and tracepoint events", 12, func(res *tetragon.GetEventsResponse) string {
+		switch ev := res.Event.(type) {
+		case *tetragon.GetEventsResponse_ProcessKprobe:
+			return ev.ProcessKprobe.PolicyName
+		case *tetragon.GetEventsResponse_ProcessTracepoint:
+			return ev.ProcessTracepoint.PolicyName
+		}
+		return ""
+	}})
+	addColumn(Column{"args", "Kprobe and tracepoint arguments separated by spaces", 30, func(res *tetragon.GetEventsResponse) string {
+		return strings.Join(EventArgs(res), " ")
+	}})
+}
+
+// GetColumn returns the column named name. Besides the columns listed by
+// Columns, argN returns the argument N of kprobe and tracepoint events.
+func GetColumn(name string) (Column, error) {
+	if c, ok := columns[name]; ok {
+		return c, nil
+	}
+	if strings.HasPrefix(name, "arg") {
+		if i, err := strconv.Atoi(strings.TrimPrefix(name, "arg")); err == nil && i >= 0 {
+			return Column{
+				Name:  name,
+				Width: 20,
+				Value: func(res *tetragon.GetEventsResponse) string {
+					return EventArg(i, res)
+				},
+			}, nil
+		}
+	}
+	return Column{}, fmt.Errorf("unknown column %q, expected one of %s or argN", name, strings.Join(columnNames(), ", "))
+}
+
+// GetColumns returns the columns named names, or the default columns if names
+// is empty.
+func GetColumns(names []string) ([]Column, error) {
+	if len(names) == 0 {
+		names = DefaultColumns
+	}
+	ret := make([]Column, 0, len(names))
+	for _, name := range names {
+		c, err := GetColumn(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, c)
+	}
+	return ret, nil
+}
+
+// Columns returns the named columns sorted by name.
+func Columns() []Column {
+	ret := make([]Column, 0, len(columns))
+	for _, name := range columnNames() {
+		ret = append(ret, columns[name])
+	}
+	return ret
+}
+
+func columnNames() []string {
+	names := make([]string, 0, len(columns))
+	for name := range columns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// EventTime returns the time of an event in RFC 3339 format.
+func EventTime(res *tetragon.GetEventsResponse) string {
+	if res.Time == nil {
+		return ""
+	}
+	return res.Time.AsTime().UTC().Format(rfc3339Nano)
+}
+
+// EventType returns the type of an event, e.g. process_exec.
+func EventType(res *tetragon.GetEventsResponse) string {
+	t, err := helpers.ResponseTypeString(res)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(t)
+}
+
+// EventPod returns the NAMESPACE/NAME of the pod of the process of an event.
+func EventPod(res *tetragon.GetEventsResponse) string {
+	pod := helpers.ResponseGetProcess(res).GetPod()
+	if pod == nil {
+		return ""
+	}
+	return pod.Namespace + "/" + pod.Name
+}
+
+// EventFunction returns the function name of kprobe events and the
+// SUBSYS/EVENT of tracepoint events.
+func EventFunction(res *tetragon.GetEventsResponse) string {
+	switch ev := res.Event.(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		return ev.ProcessKprobe.FunctionName
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		return ev.ProcessTracepoint.Subsys + "/" + ev.ProcessTracepoint.Event
+	}
+	return ""
+}
+
+func eventArgs(res *tetragon.GetEventsResponse) []*tetragon.KprobeArgument {
+	switch ev := res.Event.(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		return ev.ProcessKprobe.Args
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		return ev.ProcessTracepoint.Args
+	}
+	return nil
+}
+
+// EventArgs returns the arguments of kprobe and tracepoint events formatted
+// by ArgString.
+func EventArgs(res *tetragon.GetEventsResponse) []string {
+	args := eventArgs(res)
+	ret := make([]string, 0, len(args))
+	for _, arg := range args {
+		ret = append(ret, ArgString(arg))
+	}
+	return ret
+}
+
+// EventArg returns the argument i of kprobe and tracepoint events formatted
+// by ArgString, or an empty string if there is no such argument.
+func EventArg(i int, res *tetragon.GetEventsResponse) string {
+	args := eventArgs(res)
+	if i < 0 || i >= len(args) {
+		return ""
+	}
+	return ArgString(args[i])
+}
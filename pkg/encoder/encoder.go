@@ -7,10 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/syscallinfo"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const rfc3339Nano = "2006-01-02T15:04:05.000000000Z07:00"
@@ -40,6 +42,9 @@ type CompactEncoder struct {
 	Writer     io.Writer
 	Colorer    *Colorer
 	Timestamps bool
+	// NodeNames prefixes each event with the name of the node it comes
+	// from, for streams merged from several nodes.
+	NodeNames bool
 }
 
 // NewCompactEncoder initializes and returns a pointer to CompactEncoder.
@@ -62,6 +67,9 @@ func (p *CompactEncoder) Encode(v interface{}) error {
 	if err != nil {
 		return err
 	}
+	if p.NodeNames {
+		str = fmt.Sprintf("%s %s", p.Colorer.Yellow.Sprint(event.NodeName), str)
+	}
 	if p.Timestamps {
 		ts := event.Time.AsTime().UTC().Format(rfc3339Nano)
 		str = fmt.Sprintf("%s %s", ts, str)
@@ -113,6 +121,52 @@ func PrintNS(ns int32) string {
 }
 
 func (p *CompactEncoder) EventToString(response *tetragon.GetEventsResponse) (string, error) {
+	str, err := p.eventToString(response)
+	if err != nil {
+		return "", err
+	}
+	if policy := p.policyInfo(response); policy != "" {
+		str = fmt.Sprintf("%s %s", str, policy)
+	}
+	return str, nil
+}
+
+// policyInfo returns the tracing policy, selector, tags and message of kprobe
+// and tracepoint events, or an empty string for other events.
+func (p *CompactEncoder) policyInfo(response *tetragon.GetEventsResponse) string {
+	type policyEvent interface {
+		GetPolicyName() string
+		GetSelectorIndex() *wrapperspb.UInt32Value
+		GetTags() []string
+		GetMessage() string
+	}
+	var ev policyEvent
+	switch response.Event.(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		ev = response.GetProcessKprobe()
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		ev = response.GetProcessTracepoint()
+	default:
+		return ""
+	}
+
+	var info []string
+	if name := ev.GetPolicyName(); name != "" {
+		info = append(info, p.Colorer.Magenta.Sprintf("policy=%s", name))
+	}
+	if sel := ev.GetSelectorIndex(); sel != nil {
+		info = append(info, p.Colorer.Magenta.Sprintf("selector=%d", sel.Value))
+	}
+	if tags := ev.GetTags(); len(tags) > 0 {
+		info = append(info, p.Colorer.Yellow.Sprintf("tags=%s", strings.Join(tags, ",")))
+	}
+	if msg := ev.GetMessage(); msg != "" {
+		info = append(info, p.Colorer.Yellow.Sprintf("%q", msg))
+	}
+	return strings.Join(info, " ")
+}
+
+func (p *CompactEncoder) eventToString(response *tetragon.GetEventsResponse) (string, error) {
 	switch response.Event.(type) {
 	case *tetragon.GetEventsResponse_ProcessExec:
 		exec := response.GetProcessExec()
@@ -149,7 +203,7 @@ func (p *CompactEncoder) EventToString(response *tetragon.GetEventsResponse) (st
 			event := p.Colorer.Blue.Sprintf("📝 %-7s", "write")
 			file := ""
 			if len(kprobe.Args) > 0 && kprobe.Args[0] != nil && kprobe.Args[0].GetFileArg() != nil {
-				file = p.Colorer.Cyan.Sprint(kprobe.Args[0].GetFileArg().Path)
+				file = p.Colorer.Cyan.Sprint(ArgString(kprobe.Args[0]))
 			}
 			bytes := ""
 			if len(kprobe.Args) > 2 && kprobe.Args[2] != nil {
@@ -160,7 +214,7 @@ func (p *CompactEncoder) EventToString(response *tetragon.GetEventsResponse) (st
 			event := p.Colorer.Blue.Sprintf("📚 %-7s", "read")
 			file := ""
 			if len(kprobe.Args) > 0 && kprobe.Args[0] != nil && kprobe.Args[0].GetFileArg() != nil {
-				file = p.Colorer.Cyan.Sprint(kprobe.Args[0].GetFileArg().Path)
+				file = p.Colorer.Cyan.Sprint(ArgString(kprobe.Args[0]))
 			}
 			bytes := ""
 			if len(kprobe.Args) > 2 && kprobe.Args[2] != nil {
@@ -171,25 +225,25 @@ func (p *CompactEncoder) EventToString(response *tetragon.GetEventsResponse) (st
 			event := p.Colorer.Blue.Sprintf("📬 %-7s", "open")
 			file := ""
 			if len(kprobe.Args) > 1 && kprobe.Args[1] != nil && kprobe.Args[1].GetFileArg() != nil {
-				file = p.Colorer.Cyan.Sprint(kprobe.Args[1].GetFileArg().Path)
+				file = p.Colorer.Cyan.Sprint(ArgString(kprobe.Args[1]))
 			}
 			return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, file), caps), nil
 		case "__x64_sys_close":
 			event := p.Colorer.Blue.Sprintf("📪 %-7s", "close")
 			file := ""
 			if len(kprobe.Args) > 0 && kprobe.Args[0] != nil && kprobe.Args[0].GetFileArg() != nil {
-				file = p.Colorer.Cyan.Sprint(kprobe.Args[0].GetFileArg().Path)
+				file = p.Colorer.Cyan.Sprint(ArgString(kprobe.Args[0]))
 			}
 			return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, file), caps), nil
 		case "__x64_sys_mount":
 			event := p.Colorer.Blue.Sprintf("💾 %-7s", "mount")
 			src := ""
 			if len(kprobe.Args) > 0 && kprobe.Args[0] != nil {
-				src = p.Colorer.Cyan.Sprint(kprobe.Args[0].GetStringArg())
+				src = p.Colorer.Cyan.Sprint(ArgString(kprobe.Args[0]))
 			}
 			dst := ""
 			if len(kprobe.Args) > 1 && kprobe.Args[1] != nil {
-				dst = p.Colorer.Cyan.Sprint(kprobe.Args[1].GetStringArg())
+				dst = p.Colorer.Cyan.Sprint(ArgString(kprobe.Args[1]))
 			}
 			return CapTrailorPrinter(fmt.Sprintf("%s %s %s %s", event, processInfo, src, dst), caps), nil
 		case "__x64_sys_setuid":
@@ -207,11 +261,11 @@ func (p *CompactEncoder) EventToString(response *tetragon.GetEventsResponse) (st
 			event := p.Colorer.Blue.Sprintf("💾 %-7s", "pivot_root")
 			src := ""
 			if len(kprobe.Args) > 0 && kprobe.Args[0] != nil {
-				src = p.Colorer.Cyan.Sprint(kprobe.Args[0].GetStringArg())
+				src = p.Colorer.Cyan.Sprint(ArgString(kprobe.Args[0]))
 			}
 			dst := ""
 			if len(kprobe.Args) > 1 && kprobe.Args[1] != nil {
-				dst = p.Colorer.Cyan.Sprint(kprobe.Args[1].GetStringArg())
+				dst = p.Colorer.Cyan.Sprint(ArgString(kprobe.Args[1]))
 			}
 			return CapTrailorPrinter(fmt.Sprintf("%s %s %s %s", event, processInfo, src, dst), caps), nil
 		case "proc_exec_connector":
@@ -228,24 +282,21 @@ func (p *CompactEncoder) EventToString(response *tetragon.GetEventsResponse) (st
 			event := p.Colorer.Blue.Sprintf("🔌 %-7s", "connect")
 			sock := ""
 			if len(kprobe.Args) > 0 && kprobe.Args[0] != nil {
-				sa := kprobe.Args[0].GetSockArg()
-				sock = p.Colorer.Cyan.Sprintf("tcp %s:%d -> %s:%d", sa.Saddr, sa.Sport, sa.Daddr, sa.Dport)
+				sock = p.Colorer.Cyan.Sprint(SockString(kprobe.Args[0].GetSockArg()))
 			}
 			return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, sock), caps), nil
 		case "tcp_close":
 			event := p.Colorer.Blue.Sprintf("\U0001F9F9 %-7s", "close")
 			sock := ""
 			if len(kprobe.Args) > 0 && kprobe.Args[0] != nil {
-				sa := kprobe.Args[0].GetSockArg()
-				sock = p.Colorer.Cyan.Sprintf("tcp %s:%d -> %s:%d", sa.Saddr, sa.Sport, sa.Daddr, sa.Dport)
+				sock = p.Colorer.Cyan.Sprint(SockString(kprobe.Args[0].GetSockArg()))
 			}
 			return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, sock), caps), nil
 		case "tcp_sendmsg":
 			event := p.Colorer.Blue.Sprintf("📤 %-7s", "sendmsg")
 			args := ""
 			if len(kprobe.Args) > 0 && kprobe.Args[0] != nil {
-				sa := kprobe.Args[0].GetSockArg()
-				args = p.Colorer.Cyan.Sprintf("tcp %s:%d -> %s:%d", sa.Saddr, sa.Sport, sa.Daddr, sa.Dport)
+				args = p.Colorer.Cyan.Sprint(SockString(kprobe.Args[0].GetSockArg()))
 			}
 			bytes := int32(0)
 			if len(kprobe.Args) > 1 && kprobe.Args[1] != nil {
@@ -254,7 +305,11 @@ func (p *CompactEncoder) EventToString(response *tetragon.GetEventsResponse) (st
 			return CapTrailorPrinter(fmt.Sprintf("%s %s %s bytes %d", event, processInfo, args, bytes), caps), nil
 		default:
 			event := p.Colorer.Blue.Sprintf("⁉️ %-7s", "syscall")
-			return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, kprobe.FunctionName), caps), nil
+			attr := kprobe.FunctionName
+			if args := argsString(kprobe.Args); args != "" {
+				attr = fmt.Sprintf("%s %s", attr, p.Colorer.Cyan.Sprint(args))
+			}
+			return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, attr), caps), nil
 		}
 	case *tetragon.GetEventsResponse_ProcessTracepoint:
 		tp := response.GetProcessTracepoint()
@@ -269,14 +324,165 @@ func (p *CompactEncoder) EventToString(response *tetragon.GetEventsResponse) (st
 			return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, sysName), caps), nil
 		default:
 			event := p.Colorer.Blue.Sprintf("⁉️ %-7s", "tracepoint")
-			return CapTrailorPrinter(fmt.Sprintf("%s %s %s %s", event, processInfo, tp.Subsys, tp.Event), caps), nil
+			attr := fmt.Sprintf("%s %s", tp.Subsys, tp.Event)
+			if args := argsString(tp.Args); args != "" {
+				attr = fmt.Sprintf("%s %s", attr, p.Colorer.Cyan.Sprint(args))
+			}
+			return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, attr), caps), nil
+		}
+	case *tetragon.GetEventsResponse_ProcessCredentialsChange:
+		cc := response.GetProcessCredentialsChange()
+		if cc.Process == nil {
+			return "", ErrMissingProcessInfo
+		}
+		event := p.Colorer.Blue.Sprintf("🔑 %-7s", "creds")
+		processInfo, caps := p.Colorer.ProcessInfo(response.NodeName, cc.Process)
+		changes := p.Colorer.Cyan.Sprint(credentialsChanges(cc.OldCredentials, cc.NewCredentials))
+		if cc.Syscall != "" {
+			changes = fmt.Sprintf("%s %s", changes, p.Colorer.Red.Sprint(cc.Syscall))
+		}
+		return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, changes), caps), nil
+	case *tetragon.GetEventsResponse_ProcessNamespaceChange:
+		nc := response.GetProcessNamespaceChange()
+		if nc.Process == nil {
+			return "", ErrMissingProcessInfo
+		}
+		event := p.Colorer.Blue.Sprintf("📦 %-7s", "ns")
+		processInfo, caps := p.Colorer.ProcessInfo(response.NodeName, nc.Process)
+		changes := p.Colorer.Cyan.Sprint(namespacesChanges(nc.Flags, nc.NewNamespaces))
+		if nc.Syscall != "" {
+			changes = fmt.Sprintf("%s %s", changes, p.Colorer.Red.Sprint(nc.Syscall))
+		}
+		return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, changes), caps), nil
+	case *tetragon.GetEventsResponse_ProcessKernelModuleLoad:
+		kmod := response.GetProcessKernelModuleLoad()
+		if kmod.Process == nil {
+			return "", ErrMissingProcessInfo
 		}
+		event := p.Colorer.Blue.Sprintf("🧩 %-7s", "kmod")
+		processInfo, caps := p.Colorer.ProcessInfo(response.NodeName, kmod.Process)
+		module := p.Colorer.Cyan.Sprint(kmod.Module.GetName())
+		if kmod.Module.GetSignature() == tetragon.KernelModuleSignature_KERNEL_MODULE_SIGNATURE_UNVERIFIED {
+			module = fmt.Sprintf("%s %s", module, p.Colorer.Red.Sprint("unsigned"))
+		}
+		return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, module), caps), nil
+	case *tetragon.GetEventsResponse_ProcessBpfProgLoad:
+		prog := response.GetProcessBpfProgLoad()
+		if prog.Process == nil {
+			return "", ErrMissingProcessInfo
+		}
+		event := p.Colorer.Blue.Sprintf("🐝 %-7s", "bpf")
+		processInfo, caps := p.Colorer.ProcessInfo(response.NodeName, prog.Process)
+		attr := p.Colorer.Cyan.Sprintf("%s %s instruction count %d", prog.ProgType, prog.Name, prog.InsnCnt)
+		return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, attr), caps), nil
+	case *tetragon.GetEventsResponse_ProcessBpfMapCreate:
+		m := response.GetProcessBpfMapCreate()
+		if m.Process == nil {
+			return "", ErrMissingProcessInfo
+		}
+		event := p.Colorer.Blue.Sprintf("🗺  %-7s", "bpfmap")
+		processInfo, caps := p.Colorer.ProcessInfo(response.NodeName, m.Process)
+		attr := p.Colorer.Cyan.Sprintf("%s %s max entries %d", m.MapType, m.Name, m.MaxEntries)
+		return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, attr), caps), nil
+	case *tetragon.GetEventsResponse_ProcessMount:
+		m := response.GetProcessMount()
+		if m.Process == nil {
+			return "", ErrMissingProcessInfo
+		}
+		event := p.Colorer.Blue.Sprintf("💾 %-7s", "mount")
+		processInfo, caps := p.Colorer.ProcessInfo(response.NodeName, m.Process)
+		return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, p.Colorer.Cyan.Sprint(mountArgs(m))), caps), nil
+	case *tetragon.GetEventsResponse_EventsLost:
+		lost := response.GetEventsLost()
+		event := p.Colorer.Red.Sprintf("⚠️  %-7s", "lost")
+		return fmt.Sprintf("%s %s %d events lost (%s)", event, response.NodeName, lost.Count, lost.OverflowPolicy), nil
 	}
 
 	return "", ErrUnknownEventType
 }
 
+// mountArgs returns the operation of m followed by its source, target and
+// filesystem type, skipping the empty ones.
+func mountArgs(m *tetragon.ProcessMount) string {
+	args := []string{strings.ToLower(strings.TrimPrefix(m.Operation.String(), "MOUNT_OPERATION_"))}
+	for _, arg := range []string{m.Source, m.Target, m.Fstype} {
+		if arg != "" {
+			args = append(args, arg)
+		}
+	}
+	return strings.Join(args, " ")
+}
+
+// namespacesChanges returns the names of the namespaces set in flags,
+// followed by "(host)" if the process is now in the host namespace.
+func namespacesChanges(flags uint32, n *tetragon.Namespaces) string {
+	hosts := map[int]*tetragon.Namespace{
+		CLONE_NEWCGROUP: n.GetCgroup(),
+		CLONE_NEWIPC:    n.GetIpc(),
+		CLONE_NEWNET:    n.GetNet(),
+		CLONE_NEWNS:     n.GetMnt(),
+		CLONE_NEWPID:    n.GetPidForChildren(),
+		CLONE_NEWTIME:   n.GetTimeForChildren(),
+		CLONE_NEWUSER:   n.GetUser(),
+		CLONE_NEWUTS:    n.GetUts(),
+	}
+	flagsOrder := []int{CLONE_NEWNS, CLONE_NEWUTS, CLONE_NEWIPC, CLONE_NEWPID, CLONE_NEWNET, CLONE_NEWCGROUP, CLONE_NEWUSER, CLONE_NEWTIME}
+	var changes []string
+	for _, f := range flagsOrder {
+		if flags&uint32(f) == 0 {
+			continue
+		}
+		name := PrintNS(int32(f))
+		if hosts[f].GetIsHost() {
+			name += "(host)"
+		}
+		changes = append(changes, name)
+	}
+	return strings.Join(changes, " ")
+}
+
+// credentialsChanges returns a short description of the ids that differ
+// between o and n, or "caps" if only capabilities or securebits changed.
+func credentialsChanges(o, n *tetragon.ProcessCredentials) string {
+	if o == nil || n == nil {
+		return ""
+	}
+	ids := []struct {
+		name     string
+		old, new *wrapperspb.UInt32Value
+	}{
+		{"uid", o.Uid, n.Uid},
+		{"euid", o.Euid, n.Euid},
+		{"suid", o.Suid, n.Suid},
+		{"fsuid", o.Fsuid, n.Fsuid},
+		{"gid", o.Gid, n.Gid},
+		{"egid", o.Egid, n.Egid},
+		{"sgid", o.Sgid, n.Sgid},
+		{"fsgid", o.Fsgid, n.Fsgid},
+	}
+	var changes []string
+	for _, id := range ids {
+		if id.old.GetValue() != id.new.GetValue() {
+			changes = append(changes, fmt.Sprintf("%s=%d->%d", id.name, id.old.GetValue(), id.new.GetValue()))
+		}
+	}
+	if len(changes) == 0 {
+		return "caps"
+	}
+	return strings.Join(changes, " ")
+}
+
 func rawSyscallEnter(p *CompactEncoder, tp *tetragon.ProcessTracepoint) string {
+	// syscall audit mode: the syscall followed by its decoded arguments,
+	// labelled with their names
+	if len(tp.Args) > 0 && tp.Args[0].GetLabel() == "syscall" {
+		args := make([]string, 0, len(tp.Args)-1)
+		for _, arg := range tp.Args[1:] {
+			args = append(args, fmt.Sprintf("%s=%s", arg.GetLabel(), ArgString(arg)))
+		}
+		return fmt.Sprintf("%s(%s)", ArgString(tp.Args[0]), strings.Join(args, ", "))
+	}
+
 	sysID := int64(-1)
 	if len(tp.Args) > 0 && tp.Args[0] != nil {
 		if x, ok := tp.Args[0].GetArg().(*tetragon.KprobeArgument_LongArg); ok {
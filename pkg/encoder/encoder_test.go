@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/stretchr/testify/assert"
@@ -149,6 +150,77 @@ func TestCompactEncoder_KprobeEventToString(t *testing.T) {
 	assert.Equal(t, "⁉️ syscall kube-system/tetragon /usr/bin/curl unhandled_function", result)
 }
 
+func TestCompactEncoder_KprobePolicyEventToString(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never, false)
+
+	process := &tetragon.Process{
+		Binary: "/usr/bin/curl",
+		Pod: &tetragon.Pod{
+			Namespace: "kube-system",
+			Name:      "tetragon",
+		},
+	}
+
+	// kprobe with policy, selector, tags and message
+	result, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{
+			ProcessKprobe: &tetragon.ProcessKprobe{
+				Process:       process,
+				FunctionName:  "unhandled_function",
+				PolicyName:    "connect",
+				SelectorIndex: &wrapperspb.UInt32Value{Value: 1},
+				Tags:          []string{"network", "egress"},
+				Message:       "outbound connection",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `⁉️ syscall kube-system/tetragon /usr/bin/curl unhandled_function policy=connect selector=1 tags=network,egress "outbound connection"`, result)
+
+	// tracepoint with only a policy name
+	result, err = p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessTracepoint{
+			ProcessTracepoint: &tetragon.ProcessTracepoint{
+				Process:    process,
+				Subsys:     "syscalls",
+				Event:      "sys_enter_lseek",
+				PolicyName: "lseek",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "⁉️ tracepoint kube-system/tetragon /usr/bin/curl syscalls sys_enter_lseek policy=lseek", result)
+}
+
+func TestCompactEncoder_SyscallAuditEventToString(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never, false)
+
+	result, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessTracepoint{
+			ProcessTracepoint: &tetragon.ProcessTracepoint{
+				Process: &tetragon.Process{
+					Binary: "/usr/bin/curl",
+					Pod: &tetragon.Pod{
+						Namespace: "kube-system",
+						Name:      "tetragon",
+					},
+				},
+				Subsys: "raw_syscalls",
+				Event:  "sys_enter",
+				Args: []*tetragon.KprobeArgument{
+					{Label: "syscall", Arg: &tetragon.KprobeArgument_StringArg{StringArg: "openat"}},
+					{Label: "dfd", Arg: &tetragon.KprobeArgument_LongArg{LongArg: -100}},
+					{Label: "filename", Arg: &tetragon.KprobeArgument_StringArg{StringArg: "/etc/passwd"}},
+					{Label: "flags", Arg: &tetragon.KprobeArgument_StringArg{StringArg: "O_RDONLY|O_CLOEXEC"}},
+					{Label: "mode", Arg: &tetragon.KprobeArgument_SizeArg{SizeArg: 0}},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "☎  syscall kube-system/tetragon /usr/bin/curl openat(dfd=-100, filename=/etc/passwd, flags=O_RDONLY|O_CLOEXEC, mode=0)", result)
+}
+
 func TestCompactEncoder_KprobeOpenEventToString(t *testing.T) {
 	p := NewCompactEncoder(os.Stdout, Never, false)
 
@@ -281,6 +353,201 @@ func TestCompactEncoder_KprobeCloseEventToString(t *testing.T) {
 	assert.Equal(t, "📪 close   kube-system/tetragon /usr/bin/curl /etc/password", result)
 }
 
+func TestCompactEncoder_CredentialsChangeEventToString(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never, false)
+
+	// should fail if the process field is nil.
+	_, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessCredentialsChange{
+			ProcessCredentialsChange: &tetragon.ProcessCredentialsChange{},
+		},
+	})
+	assert.Error(t, err)
+
+	// setuid
+	result, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessCredentialsChange{
+			ProcessCredentialsChange: &tetragon.ProcessCredentialsChange{
+				Process: &tetragon.Process{
+					Binary: "/usr/bin/sudo",
+				},
+				OldCredentials: &tetragon.ProcessCredentials{
+					Uid:  &wrapperspb.UInt32Value{Value: 1000},
+					Euid: &wrapperspb.UInt32Value{Value: 1000},
+				},
+				NewCredentials: &tetragon.ProcessCredentials{
+					Uid:  &wrapperspb.UInt32Value{Value: 0},
+					Euid: &wrapperspb.UInt32Value{Value: 0},
+				},
+				Syscall: "setuid",
+			},
+		},
+		NodeName: "my-node",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "🔑 creds   my-node /usr/bin/sudo uid=1000->0 euid=1000->0 setuid", result)
+
+	// capabilities only
+	result, err = p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessCredentialsChange{
+			ProcessCredentialsChange: &tetragon.ProcessCredentialsChange{
+				Process: &tetragon.Process{
+					Binary: "/usr/sbin/capsh",
+				},
+				OldCredentials: &tetragon.ProcessCredentials{},
+				NewCredentials: &tetragon.ProcessCredentials{},
+			},
+		},
+		NodeName: "my-node",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "🔑 creds   my-node /usr/sbin/capsh caps", result)
+}
+
+func TestCompactEncoder_NamespaceChangeEventToString(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never, false)
+
+	// should fail if the process field is nil.
+	_, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessNamespaceChange{
+			ProcessNamespaceChange: &tetragon.ProcessNamespaceChange{},
+		},
+	})
+	assert.Error(t, err)
+
+	result, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessNamespaceChange{
+			ProcessNamespaceChange: &tetragon.ProcessNamespaceChange{
+				Process: &tetragon.Process{
+					Binary: "/usr/bin/nsenter",
+				},
+				NewNamespaces: &tetragon.Namespaces{
+					Mnt: &tetragon.Namespace{Inum: 4026531840, IsHost: true},
+					Net: &tetragon.Namespace{Inum: 4026532008},
+				},
+				Flags:   uint32(CLONE_NEWNS | CLONE_NEWNET),
+				Syscall: "setns",
+			},
+		},
+		NodeName: "my-node",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "📦 ns      my-node /usr/bin/nsenter mnt(host) net setns", result)
+}
+
+func TestCompactEncoder_KernelIntegrityEventToString(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never, false)
+
+	// should fail if the process field is nil.
+	_, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKernelModuleLoad{
+			ProcessKernelModuleLoad: &tetragon.ProcessKernelModuleLoad{},
+		},
+	})
+	assert.Error(t, err)
+
+	result, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKernelModuleLoad{
+			ProcessKernelModuleLoad: &tetragon.ProcessKernelModuleLoad{
+				Process: &tetragon.Process{
+					Binary: "/usr/sbin/insmod",
+				},
+				Module: &tetragon.KernelModule{
+					Name:      "rootkit",
+					Signature: tetragon.KernelModuleSignature_KERNEL_MODULE_SIGNATURE_UNVERIFIED,
+				},
+			},
+		},
+		NodeName: "my-node",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "🧩 kmod    my-node /usr/sbin/insmod rootkit unsigned", result)
+
+	result, err = p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessBpfProgLoad{
+			ProcessBpfProgLoad: &tetragon.ProcessBpfProgLoad{
+				Process: &tetragon.Process{
+					Binary: "/usr/sbin/bpftool",
+				},
+				ProgType: "BPF_PROG_TYPE_KPROBE",
+				Name:     "probe",
+				InsnCnt:  42,
+			},
+		},
+		NodeName: "my-node",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "🐝 bpf     my-node /usr/sbin/bpftool BPF_PROG_TYPE_KPROBE probe instruction count 42", result)
+
+	result, err = p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessBpfMapCreate{
+			ProcessBpfMapCreate: &tetragon.ProcessBpfMapCreate{
+				Process: &tetragon.Process{
+					Binary: "/usr/sbin/bpftool",
+				},
+				MapType:    "BPF_MAP_TYPE_HASH",
+				Name:       "conns",
+				MaxEntries: 1024,
+			},
+		},
+		NodeName: "my-node",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "🗺  bpfmap  my-node /usr/sbin/bpftool BPF_MAP_TYPE_HASH conns max entries 1024", result)
+}
+
+func TestCompactEncoder_MountEncoder(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never, false)
+
+	result, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessMount{
+			ProcessMount: &tetragon.ProcessMount{
+				Process: &tetragon.Process{
+					Binary: "/usr/bin/mount",
+				},
+				Operation: tetragon.MountOperation_MOUNT_OPERATION_MOUNT,
+				Source:    "/dev/sdb1",
+				Target:    "/mnt/data",
+				Fstype:    "ext4",
+			},
+		},
+		NodeName: "my-node",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "💾 mount   my-node /usr/bin/mount mount /dev/sdb1 /mnt/data ext4", result)
+
+	result, err = p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessMount{
+			ProcessMount: &tetragon.ProcessMount{
+				Process: &tetragon.Process{
+					Binary: "/usr/bin/umount",
+				},
+				Operation: tetragon.MountOperation_MOUNT_OPERATION_UMOUNT,
+				Target:    "/mnt/data",
+			},
+		},
+		NodeName: "my-node",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "💾 mount   my-node /usr/bin/umount umount /mnt/data", result)
+}
+
+func TestCompactEncoder_EventsLostEncoder(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never, false)
+
+	result, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_EventsLost{
+			EventsLost: &tetragon.EventsLost{
+				Count:          12,
+				OverflowPolicy: "drop-oldest",
+			},
+		},
+		NodeName: "my-node",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "⚠️  lost    my-node 12 events lost (drop-oldest)", result)
+}
+
 func TestCompactEncoder_Encode(t *testing.T) {
 	var b bytes.Buffer
 	p := NewCompactEncoder(&b, Never, false)
@@ -343,3 +610,28 @@ func TestCompactEncoder_EncodeWithTimestamp(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "1970-01-01T00:00:00.000000000Z 🚀 process kube-system/tetragon /usr/bin/curl cilium.io\n", b.String())
 }
+
+func TestCompactEncoder_EncodeWithNodeName(t *testing.T) {
+	var b bytes.Buffer
+	p := NewCompactEncoder(&b, Never, true)
+	p.NodeNames = true
+
+	err := p.Encode(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{
+			ProcessExec: &tetragon.ProcessExec{
+				Process: &tetragon.Process{
+					Binary:    "/usr/bin/curl",
+					Arguments: "cilium.io",
+					Pod: &tetragon.Pod{
+						Namespace: "kube-system",
+						Name:      "tetragon",
+					},
+				},
+			},
+		},
+		NodeName: "worker-1",
+		Time:     &timestamppb.Timestamp{},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1970-01-01T00:00:00.000000000Z worker-1 🚀 process kube-system/tetragon /usr/bin/curl cilium.io\n", b.String())
+}
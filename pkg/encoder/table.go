@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+// CSVEncoder encodes tetragon.GetEventsResponse as CSV records of columns,
+// after a header record with the column names.
+type CSVEncoder struct {
+	Writer  *csv.Writer
+	Columns []Column
+	header  bool
+}
+
+// NewCSVEncoder returns a pointer to CSVEncoder for the named columns, or the
+// default columns if names is empty.
+func NewCSVEncoder(w io.Writer, names []string) (*CSVEncoder, error) {
+	cols, err := GetColumns(names)
+	if err != nil {
+		return nil, err
+	}
+	return &CSVEncoder{
+		Writer:  csv.NewWriter(w),
+		Columns: cols,
+	}, nil
+}
+
+// Encode implements EventEncoder.Encode.
+func (p *CSVEncoder) Encode(v interface{}) error {
+	event, ok := v.(*tetragon.GetEventsResponse)
+	if !ok {
+		return ErrInvalidEvent
+	}
+	if !p.header {
+		names := make([]string, len(p.Columns))
+		for i, c := range p.Columns {
+			names[i] = c.Name
+		}
+		if err := p.Writer.Write(names); err != nil {
+			return err
+		}
+		p.header = true
+	}
+	record := make([]string, len(p.Columns))
+	for i, c := range p.Columns {
+		record[i] = c.Value(event)
+	}
+	if err := p.Writer.Write(record); err != nil {
+		return err
+	}
+	// Flush each event, events are streamed.
+	p.Writer.Flush()
+	return p.Writer.Error()
+}
+
+// TableEncoder encodes tetragon.GetEventsResponse as rows of columns, after a
+// header row with the upper case column names. Since events are streamed, the
+// columns are padded to their width instead of the widest value, longer
+// values shift the following columns of their row.
+type TableEncoder struct {
+	Writer  io.Writer
+	Columns []Column
+	header  bool
+}
+
+// NewTableEncoder returns a pointer to TableEncoder for the named columns, or
+// the default columns if names is empty.
+func NewTableEncoder(w io.Writer, names []string) (*TableEncoder, error) {
+	cols, err := GetColumns(names)
+	if err != nil {
+		return nil, err
+	}
+	return &TableEncoder{
+		Writer:  w,
+		Columns: cols,
+	}, nil
+}
+
+func (p *TableEncoder) row(values []string) string {
+	var sb strings.Builder
+	for i, value := range values {
+		if i == len(values)-1 {
+			// Do not pad the last column.
+			sb.WriteString(value)
+			break
+		}
+		fmt.Fprintf(&sb, "%-*s ", p.Columns[i].Width, value)
+	}
+	return strings.TrimRight(sb.String(), " ")
+}
+
+// Encode implements EventEncoder.Encode.
+func (p *TableEncoder) Encode(v interface{}) error {
+	event, ok := v.(*tetragon.GetEventsResponse)
+	if !ok {
+		return ErrInvalidEvent
+	}
+	if !p.header {
+		names := make([]string, len(p.Columns))
+		for i, c := range p.Columns {
+			names[i] = strings.ToUpper(c.Name)
+		}
+		if _, err := fmt.Fprintln(p.Writer, p.row(names)); err != nil {
+			return err
+		}
+		p.header = true
+	}
+	values := make([]string, len(p.Columns))
+	for i, c := range p.Columns {
+		// Keep one event per row.
+		values[i] = strings.ReplaceAll(c.Value(event), "\n", " ")
+	}
+	_, err := fmt.Fprintln(p.Writer, p.row(values))
+	return err
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"bytes"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func kprobeConnectEvent() *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{
+			ProcessKprobe: &tetragon.ProcessKprobe{
+				Process: &tetragon.Process{
+					Binary: "/usr/bin/curl",
+					Pod: &tetragon.Pod{
+						Namespace: "kube-system",
+						Name:      "tetragon",
+					},
+					Cap: &tetragon.Capabilities{
+						Effective: []tetragon.CapabilitiesType{
+							tetragon.CapabilitiesType_CAP_NET_RAW,
+							tetragon.CapabilitiesType_CAP_NET_ADMIN,
+						},
+					},
+				},
+				FunctionName: "tcp_connect",
+				PolicyName:   "connect",
+				Args: []*tetragon.KprobeArgument{
+					{Arg: &tetragon.KprobeArgument_SockArg{SockArg: &tetragon.KprobeSock{
+						Saddr: "10.0.0.1",
+						Sport: 40000,
+						Daddr: "1.1.1.1",
+						Dport: 443,
+					}}},
+					{Arg: &tetragon.KprobeArgument_IntArg{IntArg: 3}},
+				},
+			},
+		},
+		NodeName: "my-node",
+		Time:     &timestamppb.Timestamp{Seconds: 1, Nanos: 2},
+	}
+}
+
+func TestGetColumns(t *testing.T) {
+	cols, err := GetColumns(nil)
+	require.NoError(t, err)
+	assert.Len(t, cols, len(DefaultColumns))
+
+	cols, err = GetColumns([]string{"arg1", "policy"})
+	require.NoError(t, err)
+	assert.Equal(t, "3", cols[0].Value(kprobeConnectEvent()))
+	assert.Equal(t, "connect", cols[1].Value(kprobeConnectEvent()))
+
+	_, err = GetColumns([]string{"unknown"})
+	assert.Error(t, err)
+	_, err = GetColumns([]string{"argx"})
+	assert.Error(t, err)
+}
+
+func TestCSVEncoder_Encode(t *testing.T) {
+	var b bytes.Buffer
+	p, err := NewCSVEncoder(&b, []string{"type", "pod", "function", "args", "arg5", "caps"})
+	require.NoError(t, err)
+
+	require.NoError(t, p.Encode(kprobeConnectEvent()))
+	require.NoError(t, p.Encode(kprobeConnectEvent()))
+	assert.Error(t, p.Encode(nil))
+	row := `process_kprobe,kube-system/tetragon,tcp_connect,tcp 10.0.0.1:40000 -> 1.1.1.1:443 3,,"CAP_NET_RAW,CAP_NET_ADMIN"` + "\n"
+	assert.Equal(t, "type,pod,function,args,arg5,caps\n"+row+row, b.String())
+}
+
+func TestTableEncoder_Encode(t *testing.T) {
+	var b bytes.Buffer
+	p, err := NewTableEncoder(&b, []string{"node", "binary", "arg0"})
+	require.NoError(t, err)
+
+	require.NoError(t, p.Encode(kprobeConnectEvent()))
+	assert.Equal(t, ""+
+		"NODE         BINARY               ARG0\n"+
+		"my-node      /usr/bin/curl        tcp 10.0.0.1:40000 -> 1.1.1.1:443\n", b.String())
+}
+
+func TestTemplateEncoder_Encode(t *testing.T) {
+	var b bytes.Buffer
+	p, err := NewTemplateEncoder(&b, `{{time .}} {{.NodeName}} {{type .}} {{(process .).Binary}} {{function .}} {{arg 0 .}} caps={{caps (process .).Cap}} {{ns 0x40000000}}`)
+	require.NoError(t, err)
+
+	require.NoError(t, p.Encode(kprobeConnectEvent()))
+	assert.Equal(t, "1970-01-01T00:00:01.000000002Z my-node process_kprobe /usr/bin/curl tcp_connect tcp 10.0.0.1:40000 -> 1.1.1.1:443 caps=CAP_NET_RAW,CAP_NET_ADMIN net\n", b.String())
+
+	b.Reset()
+	p, err = NewTemplateEncoder(&b, "{{range args .}}[{{.}}]{{end}} {{column \"policy\" .}}\n")
+	require.NoError(t, err)
+	require.NoError(t, p.Encode(kprobeConnectEvent()))
+	assert.Equal(t, "[tcp 10.0.0.1:40000 -> 1.1.1.1:443][3] connect\n", b.String())
+
+	_, err = NewTemplateEncoder(&b, "{{unknown .}}")
+	assert.Error(t, err)
+}
+
+func TestArgStringPartial(t *testing.T) {
+	args := []*tetragon.KprobeArgument{
+		nil,
+		{},
+		{Arg: &tetragon.KprobeArgument_PathArg{}},
+		{Arg: &tetragon.KprobeArgument_FileArg{}},
+		{Arg: &tetragon.KprobeArgument_SockArg{}},
+		{Arg: &tetragon.KprobeArgument_SkbArg{}},
+		{Arg: &tetragon.KprobeArgument_CredArg{}},
+		{Arg: &tetragon.KprobeArgument_TruncatedBytesArg{}},
+		{Arg: &tetragon.KprobeArgument_BpfAttrArg{}},
+		{Arg: &tetragon.KprobeArgument_PerfEventArg{}},
+	}
+	for _, arg := range args {
+		assert.NotPanics(t, func() { ArgString(arg) })
+	}
+	assert.Equal(t, "", ArgString(&tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_PathArg{}}))
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package encoder
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/api/v1/tetragon/codegen/helpers"
+)
+
+// TemplateFuncs are the functions available to the templates of
+// TemplateEncoder, in addition to the text/template builtins:
+//
+//	process EVENT      the process of the event
+//	parent EVENT       the parent process of the event
+//	type EVENT         the event type, e.g. process_exec
+//	time EVENT         the event time in RFC 3339 format
+//	pod EVENT          NAMESPACE/NAME of the pod of the process
+//	function EVENT     the kprobe function or tracepoint SUBSYS/EVENT
+//	args EVENT         the kprobe or tracepoint arguments, as a list
+//	arg N EVENT        the kprobe or tracepoint argument N
+//	column NAME EVENT  the value of a csv and table column
+//	compact EVENT      the event in the compact format, without colors
+//	caps CAPS          capabilities as a comma separated list of names
+//	ns NS              the name of a CLONE_NEW* namespace flag
+//	sock SOCK          a socket as "tcp SADDR:SPORT -> DADDR:DPORT"
+//
+// The arguments are formatted as by the compact encoder.
+var TemplateFuncs = template.FuncMap{
+	"process":  helpers.ResponseGetProcess,
+	"parent":   helpers.ResponseGetParent,
+	"type":     EventType,
+	"time":     EventTime,
+	"pod":      EventPod,
+	"function": EventFunction,
+	"args":     EventArgs,
+	"arg":      EventArg,
+	"column":   templateColumn,
+	"compact":  templateCompact,
+	"caps":     templateCaps,
+	"ns":       PrintNS,
+	"sock":     SockString,
+}
+
+func templateColumn(name string, res *tetragon.GetEventsResponse) (string, error) {
+	c, err := GetColumn(name)
+	if err != nil {
+		return "", err
+	}
+	return c.Value(res), nil
+}
+
+func templateCompact(res *tetragon.GetEventsResponse) (string, error) {
+	p := CompactEncoder{Colorer: NewColorer(Never)}
+	return p.EventToString(res)
+}
+
+// templateCaps accepts the capabilities of processes and kprobe credential
+// arguments, of which it formats the effective set, or a set of
+// capabilities.
+func templateCaps(v interface{}) (string, error) {
+	switch caps := v.(type) {
+	case nil:
+		return "", nil
+	case *tetragon.Capabilities:
+		return CapsString(caps.GetEffective()), nil
+	case *tetragon.KprobeCred:
+		return CapsString(caps.GetEffective()), nil
+	case []tetragon.CapabilitiesType:
+		return CapsString(caps), nil
+	}
+	return "", fmt.Errorf("caps: unexpected %T", v)
+}
+
+// TemplateEncoder encodes tetragon.GetEventsResponse with a text/template
+// executed on each event. A newline is added after each event, unless the
+// template ends with one.
+type TemplateEncoder struct {
+	Writer   io.Writer
+	Template *template.Template
+	newline  bool
+}
+
+// NewTemplateEncoder parses text and returns a pointer to TemplateEncoder.
+func NewTemplateEncoder(w io.Writer, text string) (*TemplateEncoder, error) {
+	tmpl, err := template.New("event").Funcs(TemplateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	return &TemplateEncoder{
+		Writer:   w,
+		Template: tmpl,
+		newline:  !strings.HasSuffix(text, "\n"),
+	}, nil
+}
+
+// Encode implements EventEncoder.Encode.
+func (p *TemplateEncoder) Encode(v interface{}) error {
+	event, ok := v.(*tetragon.GetEventsResponse)
+	if !ok {
+		return ErrInvalidEvent
+	}
+	var sb strings.Builder
+	if err := p.Template.Execute(&sb, event); err != nil {
+		return err
+	}
+	if p.newline {
+		sb.WriteByte('\n')
+	}
+	_, err := io.WriteString(p.Writer, sb.String())
+	return err
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/cilium/tetragon/pkg/metrics/errormetrics"
 	"github.com/cilium/tetragon/pkg/metrics/eventcachemetrics"
 	"github.com/cilium/tetragon/pkg/metrics/mapmetrics"
+	"github.com/cilium/tetragon/pkg/metrics/processexecmetrics"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/process"
 	"github.com/cilium/tetragon/pkg/reader/node"
@@ -51,6 +52,7 @@ var (
 	ErrFailedToGetPodInfo     = errors.New("failed to get pod info")
 	ErrFailedToGetProcessInfo = errors.New("failed to get process info")
 	ErrFailedToGetParentInfo  = errors.New("failed to get parent info")
+	ErrBinaryHashPending      = errors.New("binary hash pending")
 )
 
 // Generic internal lookup happens when events are received out of order and
@@ -106,6 +108,11 @@ func (ec *Cache) handleEvents() {
 				eventcachemetrics.ProcessInfoError(notify.EventTypeString(event.event)).Inc()
 			} else if errors.Is(err, ErrFailedToGetPodInfo) {
 				eventcachemetrics.ProcessInfoError(notify.EventTypeString(event.event)).Inc()
+			} else if errors.Is(err, ErrBinaryHashPending) {
+				// Retry returns before copying the process while
+				// the hash is pending, so take the copy now.
+				event.event.SetProcess(event.internal.GetProcessCopy())
+				processexecmetrics.BinaryHashInc(processexecmetrics.BinaryHashTimeout)
 			}
 		}
 
@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/health"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/ratelimit"
 	"github.com/cilium/tetragon/pkg/server"
@@ -54,15 +55,18 @@ func NewExporter(
 func (e *Exporter) Start() {
 	var readyWG sync.WaitGroup
 	readyWG.Add(1)
+	health.SetStatus(health.Exporter, health.Starting, "")
 	go func() {
 		if err := e.server.GetEventsWG(e.request, e, e.closer, &readyWG); err != nil {
 			if e.ctx.Err() == nil {
 				logger.GetLogger().WithError(err).Error("Failed to start JSON exporter")
+				health.SetStatus(health.Exporter, health.Failed, err.Error())
 			}
 		}
 		e.done <- true
 	}()
 	readyWG.Wait()
+	health.SetStatus(health.Exporter, health.Serving, "")
 }
 
 func (e *Exporter) Send(event *tetragon.GetEventsResponse) error {
@@ -76,6 +80,11 @@ func (e *Exporter) Send(event *tetragon.GetEventsResponse) error {
 	return nil
 }
 
+// ClientName names the exporter in the GetEvents client metrics.
+func (e *Exporter) ClientName() string {
+	return "exporter"
+}
+
 func (e *Exporter) SetHeader(metadata.MD) error {
 	return nil
 }
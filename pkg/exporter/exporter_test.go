@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/ratelimit"
 	"github.com/cilium/tetragon/pkg/sensors"
 	"github.com/cilium/tetragon/pkg/server"
@@ -146,6 +147,49 @@ func TestExporter_Send(t *testing.T) {
 	<-eventNotifier.removed
 }
 
+func TestExporter_SinceSequence(t *testing.T) {
+	var wg sync.WaitGroup
+
+	oldSize := option.Config.EventRingSize
+	option.Config.EventRingSize = 10
+	defer func() { option.Config.EventRingSize = oldSize }()
+
+	eventNotifier := newFakeNotifier()
+	ctx, cancel := context.WithCancel(context.Background())
+	grpcServer := server.NewServer(ctx, &wg, eventNotifier, &fakeObserver{})
+	execEvent := func(binary string) *tetragon.GetEventsResponse {
+		return &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessExec{
+				ProcessExec: &tetragon.ProcessExec{Process: &tetragon.Process{Binary: binary}},
+			}}
+	}
+	var replayed *tetragon.GetEventsResponse
+	for _, b := range []string{"a", "b", "c"} {
+		replayed = execEvent(b)
+		grpcServer.RecordEvent(replayed)
+	}
+
+	results := newArrayWriter(3)
+	encoder := json.NewEncoder(results)
+	epoch := replayed.Epoch
+	request := tetragon.GetEventsRequest{SinceSequence: 1, SinceEpoch: epoch}
+	exporter := NewExporter(ctx, &request, grpcServer, encoder, results, nil)
+	exporter.Start()
+	// already replayed, must not be exported twice
+	eventNotifier.NotifyListener(nil, replayed)
+	live := execEvent("d")
+	grpcServer.RecordEvent(live)
+	eventNotifier.NotifyListener(nil, live)
+	<-results.done
+	assert.Equal(t, []string{
+		fmt.Sprintf(`{"process_exec":{"process":{"binary":"b"}},"sequence":"2","epoch":"%s"}`, epoch),
+		fmt.Sprintf(`{"process_exec":{"process":{"binary":"c"}},"sequence":"3","epoch":"%s"}`, epoch),
+		fmt.Sprintf(`{"process_exec":{"process":{"binary":"d"}},"sequence":"4","epoch":"%s"}`, epoch),
+	}, results.items)
+	cancel()
+	<-eventNotifier.removed
+}
+
 type jsonEvent struct {
 	Event         json.RawMessage `json:"process_exec"`
 	RateLimitInfo json.RawMessage `json:"rate_limit_info"`
@@ -0,0 +1,337 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+var argOperators = []struct {
+	text string
+	op   tetragon.ArgOperator
+}{
+	// Two character operators first, so that "<=" is not parsed as "<".
+	{"==", tetragon.ArgOperator_ARG_OPERATOR_EQ},
+	{"!=", tetragon.ArgOperator_ARG_OPERATOR_NE},
+	{"<=", tetragon.ArgOperator_ARG_OPERATOR_LE},
+	{">=", tetragon.ArgOperator_ARG_OPERATOR_GE},
+	{"<", tetragon.ArgOperator_ARG_OPERATOR_LT},
+	{">", tetragon.ArgOperator_ARG_OPERATOR_GT},
+}
+
+// ParseArgFilter parses an argument filter of the form INDEX:MATCHER, where
+// MATCHER is one of prefix=VALUE, regex=VALUE, saddr=CIDR, daddr=CIDR, or an
+// integer comparison such as >=1024 or !=0.
+func ParseArgFilter(s string) (*tetragon.ArgFilter, error) {
+	index, matcher, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid argument filter %q: expected INDEX:MATCHER", s)
+	}
+	i, err := strconv.ParseUint(index, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid argument filter %q: invalid index %q", s, index)
+	}
+	filter := &tetragon.ArgFilter{Index: uint32(i)}
+
+	for _, o := range argOperators {
+		if !strings.HasPrefix(matcher, o.text) {
+			continue
+		}
+		value, err := strconv.ParseInt(strings.TrimPrefix(matcher, o.text), 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument filter %q: %w", s, err)
+		}
+		filter.Int = []*tetragon.ArgIntComparison{{Operator: o.op, Value: value}}
+		return filter, nil
+	}
+
+	kind, value, ok := strings.Cut(matcher, "=")
+	if !ok {
+		return nil, fmt.Errorf("invalid argument filter %q: expected prefix=, regex=, saddr=, daddr= or an integer comparison", s)
+	}
+	switch kind {
+	case "prefix":
+		filter.Prefix = []string{value}
+	case "regex":
+		filter.Regex = []string{value}
+	case "saddr":
+		filter.Saddr = []string{value}
+	case "daddr":
+		filter.Daddr = []string{value}
+	default:
+		return nil, fmt.Errorf("invalid argument filter %q: unknown matcher %q", s, kind)
+	}
+	return filter, nil
+}
+
+func parseCIDR(s string) (*net.IPNet, error) {
+	if _, n, err := net.ParseCIDR(s); err == nil {
+		return n, nil
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid CIDR or IP address %q", s)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
+type argMatcher struct {
+	index    uint32
+	prefixes []string
+	regexes  []*regexp.Regexp
+	ints     []*tetragon.ArgIntComparison
+	saddrs   []*net.IPNet
+	daddrs   []*net.IPNet
+}
+
+func newArgMatcher(filter *tetragon.ArgFilter) (*argMatcher, error) {
+	m := &argMatcher{
+		index:    filter.Index,
+		prefixes: filter.Prefix,
+		ints:     filter.Int,
+	}
+	for _, c := range filter.Int {
+		switch c.Operator {
+		case tetragon.ArgOperator_ARG_OPERATOR_EQ, tetragon.ArgOperator_ARG_OPERATOR_NE,
+			tetragon.ArgOperator_ARG_OPERATOR_LT, tetragon.ArgOperator_ARG_OPERATOR_LE,
+			tetragon.ArgOperator_ARG_OPERATOR_GT, tetragon.ArgOperator_ARG_OPERATOR_GE:
+		default:
+			return nil, fmt.Errorf("invalid argument filter operator %s", c.Operator)
+		}
+	}
+	for _, pattern := range filter.Regex {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile regexp: %v", err)
+		}
+		m.regexes = append(m.regexes, re)
+	}
+	for _, s := range filter.Saddr {
+		n, err := parseCIDR(s)
+		if err != nil {
+			return nil, err
+		}
+		m.saddrs = append(m.saddrs, n)
+	}
+	for _, s := range filter.Daddr {
+		n, err := parseCIDR(s)
+		if err != nil {
+			return nil, err
+		}
+		m.daddrs = append(m.daddrs, n)
+	}
+	return m, nil
+}
+
+func argString(arg *tetragon.KprobeArgument) (string, bool) {
+	switch arg.GetArg().(type) {
+	case *tetragon.KprobeArgument_StringArg:
+		return arg.GetStringArg(), true
+	case *tetragon.KprobeArgument_PathArg:
+		return arg.GetPathArg().GetPath(), true
+	case *tetragon.KprobeArgument_FileArg:
+		return arg.GetFileArg().GetPath(), true
+	}
+	return "", false
+}
+
+// argCompare compares an integer argument to v and returns -1, 0 or 1.
+func argCompare(arg *tetragon.KprobeArgument, v int64) (int, bool) {
+	var x int64
+	switch arg.GetArg().(type) {
+	case *tetragon.KprobeArgument_IntArg:
+		x = int64(arg.GetIntArg())
+	case *tetragon.KprobeArgument_LongArg:
+		x = arg.GetLongArg()
+	case *tetragon.KprobeArgument_SizeArg:
+		if arg.GetSizeArg() > math.MaxInt64 {
+			return 1, true
+		}
+		x = int64(arg.GetSizeArg())
+	default:
+		return 0, false
+	}
+	switch {
+	case x < v:
+		return -1, true
+	case x > v:
+		return 1, true
+	}
+	return 0, true
+}
+
+func argAddrs(arg *tetragon.KprobeArgument) (saddr, daddr string, ok bool) {
+	switch arg.GetArg().(type) {
+	case *tetragon.KprobeArgument_SockArg:
+		return arg.GetSockArg().GetSaddr(), arg.GetSockArg().GetDaddr(), true
+	case *tetragon.KprobeArgument_SkbArg:
+		return arg.GetSkbArg().GetSaddr(), arg.GetSkbArg().GetDaddr(), true
+	}
+	return "", "", false
+}
+
+func matchCIDRs(addr string, cidrs []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range cidrs {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *argMatcher) matchString(arg *tetragon.KprobeArgument) bool {
+	s, ok := argString(arg)
+	if !ok {
+		return false
+	}
+	if len(m.prefixes) > 0 {
+		found := false
+		for _, prefix := range m.prefixes {
+			if strings.HasPrefix(s, prefix) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(m.regexes) > 0 {
+		found := false
+		for _, re := range m.regexes {
+			if re.MatchString(s) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *argMatcher) matchInt(arg *tetragon.KprobeArgument) bool {
+	for _, c := range m.ints {
+		cmp, ok := argCompare(arg, c.Value)
+		if !ok {
+			return false
+		}
+		var match bool
+		switch c.Operator {
+		case tetragon.ArgOperator_ARG_OPERATOR_EQ:
+			match = cmp == 0
+		case tetragon.ArgOperator_ARG_OPERATOR_NE:
+			match = cmp != 0
+		case tetragon.ArgOperator_ARG_OPERATOR_LT:
+			match = cmp < 0
+		case tetragon.ArgOperator_ARG_OPERATOR_LE:
+			match = cmp <= 0
+		case tetragon.ArgOperator_ARG_OPERATOR_GT:
+			match = cmp > 0
+		case tetragon.ArgOperator_ARG_OPERATOR_GE:
+			match = cmp >= 0
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *argMatcher) match(args []*tetragon.KprobeArgument) bool {
+	if int(m.index) >= len(args) {
+		return false
+	}
+	arg := args[m.index]
+	if (len(m.prefixes) > 0 || len(m.regexes) > 0) && !m.matchString(arg) {
+		return false
+	}
+	if len(m.ints) > 0 && !m.matchInt(arg) {
+		return false
+	}
+	if len(m.saddrs) > 0 || len(m.daddrs) > 0 {
+		saddr, daddr, ok := argAddrs(arg)
+		if !ok {
+			return false
+		}
+		if len(m.saddrs) > 0 && !matchCIDRs(saddr, m.saddrs) {
+			return false
+		}
+		if len(m.daddrs) > 0 && !matchCIDRs(daddr, m.daddrs) {
+			return false
+		}
+	}
+	return true
+}
+
+func getArgs(response *tetragon.GetEventsResponse) ([]*tetragon.KprobeArgument, bool) {
+	switch ev := response.Event.(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		return ev.ProcessKprobe.Args, true
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		return ev.ProcessTracepoint.Args, true
+	}
+	return nil, false
+}
+
+func filterByArgs(filters []*tetragon.ArgFilter) (hubbleFilters.FilterFunc, error) {
+	var matchers []*argMatcher
+	for _, filter := range filters {
+		m, err := newArgMatcher(filter)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+	return func(ev *v1.Event) bool {
+		response, ok := ev.Event.(*tetragon.GetEventsResponse)
+		if !ok {
+			return false
+		}
+		args, ok := getArgs(response)
+		if !ok {
+			return false
+		}
+		for _, m := range matchers {
+			if !m.match(args) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// ArgFilter matches process_kprobe and process_tracepoint events by argument
+// value. Other events never match.
+type ArgFilter struct{}
+
+func (f *ArgFilter) OnBuildFilter(_ context.Context, ff *tetragon.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.Arg != nil {
+		argFilter, err := filterByArgs(ff.Arg)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, argFilter)
+	}
+	return fs, nil
+}
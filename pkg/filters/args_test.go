@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseArgFilter(t *testing.T) {
+	tests := []struct {
+		s      string
+		filter *tetragon.ArgFilter
+	}{
+		{"1:prefix=/etc", &tetragon.ArgFilter{Index: 1, Prefix: []string{"/etc"}}},
+		{"0:regex=^/etc/(passwd|shadow)$", &tetragon.ArgFilter{Regex: []string{"^/etc/(passwd|shadow)$"}}},
+		{"0:saddr=10.0.0.0/8", &tetragon.ArgFilter{Saddr: []string{"10.0.0.0/8"}}},
+		{"0:daddr=::1", &tetragon.ArgFilter{Daddr: []string{"::1"}}},
+		{"2:>=1024", &tetragon.ArgFilter{Index: 2, Int: []*tetragon.ArgIntComparison{{Operator: tetragon.ArgOperator_ARG_OPERATOR_GE, Value: 1024}}}},
+		{"2:>0x10", &tetragon.ArgFilter{Index: 2, Int: []*tetragon.ArgIntComparison{{Operator: tetragon.ArgOperator_ARG_OPERATOR_GT, Value: 16}}}},
+		{"2:!=-1", &tetragon.ArgFilter{Index: 2, Int: []*tetragon.ArgIntComparison{{Operator: tetragon.ArgOperator_ARG_OPERATOR_NE, Value: -1}}}},
+		{"2:==0", &tetragon.ArgFilter{Index: 2, Int: []*tetragon.ArgIntComparison{{Operator: tetragon.ArgOperator_ARG_OPERATOR_EQ, Value: 0}}}},
+	}
+	for _, tt := range tests {
+		filter, err := ParseArgFilter(tt.s)
+		require.NoError(t, err, tt.s)
+		assert.Equal(t, tt.filter.String(), filter.String(), tt.s)
+	}
+
+	for _, s := range []string{"", "prefix=/etc", "a:prefix=/etc", "-1:prefix=/etc", "0:>=foo", "0:suffix=/etc", "0:/etc"} {
+		_, err := ParseArgFilter(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestArgFilter(t *testing.T) {
+	parse := func(s ...string) []*tetragon.ArgFilter {
+		var filters []*tetragon.ArgFilter
+		for _, arg := range s {
+			filter, err := ParseArgFilter(arg)
+			require.NoError(t, err)
+			filters = append(filters, filter)
+		}
+		return filters
+	}
+	stringArg := func(s string) *tetragon.KprobeArgument {
+		return &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_StringArg{StringArg: s}}
+	}
+	intArg := func(i int32) *tetragon.KprobeArgument {
+		return &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_IntArg{IntArg: i}}
+	}
+	sizeArg := func(i uint64) *tetragon.KprobeArgument {
+		return &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_SizeArg{SizeArg: i}}
+	}
+	sockArg := func(saddr, daddr string) *tetragon.KprobeArgument {
+		return &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_SockArg{SockArg: &tetragon.KprobeSock{Saddr: saddr, Daddr: daddr}}}
+	}
+	openat := func(path string, flags int32) *tetragon.ProcessTracepoint {
+		return &tetragon.ProcessTracepoint{
+			Subsys: "syscalls",
+			Event:  "sys_enter_openat",
+			Args:   []*tetragon.KprobeArgument{intArg(-100), stringArg(path), intArg(flags)},
+		}
+	}
+
+	tests := []struct {
+		args  []string
+		event *v1.Event
+		match bool
+	}{
+		{[]string{"1:prefix=/etc"}, tracepointEvent(openat("/etc/passwd", 0)), true},
+		{[]string{"1:prefix=/etc"}, tracepointEvent(openat("/tmp/passwd", 0)), false},
+		{[]string{"1:regex=/(passwd|shadow)$"}, tracepointEvent(openat("/etc/shadow", 0)), true},
+		{[]string{"1:regex=/(passwd|shadow)$"}, tracepointEvent(openat("/etc/group", 0)), false},
+		{[]string{"1:prefix=/etc", "2:>0"}, tracepointEvent(openat("/etc/passwd", 0)), false},
+		{[]string{"1:prefix=/etc", "2:>0"}, tracepointEvent(openat("/etc/passwd", 1)), true},
+		{[]string{"0:<0"}, tracepointEvent(openat("/etc/passwd", 0)), true},
+		// string matchers never match integer arguments
+		{[]string{"0:prefix=-"}, tracepointEvent(openat("/etc/passwd", 0)), false},
+		// out of range index
+		{[]string{"3:==0"}, tracepointEvent(openat("/etc/passwd", 0)), false},
+		{[]string{"0:>=1024"}, kprobeEvent(&tetragon.ProcessKprobe{Args: []*tetragon.KprobeArgument{sizeArg(4096)}}), true},
+		{[]string{"0:>=1024"}, kprobeEvent(&tetragon.ProcessKprobe{Args: []*tetragon.KprobeArgument{sizeArg(1 << 63)}}), true},
+		{[]string{"0:<1024"}, kprobeEvent(&tetragon.ProcessKprobe{Args: []*tetragon.KprobeArgument{sizeArg(1 << 63)}}), false},
+		{[]string{"0:daddr=10.0.0.0/8"}, kprobeEvent(&tetragon.ProcessKprobe{Args: []*tetragon.KprobeArgument{sockArg("192.168.1.1", "10.1.2.3")}}), true},
+		{[]string{"0:saddr=10.0.0.0/8"}, kprobeEvent(&tetragon.ProcessKprobe{Args: []*tetragon.KprobeArgument{sockArg("192.168.1.1", "10.1.2.3")}}), false},
+		{[]string{"0:saddr=192.168.1.1"}, kprobeEvent(&tetragon.ProcessKprobe{Args: []*tetragon.KprobeArgument{sockArg("192.168.1.1", "10.1.2.3")}}), true},
+		{[]string{"0:saddr=fd00::/8"}, kprobeEvent(&tetragon.ProcessKprobe{Args: []*tetragon.KprobeArgument{sockArg("fd00::1", "fd00::2")}}), true},
+		{[]string{"0:saddr=0.0.0.0/0"}, kprobeEvent(&tetragon.ProcessKprobe{Args: []*tetragon.KprobeArgument{stringArg("10.0.0.1")}}), false},
+		{[]string{"0:==0"}, execEvent, false},
+		// partially populated arguments do not match
+		{[]string{"0:prefix=/etc"}, kprobeEvent(&tetragon.ProcessKprobe{Args: []*tetragon.KprobeArgument{{Arg: &tetragon.KprobeArgument_PathArg{}}}}), false},
+		{[]string{"0:prefix=/etc"}, kprobeEvent(&tetragon.ProcessKprobe{Args: []*tetragon.KprobeArgument{{Arg: &tetragon.KprobeArgument_FileArg{}}}}), false},
+		{[]string{"0:saddr=0.0.0.0/0"}, kprobeEvent(&tetragon.ProcessKprobe{Args: []*tetragon.KprobeArgument{{Arg: &tetragon.KprobeArgument_SockArg{}}}}), false},
+		{[]string{"0:daddr=0.0.0.0/0"}, kprobeEvent(&tetragon.ProcessKprobe{Args: []*tetragon.KprobeArgument{{Arg: &tetragon.KprobeArgument_SkbArg{}}}}), false},
+		{[]string{"0:==0"}, kprobeEvent(&tetragon.ProcessKprobe{Args: []*tetragon.KprobeArgument{nil}}), false},
+	}
+	for _, tt := range tests {
+		f := []*tetragon.Filter{{Arg: parse(tt.args...)}}
+		fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&ArgFilter{}})
+		require.NoError(t, err)
+		assert.Equal(t, tt.match, fl.MatchOne(tt.event), "%v", tt.args)
+	}
+}
+
+func TestArgFilterInvalid(t *testing.T) {
+	for _, filter := range []*tetragon.ArgFilter{
+		{Regex: []string{"("}},
+		{Saddr: []string{"10.0.0.0/33"}},
+		{Daddr: []string{"foo"}},
+		{Int: []*tetragon.ArgIntComparison{{Value: 1}}},
+		{Int: []*tetragon.ArgIntComparison{{Operator: 42, Value: 1}}},
+	} {
+		f := []*tetragon.Filter{{Arg: []*tetragon.ArgFilter{filter}}}
+		_, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&ArgFilter{}})
+		assert.Error(t, err, filter.String())
+	}
+}
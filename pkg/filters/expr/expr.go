@@ -0,0 +1,907 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package expr implements a small CEL-like expression language evaluated
+// against GetEventsResponse events.
+//
+// The fields of GetEventsResponse, e.g. process_kprobe or node_name, are
+// available as variables, together with:
+//
+//	process     the process of the event, whatever its type
+//	parent      the parent process of the event, whatever its type
+//	event_type  the type of the event, e.g. "PROCESS_KPROBE"
+//
+// Fields are accessed by their protobuf name, lists and maps are indexed with
+// [], wrapper types such as google.protobuf.UInt32Value are unwrapped and
+// enums compare equal to both their number and their name. Accessing a field
+// of an unset message, e.g. process_kprobe.function_name for an exec event,
+// evaluates to null. Supported operators are ==, !=, <, <=, >, >=, in, !, &&
+// and ||, and supported functions are:
+//
+//	has(x.f)                 whether the field f is set
+//	size(x)                  the length of a string, list or map
+//	string(x), int(x)        conversions
+//	s.startsWith(t), s.endsWith(t), s.contains(t)
+//	s.matches("re")          RE2 regular expression match
+//	l.exists(x, p), l.all(x, p)
+//	                         whether p holds for any or all elements x of l
+//
+// For example:
+//
+//	process_kprobe.function_name == "tcp_connect" && process.uid == 0
+//	parent.binary.endsWith("/bash")
+//	process_kprobe.args.exists(a, a.int_arg == 42)
+//	"CAP_SYS_ADMIN" in process.cap.effective
+package expr
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/api/v1/tetragon/codegen/helpers"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// value is the result of evaluating an expression. It is one of nil, bool,
+// int64, uint64, float64, string, enumValue, protoreflect.Message, listValue,
+// mapValue or []value.
+type value interface{}
+
+type enumValue struct {
+	num  protoreflect.EnumNumber
+	desc protoreflect.EnumDescriptor
+}
+
+func (e enumValue) name() string {
+	if v := e.desc.Values().ByNumber(e.num); v != nil {
+		return string(v.Name())
+	}
+	return ""
+}
+
+type listValue struct {
+	list protoreflect.List
+	fd   protoreflect.FieldDescriptor
+}
+
+type mapValue struct {
+	m  protoreflect.Map
+	fd protoreflect.FieldDescriptor
+}
+
+// typ is the static type of an expression, nil if unknown. It is used to
+// report unknown fields when compiling.
+type typ struct {
+	msg    protoreflect.MessageDescriptor
+	list   bool
+	mapv   bool
+	scalar bool
+}
+
+type env struct {
+	res  *tetragon.GetEventsResponse
+	msg  protoreflect.Message
+	vars []value
+}
+
+type evalFn func(*env) value
+
+type scopeVar struct {
+	name string
+	typ  *typ
+}
+
+var (
+	responseDesc = (&tetragon.GetEventsResponse{}).ProtoReflect().Descriptor()
+	processDesc  = (&tetragon.Process{}).ProtoReflect().Descriptor()
+)
+
+// Program is a compiled expression.
+type Program struct {
+	src  string
+	eval evalFn
+}
+
+// Compile parses src and checks the fields it accesses.
+func Compile(src string) (*Program, error) {
+	n, err := parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", src, err)
+	}
+	c := &compiler{}
+	fn, _, err := c.compile(n)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", src, err)
+	}
+	return &Program{src: src, eval: fn}, nil
+}
+
+func (p *Program) String() string {
+	return p.src
+}
+
+// Match returns whether the expression evaluates to true for res.
+func (p *Program) Match(res *tetragon.GetEventsResponse) bool {
+	if res == nil {
+		return false
+	}
+	v := p.eval(&env{res: res, msg: res.ProtoReflect()})
+	b, ok := v.(bool)
+	return ok && b
+}
+
+// EvalString evaluates the expression for res and returns its value as a
+// string. Numbers and booleans are formatted and enums are converted to their
+// name. ok is false if the value is null, a message, a list or a map.
+func (p *Program) EvalString(res *tetragon.GetEventsResponse) (s string, ok bool) {
+	if res == nil {
+		return "", false
+	}
+	switch v := p.eval(&env{res: res, msg: res.ProtoReflect()}).(type) {
+	case string:
+		return v, true
+	case enumValue:
+		return v.name(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case uint64:
+		return strconv.FormatUint(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), true
+	}
+	return "", false
+}
+
+// EvalNumber evaluates the expression for res and returns its value as a
+// number. ok is false if the value is not a number.
+func (p *Program) EvalNumber(res *tetragon.GetEventsResponse) (f float64, ok bool) {
+	if res == nil {
+		return 0, false
+	}
+	switch v := p.eval(&env{res: res, msg: res.ProtoReflect()}).(type) {
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+type compiler struct {
+	scope []scopeVar
+}
+
+func (c *compiler) compile(n node) (evalFn, *typ, error) {
+	switch n := n.(type) {
+	case *literalNode:
+		v := n.val
+		return func(*env) value { return v }, nil, nil
+	case *listNode:
+		return c.compileList(n)
+	case *identNode:
+		return c.compileIdent(n)
+	case *selectNode:
+		return c.compileSelect(n)
+	case *indexNode:
+		return c.compileIndex(n)
+	case *callNode:
+		return c.compileCall(n)
+	case *unaryNode:
+		return c.compileUnary(n)
+	case *binaryNode:
+		return c.compileBinary(n)
+	}
+	return nil, nil, fmt.Errorf("unsupported expression %T", n)
+}
+
+func (c *compiler) compileList(n *listNode) (evalFn, *typ, error) {
+	fns := make([]evalFn, 0, len(n.elems))
+	for _, elem := range n.elems {
+		fn, _, err := c.compile(elem)
+		if err != nil {
+			return nil, nil, err
+		}
+		fns = append(fns, fn)
+	}
+	return func(e *env) value {
+		l := make([]value, 0, len(fns))
+		for _, fn := range fns {
+			l = append(l, fn(e))
+		}
+		return l
+	}, nil, nil
+}
+
+func (c *compiler) compileIdent(n *identNode) (evalFn, *typ, error) {
+	for i := len(c.scope) - 1; i >= 0; i-- {
+		if c.scope[i].name == n.name {
+			idx := i
+			return func(e *env) value { return e.vars[idx] }, c.scope[i].typ, nil
+		}
+	}
+	switch n.name {
+	case "process":
+		return func(e *env) value {
+			if p := helpers.ResponseGetProcess(e.res); p != nil {
+				return p.ProtoReflect()
+			}
+			return nil
+		}, &typ{msg: processDesc}, nil
+	case "parent":
+		return func(e *env) value {
+			if p := helpers.ResponseGetParent(e.res); p != nil {
+				return p.ProtoReflect()
+			}
+			return nil
+		}, &typ{msg: processDesc}, nil
+	case "event_type":
+		return func(e *env) value {
+			t, err := helpers.ResponseTypeString(e.res)
+			if err != nil {
+				return nil
+			}
+			return t
+		}, &typ{scalar: true}, nil
+	}
+	fd := responseDesc.Fields().ByName(protoreflect.Name(n.name))
+	if fd == nil {
+		return nil, nil, fmt.Errorf("unknown identifier %q", n.name)
+	}
+	return func(e *env) value { return fieldValue(e.msg, fd) }, fieldType(fd), nil
+}
+
+func (c *compiler) compileSelect(n *selectNode) (evalFn, *typ, error) {
+	operand, t, err := c.compile(n.operand)
+	if err != nil {
+		return nil, nil, err
+	}
+	name := protoreflect.Name(n.field)
+	if t != nil {
+		if t.list || t.mapv || t.scalar || t.msg == nil {
+			return nil, nil, fmt.Errorf("cannot access field %q of a non-message value", n.field)
+		}
+		fd := t.msg.Fields().ByName(name)
+		if fd == nil {
+			return nil, nil, fmt.Errorf("no field %q in %s", n.field, t.msg.Name())
+		}
+		return func(e *env) value {
+			m, ok := operand(e).(protoreflect.Message)
+			if !ok {
+				return nil
+			}
+			return fieldValue(m, fd)
+		}, fieldType(fd), nil
+	}
+	return func(e *env) value {
+		m, ok := operand(e).(protoreflect.Message)
+		if !ok {
+			return nil
+		}
+		fd := m.Descriptor().Fields().ByName(name)
+		if fd == nil {
+			return nil
+		}
+		return fieldValue(m, fd)
+	}, nil, nil
+}
+
+func (c *compiler) compileIndex(n *indexNode) (evalFn, *typ, error) {
+	operand, t, err := c.compile(n.operand)
+	if err != nil {
+		return nil, nil, err
+	}
+	index, _, err := c.compile(n.index)
+	if err != nil {
+		return nil, nil, err
+	}
+	var elemType *typ
+	if t != nil {
+		if !t.list && !t.mapv {
+			return nil, nil, fmt.Errorf("cannot index a value that is not a list or a map")
+		}
+		elemType = elementType(t)
+	}
+	return func(e *env) value {
+		switch o := operand(e).(type) {
+		case listValue:
+			i, ok := toInt(index(e))
+			if !ok || i < 0 || i >= int64(o.list.Len()) {
+				return nil
+			}
+			return convert(o.fd, o.list.Get(int(i)))
+		case []value:
+			i, ok := toInt(index(e))
+			if !ok || i < 0 || i >= int64(len(o)) {
+				return nil
+			}
+			return o[i]
+		case mapValue:
+			key, ok := mapKey(o.fd, index(e))
+			if !ok || !o.m.Has(key) {
+				return nil
+			}
+			return convert(o.fd.MapValue(), o.m.Get(key))
+		}
+		return nil
+	}, elemType, nil
+}
+
+func (c *compiler) compileUnary(n *unaryNode) (evalFn, *typ, error) {
+	operand, _, err := c.compile(n.operand)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch n.op {
+	case "!":
+		return func(e *env) value {
+			if b, ok := operand(e).(bool); ok {
+				return !b
+			}
+			return nil
+		}, &typ{scalar: true}, nil
+	case "-":
+		return func(e *env) value {
+			switch v := operand(e).(type) {
+			case int64:
+				return -v
+			case float64:
+				return -v
+			}
+			return nil
+		}, &typ{scalar: true}, nil
+	}
+	return nil, nil, fmt.Errorf("unknown operator %q", n.op)
+}
+
+func (c *compiler) compileBinary(n *binaryNode) (evalFn, *typ, error) {
+	x, _, err := c.compile(n.x)
+	if err != nil {
+		return nil, nil, err
+	}
+	y, _, err := c.compile(n.y)
+	if err != nil {
+		return nil, nil, err
+	}
+	boolean := &typ{scalar: true}
+	switch n.op {
+	case "&&":
+		return func(e *env) value {
+			a, aok := x(e).(bool)
+			if aok && !a {
+				return false
+			}
+			b, bok := y(e).(bool)
+			if bok && !b {
+				return false
+			}
+			if aok && bok {
+				return true
+			}
+			return nil
+		}, boolean, nil
+	case "||":
+		return func(e *env) value {
+			a, aok := x(e).(bool)
+			if aok && a {
+				return true
+			}
+			b, bok := y(e).(bool)
+			if bok && b {
+				return true
+			}
+			if aok && bok {
+				return false
+			}
+			return nil
+		}, boolean, nil
+	case "==":
+		return func(e *env) value { return equal(x(e), y(e)) }, boolean, nil
+	case "!=":
+		return func(e *env) value { return !equal(x(e), y(e)) }, boolean, nil
+	case "<", "<=", ">", ">=":
+		op := n.op
+		return func(e *env) value {
+			cmp, ok := compare(x(e), y(e))
+			if !ok {
+				return nil
+			}
+			switch op {
+			case "<":
+				return cmp < 0
+			case "<=":
+				return cmp <= 0
+			case ">":
+				return cmp > 0
+			default:
+				return cmp >= 0
+			}
+		}, boolean, nil
+	case "in":
+		return func(e *env) value { return contains(y(e), x(e)) }, boolean, nil
+	}
+	return nil, nil, fmt.Errorf("unknown operator %q", n.op)
+}
+
+func (c *compiler) compileCall(n *callNode) (evalFn, *typ, error) {
+	if n.target == nil {
+		return c.compileFunction(n)
+	}
+	switch n.fn {
+	case "exists", "all":
+		return c.compileMacro(n)
+	case "matches":
+		return c.compileMatches(n)
+	case "startsWith", "endsWith", "contains":
+		target, _, err := c.compile(n.target)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(n.args) != 1 {
+			return nil, nil, fmt.Errorf("%s() takes exactly one argument", n.fn)
+		}
+		arg, _, err := c.compile(n.args[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		f := map[string]func(string, string) bool{
+			"startsWith": strings.HasPrefix,
+			"endsWith":   strings.HasSuffix,
+			"contains":   strings.Contains,
+		}[n.fn]
+		return func(e *env) value {
+			s, ok := toString(target(e))
+			if !ok {
+				return nil
+			}
+			a, ok := toString(arg(e))
+			if !ok {
+				return nil
+			}
+			return f(s, a)
+		}, &typ{scalar: true}, nil
+	}
+	return nil, nil, fmt.Errorf("unknown method %q", n.fn)
+}
+
+func (c *compiler) compileFunction(n *callNode) (evalFn, *typ, error) {
+	if len(n.args) != 1 {
+		return nil, nil, fmt.Errorf("%s() takes exactly one argument", n.fn)
+	}
+	switch n.fn {
+	case "has":
+		sel, ok := n.args[0].(*selectNode)
+		if !ok {
+			return nil, nil, fmt.Errorf("has() argument must be a field selection")
+		}
+		fn, _, err := c.compile(sel)
+		if err != nil {
+			return nil, nil, err
+		}
+		return func(e *env) value {
+			switch v := fn(e).(type) {
+			case nil:
+				return false
+			case listValue:
+				return v.list.Len() > 0
+			case mapValue:
+				return v.m.Len() > 0
+			}
+			return true
+		}, &typ{scalar: true}, nil
+	}
+
+	arg, _, err := c.compile(n.args[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	switch n.fn {
+	case "size":
+		return func(e *env) value {
+			switch v := arg(e).(type) {
+			case string:
+				return int64(len(v))
+			case listValue:
+				return int64(v.list.Len())
+			case mapValue:
+				return int64(v.m.Len())
+			case []value:
+				return int64(len(v))
+			}
+			return nil
+		}, &typ{scalar: true}, nil
+	case "string":
+		return func(e *env) value {
+			switch v := arg(e).(type) {
+			case nil:
+				return nil
+			case enumValue:
+				return v.name()
+			case string:
+				return v
+			case bool, int64, uint64, float64:
+				return fmt.Sprint(v)
+			}
+			return nil
+		}, &typ{scalar: true}, nil
+	case "int":
+		return func(e *env) value {
+			if i, ok := toInt(arg(e)); ok {
+				return i
+			}
+			return nil
+		}, &typ{scalar: true}, nil
+	}
+	return nil, nil, fmt.Errorf("unknown function %q", n.fn)
+}
+
+func (c *compiler) compileMatches(n *callNode) (evalFn, *typ, error) {
+	target, _, err := c.compile(n.target)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(n.args) != 1 {
+		return nil, nil, fmt.Errorf("matches() takes exactly one argument")
+	}
+	lit, ok := n.args[0].(*literalNode)
+	if !ok {
+		return nil, nil, fmt.Errorf("matches() argument must be a string literal")
+	}
+	pattern, ok := lit.val.(string)
+	if !ok {
+		return nil, nil, fmt.Errorf("matches() argument must be a string literal")
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, nil, err
+	}
+	return func(e *env) value {
+		s, ok := toString(target(e))
+		if !ok {
+			return nil
+		}
+		return re.MatchString(s)
+	}, &typ{scalar: true}, nil
+}
+
+// compileMacro compiles l.exists(x, p) and l.all(x, p).
+func (c *compiler) compileMacro(n *callNode) (evalFn, *typ, error) {
+	if len(n.args) != 2 {
+		return nil, nil, fmt.Errorf("%s() takes exactly two arguments", n.fn)
+	}
+	ident, ok := n.args[0].(*identNode)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s() first argument must be an identifier", n.fn)
+	}
+	target, t, err := c.compile(n.target)
+	if err != nil {
+		return nil, nil, err
+	}
+	var elemType *typ
+	if t != nil {
+		if !t.list && !t.mapv {
+			return nil, nil, fmt.Errorf("%s() can only be applied to lists and maps", n.fn)
+		}
+		if t.list {
+			elemType = elementType(t)
+		} else {
+			// maps are iterated over their keys
+			elemType = &typ{scalar: true}
+		}
+	}
+	c.scope = append(c.scope, scopeVar{ident.name, elemType})
+	idx := len(c.scope) - 1
+	pred, _, err := c.compile(n.args[1])
+	c.scope = c.scope[:idx]
+	if err != nil {
+		return nil, nil, err
+	}
+
+	all := n.fn == "all"
+	return func(e *env) value {
+		var elems []value
+		switch v := target(e).(type) {
+		case listValue:
+			for i := 0; i < v.list.Len(); i++ {
+				elems = append(elems, convert(v.fd, v.list.Get(i)))
+			}
+		case mapValue:
+			v.m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+				elems = append(elems, convert(v.fd.MapKey(), k.Value()))
+				return true
+			})
+		case []value:
+			elems = v
+		default:
+			return nil
+		}
+
+		vars := e.vars
+		defer func() { e.vars = vars }()
+		e.vars = append(vars[:idx:idx], nil)
+		for _, elem := range elems {
+			e.vars[idx] = elem
+			b, ok := pred(e).(bool)
+			if all && (!ok || !b) {
+				return false
+			}
+			if !all && ok && b {
+				return true
+			}
+		}
+		return all
+	}, &typ{scalar: true}, nil
+}
+
+func isWrapper(md protoreflect.MessageDescriptor) bool {
+	return md.ParentFile().Package() == "google.protobuf" && strings.HasSuffix(string(md.Name()), "Value")
+}
+
+func fieldType(fd protoreflect.FieldDescriptor) *typ {
+	switch {
+	case fd.IsMap():
+		return &typ{mapv: true, msg: fd.MapValue().Message()}
+	case fd.IsList():
+		return &typ{list: true, msg: fd.Message()}
+	case fd.Message() != nil && !isWrapper(fd.Message()):
+		return &typ{msg: fd.Message()}
+	}
+	return &typ{scalar: true}
+}
+
+// elementType returns the type of the elements of the list or map type t.
+func elementType(t *typ) *typ {
+	if t.msg != nil && !isWrapper(t.msg) {
+		return &typ{msg: t.msg}
+	}
+	return &typ{scalar: true}
+}
+
+// fieldValue returns the value of the field fd of m. Unset message fields
+// and unset members of a oneof are null.
+func fieldValue(m protoreflect.Message, fd protoreflect.FieldDescriptor) value {
+	switch {
+	case fd.IsMap():
+		return mapValue{m.Get(fd).Map(), fd}
+	case fd.IsList():
+		return listValue{m.Get(fd).List(), fd}
+	case (fd.Message() != nil || fd.ContainingOneof() != nil) && !m.Has(fd):
+		return nil
+	}
+	return convert(fd, m.Get(fd))
+}
+
+// convert returns the value of v, a singular value of field fd.
+func convert(fd protoreflect.FieldDescriptor, v protoreflect.Value) value {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return v.Bool()
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return v.Int()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return v.Uint()
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return v.Float()
+	case protoreflect.StringKind:
+		return v.String()
+	case protoreflect.BytesKind:
+		return string(v.Bytes())
+	case protoreflect.EnumKind:
+		return enumValue{v.Enum(), fd.Enum()}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		m := v.Message()
+		if isWrapper(m.Descriptor()) {
+			inner := m.Descriptor().Fields().ByName("value")
+			return convert(inner, m.Get(inner))
+		}
+		return m
+	}
+	return nil
+}
+
+func mapKey(fd protoreflect.FieldDescriptor, v value) (protoreflect.MapKey, bool) {
+	kd := fd.MapKey()
+	switch kd.Kind() {
+	case protoreflect.StringKind:
+		if s, ok := v.(string); ok {
+			return protoreflect.ValueOfString(s).MapKey(), true
+		}
+	case protoreflect.BoolKind:
+		if b, ok := v.(bool); ok {
+			return protoreflect.ValueOfBool(b).MapKey(), true
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if i, ok := toInt(v); ok {
+			return protoreflect.ValueOfInt32(int32(i)).MapKey(), true
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if i, ok := toInt(v); ok {
+			return protoreflect.ValueOfInt64(i).MapKey(), true
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if i, ok := toInt(v); ok && i >= 0 {
+			return protoreflect.ValueOfUint32(uint32(i)).MapKey(), true
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if i, ok := toInt(v); ok && i >= 0 {
+			return protoreflect.ValueOfUint64(uint64(i)).MapKey(), true
+		}
+	}
+	return protoreflect.MapKey{}, false
+}
+
+func toString(v value) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case enumValue:
+		return v.name(), true
+	}
+	return "", false
+}
+
+func toInt(v value) (int64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return v, true
+	case uint64:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	case enumValue:
+		return int64(v.num), true
+	}
+	return 0, false
+}
+
+// compare returns -1, 0 or 1 if a is lower than, equal to or greater than b,
+// and whether a and b are comparable.
+func compare(a, b value) (int, bool) {
+	if ea, ok := a.(enumValue); ok {
+		a = int64(ea.num)
+	}
+	if eb, ok := b.(enumValue); ok {
+		b = int64(eb.num)
+	}
+	switch a := a.(type) {
+	case string:
+		b, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(a, b), true
+	case bool:
+		b, ok := b.(bool)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case a == b:
+			return 0, true
+		case !a:
+			return -1, true
+		}
+		return 1, true
+	case int64:
+		switch b := b.(type) {
+		case int64:
+			return cmpInt(a, b), true
+		case uint64:
+			if a < 0 {
+				return -1, true
+			}
+			return cmpUint(uint64(a), b), true
+		case float64:
+			return cmpFloat(float64(a), b), true
+		}
+	case uint64:
+		switch b := b.(type) {
+		case int64:
+			if b < 0 {
+				return 1, true
+			}
+			return cmpUint(a, uint64(b)), true
+		case uint64:
+			return cmpUint(a, b), true
+		case float64:
+			return cmpFloat(float64(a), b), true
+		}
+	case float64:
+		switch b := b.(type) {
+		case int64:
+			return cmpFloat(a, float64(b)), true
+		case uint64:
+			return cmpFloat(a, float64(b)), true
+		case float64:
+			return cmpFloat(a, b), true
+		}
+	}
+	return 0, false
+}
+
+func cmpInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func cmpUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func equal(a, b value) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	// enums compare equal to their name
+	if ea, ok := a.(enumValue); ok {
+		if s, ok := b.(string); ok {
+			return ea.name() == s
+		}
+	}
+	if eb, ok := b.(enumValue); ok {
+		if s, ok := a.(string); ok {
+			return eb.name() == s
+		}
+	}
+	if ma, ok := a.(protoreflect.Message); ok {
+		mb, ok := b.(protoreflect.Message)
+		return ok && proto.Equal(ma.Interface(), mb.Interface())
+	}
+	cmp, ok := compare(a, b)
+	return ok && cmp == 0
+}
+
+// contains returns whether x is an element of the list c or a key of the
+// map c.
+func contains(c value, x value) value {
+	switch c := c.(type) {
+	case listValue:
+		for i := 0; i < c.list.Len(); i++ {
+			if equal(convert(c.fd, c.list.Get(i)), x) {
+				return true
+			}
+		}
+		return false
+	case []value:
+		for _, elem := range c {
+			if equal(elem, x) {
+				return true
+			}
+		}
+		return false
+	case mapValue:
+		key, ok := mapKey(c.fd, x)
+		return ok && c.m.Has(key)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package expr
+
+import (
+	"testing"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func kprobeEvent() *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{
+			ProcessKprobe: &tetragon.ProcessKprobe{
+				Process: &tetragon.Process{
+					Binary: "/usr/bin/curl",
+					Uid:    &wrapperspb.UInt32Value{Value: 0},
+					Pod: &tetragon.Pod{
+						Namespace: "default",
+						PodLabels: map[string]string{"app": "web"},
+					},
+					Cap: &tetragon.Capabilities{
+						Effective: []tetragon.CapabilitiesType{tetragon.CapabilitiesType_CAP_SYS_ADMIN},
+					},
+				},
+				Parent: &tetragon.Process{
+					Binary: "/bin/bash",
+				},
+				FunctionName: "tcp_connect",
+				Args: []*tetragon.KprobeArgument{
+					{Arg: &tetragon.KprobeArgument_StringArg{StringArg: "foo"}},
+					{Arg: &tetragon.KprobeArgument_IntArg{IntArg: 42}},
+				},
+				Action: tetragon.KprobeAction_KPROBE_ACTION_SIGKILL,
+			},
+		},
+		NodeName: "node-1",
+	}
+}
+
+func execEvent() *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{
+			ProcessExec: &tetragon.ProcessExec{
+				Process: &tetragon.Process{
+					Binary: "/usr/bin/curl",
+					Uid:    &wrapperspb.UInt32Value{Value: 1000},
+				},
+			},
+		},
+		NodeName: "node-1",
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		expr   string
+		kprobe bool
+		exec   bool
+	}{
+		{`process_kprobe.function_name == "tcp_connect"`, true, false},
+		{`process_kprobe.function_name != "tcp_connect"`, false, true},
+		{`event_type == "PROCESS_EXEC"`, false, true},
+		{`process.binary == "/usr/bin/curl"`, true, true},
+		{`process.uid == 0`, true, false},
+		{`process.uid >= 1000u`, false, true},
+		{`process.uid > 0 && process.uid < 65535`, false, true},
+		{`parent.binary.endsWith("/bash")`, true, false},
+		{`process.binary.startsWith("/usr/") && process.binary.contains("curl")`, true, true},
+		{`process.binary.matches("^/usr/(s)?bin/")`, true, true},
+		{`process_kprobe.args.exists(a, a.int_arg == 42)`, true, false},
+		{`process_kprobe.args.exists(a, a.int_arg == 0)`, false, false},
+		{`process_kprobe.args.all(a, has(a.string_arg))`, false, false},
+		{`process_kprobe.args[0].string_arg == "foo"`, true, false},
+		{`size(process_kprobe.args) == 2`, true, false},
+		{`process_kprobe.action == "KPROBE_ACTION_SIGKILL"`, true, false},
+		{`string(process_kprobe.action) == "KPROBE_ACTION_SIGKILL"`, true, false},
+		{`"CAP_SYS_ADMIN" in process.cap.effective`, true, false},
+		{`process.pod.pod_labels["app"] == "web"`, true, false},
+		{`"app" in process.pod.pod_labels`, true, false},
+		{`process.pod.namespace in ["default", "kube-system"]`, true, false},
+		{`has(process.pod)`, true, false},
+		{`!has(process.pod)`, false, true},
+		{`has(process_exec.process)`, false, true},
+		{`node_name == "node-1" || false`, true, true},
+		{`!(process.uid == 0)`, false, true},
+		{`process_kprobe.function_name == null`, false, true},
+		{`process.binary != "/usr/bin/cürl"`, true, true},
+	}
+	for _, tt := range tests {
+		p, err := Compile(tt.expr)
+		require.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.kprobe, p.Match(kprobeEvent()), "%s on kprobe event", tt.expr)
+		assert.Equal(t, tt.exec, p.Match(execEvent()), "%s on exec event", tt.expr)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, src := range []string{
+		``,
+		`process.`,
+		`process.binary ==`,
+		`(process.binary == "a"`,
+		`"unterminated`,
+		`process.nosuchfield == 1`,
+		`nosuchvariable == 1`,
+		`process.binary.foo == 1`,
+		`process_kprobe.args.function_name == "a"`,
+		`process.binary.matches(process.binary)`,
+		`process.binary.matches("(")`,
+		`process_kprobe.args.exists(1, true)`,
+		`has(process)`,
+		`unknown(process)`,
+		`process.binary.unknown()`,
+		`process.binary # 1`,
+		`process.bïnary == "a"`,
+		`process.binary == "a" && π`,
+		`process.binary == ٣`,
+		"process.binary == \"\xff\" \xff",
+	} {
+		_, err := Compile(src)
+		assert.Error(t, err, src)
+	}
+}
+
+func TestEval(t *testing.T) {
+	strs := []struct {
+		expr string
+		want string
+		ok   bool
+	}{
+		{`process.binary`, "/usr/bin/curl", true},
+		{`process.uid`, "0", true},
+		{`process_kprobe.args[1].int_arg`, "42", true},
+		{`process_kprobe.action`, "KPROBE_ACTION_SIGKILL", true},
+		{`process.uid == 0`, "true", true},
+		{`process.pod`, "", false},
+		{`process_exec.process.binary`, "", false},
+	}
+	for _, tc := range strs {
+		p, err := Compile(tc.expr)
+		require.NoError(t, err, tc.expr)
+		s, ok := p.EvalString(kprobeEvent())
+		assert.Equal(t, tc.ok, ok, tc.expr)
+		assert.Equal(t, tc.want, s, tc.expr)
+	}
+
+	nums := []struct {
+		expr string
+		want float64
+		ok   bool
+	}{
+		{`process_kprobe.args[1].int_arg`, 42, true},
+		{`process.uid`, 0, true},
+		{`size(process_kprobe.args)`, 2, true},
+		{`process.binary`, 0, false},
+	}
+	for _, tc := range nums {
+		p, err := Compile(tc.expr)
+		require.NoError(t, err, tc.expr)
+		f, ok := p.EvalNumber(kprobeEvent())
+		assert.Equal(t, tc.ok, ok, tc.expr)
+		assert.Equal(t, tc.want, f, tc.expr)
+	}
+}
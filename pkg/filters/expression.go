@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/filters/expr"
+)
+
+func filterByExpression(prog *expr.Program) hubbleFilters.FilterFunc {
+	return func(ev *v1.Event) bool {
+		response, ok := ev.Event.(*tetragon.GetEventsResponse)
+		if !ok {
+			return false
+		}
+		return prog.Match(response)
+	}
+}
+
+// ExpressionFilter matches events for which the filter expression evaluates
+// to true. The expression is compiled once when the filter is built.
+type ExpressionFilter struct{}
+
+func (f *ExpressionFilter) OnBuildFilter(_ context.Context, ff *tetragon.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.Expression != "" {
+		prog, err := expr.Compile(ff.Expression)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, filterByExpression(prog))
+	}
+	return fs, nil
+}
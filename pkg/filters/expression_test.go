@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestExpressionFilter(t *testing.T) {
+	f, err := ParseFilterList(`{"expression":"process_kprobe.function_name == \"tcp_connect\" && process.uid == 0"}`)
+	require.NoError(t, err)
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&ExpressionFilter{}})
+	require.NoError(t, err)
+
+	kprobeEvent := func(function string, uid uint32) *v1.Event {
+		return &v1.Event{
+			Event: &tetragon.GetEventsResponse{
+				Event: &tetragon.GetEventsResponse_ProcessKprobe{
+					ProcessKprobe: &tetragon.ProcessKprobe{
+						Process:      &tetragon.Process{Uid: &wrapperspb.UInt32Value{Value: uid}},
+						FunctionName: function,
+					},
+				},
+			},
+		}
+	}
+	assert.True(t, fl.MatchOne(kprobeEvent("tcp_connect", 0)))
+	assert.False(t, fl.MatchOne(kprobeEvent("tcp_connect", 1000)))
+	assert.False(t, fl.MatchOne(kprobeEvent("tcp_close", 0)))
+
+	// other events never match
+	ev := v1.Event{
+		Event: &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessExec{
+				ProcessExec: &tetragon.ProcessExec{
+					Process: &tetragon.Process{Uid: &wrapperspb.UInt32Value{Value: 0}},
+				},
+			},
+		},
+	}
+	assert.False(t, fl.MatchOne(&ev))
+}
+
+func TestExpressionFilterInvalid(t *testing.T) {
+	f := []*tetragon.Filter{{Expression: "process.nosuchfield == 1"}}
+	_, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&ExpressionFilter{}})
+	assert.Error(t, err)
+}
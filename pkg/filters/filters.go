@@ -99,6 +99,13 @@ var Filters = []OnBuildFilter{
 	&ArgumentsRegexFilter{},
 	&LabelsFilter{},
 	&PodRegexFilter{},
+	&MountTargetPrefixFilter{},
+	&ExpressionFilter{},
+	&FunctionNameFilter{},
+	&PolicyNameFilter{},
+	&TracepointFilter{},
+	&KprobeActionFilter{},
+	&ArgFilter{},
 }
 
 func GetProcess(event *v1.Event) *tetragon.Process {
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+func filterByFunctionName(names []string) hubbleFilters.FilterFunc {
+	return func(ev *v1.Event) bool {
+		response, ok := ev.Event.(*tetragon.GetEventsResponse)
+		if !ok {
+			return false
+		}
+		kprobe := response.GetProcessKprobe()
+		if kprobe == nil {
+			return false
+		}
+		for _, name := range names {
+			if kprobe.FunctionName == name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// FunctionNameFilter matches process_kprobe events by kernel function name.
+// Other events never match.
+type FunctionNameFilter struct{}
+
+func (f *FunctionNameFilter) OnBuildFilter(_ context.Context, ff *tetragon.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.FunctionName != nil {
+		fs = append(fs, filterByFunctionName(ff.FunctionName))
+	}
+	return fs, nil
+}
+
+func filterByKprobeAction(actions []tetragon.KprobeAction) hubbleFilters.FilterFunc {
+	return func(ev *v1.Event) bool {
+		response, ok := ev.Event.(*tetragon.GetEventsResponse)
+		if !ok {
+			return false
+		}
+		kprobe := response.GetProcessKprobe()
+		if kprobe == nil {
+			return false
+		}
+		for _, action := range actions {
+			if kprobe.Action == action {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// KprobeActionFilter matches process_kprobe events by action. Other events
+// never match.
+type KprobeActionFilter struct{}
+
+func (f *KprobeActionFilter) OnBuildFilter(_ context.Context, ff *tetragon.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.KprobeAction != nil {
+		fs = append(fs, filterByKprobeAction(ff.KprobeAction))
+	}
+	return fs, nil
+}
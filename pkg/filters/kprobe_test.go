@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+)
+
+func kprobeEvent(kprobe *tetragon.ProcessKprobe) *v1.Event {
+	return &v1.Event{
+		Event: &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: kprobe},
+		},
+	}
+}
+
+func tracepointEvent(tp *tetragon.ProcessTracepoint) *v1.Event {
+	return &v1.Event{
+		Event: &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessTracepoint{ProcessTracepoint: tp},
+		},
+	}
+}
+
+var execEvent = &v1.Event{
+	Event: &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{
+			ProcessExec: &tetragon.ProcessExec{Process: &tetragon.Process{Binary: "/bin/ls"}},
+		},
+	},
+}
+
+func TestFunctionNameFilter(t *testing.T) {
+	f := []*tetragon.Filter{{FunctionName: []string{"tcp_connect", "tcp_close"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&FunctionNameFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent(&tetragon.ProcessKprobe{FunctionName: "tcp_connect"})))
+	assert.True(t, fl.MatchOne(kprobeEvent(&tetragon.ProcessKprobe{FunctionName: "tcp_close"})))
+	assert.False(t, fl.MatchOne(kprobeEvent(&tetragon.ProcessKprobe{FunctionName: "tcp_sendmsg"})))
+	assert.False(t, fl.MatchOne(execEvent))
+}
+
+func TestKprobeActionFilter(t *testing.T) {
+	f := []*tetragon.Filter{{KprobeAction: []tetragon.KprobeAction{tetragon.KprobeAction_KPROBE_ACTION_SIGKILL}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&KprobeActionFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent(&tetragon.ProcessKprobe{Action: tetragon.KprobeAction_KPROBE_ACTION_SIGKILL})))
+	assert.False(t, fl.MatchOne(kprobeEvent(&tetragon.ProcessKprobe{Action: tetragon.KprobeAction_KPROBE_ACTION_POST})))
+	assert.False(t, fl.MatchOne(execEvent))
+}
+
+func TestPolicyNameFilter(t *testing.T) {
+	f := []*tetragon.Filter{{PolicyName: []string{"fd-install"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&PolicyNameFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(kprobeEvent(&tetragon.ProcessKprobe{PolicyName: "fd-install"})))
+	assert.True(t, fl.MatchOne(tracepointEvent(&tetragon.ProcessTracepoint{PolicyName: "fd-install"})))
+	assert.False(t, fl.MatchOne(kprobeEvent(&tetragon.ProcessKprobe{PolicyName: "other"})))
+	assert.False(t, fl.MatchOne(tracepointEvent(&tetragon.ProcessTracepoint{})))
+	assert.False(t, fl.MatchOne(execEvent))
+}
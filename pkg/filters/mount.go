@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+func filterByMountTargetPrefix(prefixes []string) hubbleFilters.FilterFunc {
+	return func(ev *v1.Event) bool {
+		response, ok := ev.Event.(*tetragon.GetEventsResponse)
+		if !ok {
+			return false
+		}
+		mount := response.GetProcessMount()
+		if mount == nil {
+			return false
+		}
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(mount.Target, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// MountTargetPrefixFilter matches process_mount events whose target starts
+// with one of the given prefixes. Other events never match.
+type MountTargetPrefixFilter struct{}
+
+func (f *MountTargetPrefixFilter) OnBuildFilter(_ context.Context, ff *tetragon.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.MountTargetPrefix != nil {
+		fs = append(fs, filterByMountTargetPrefix(ff.MountTargetPrefix))
+	}
+	return fs, nil
+}
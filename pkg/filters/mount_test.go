@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMountTargetPrefixFilter(t *testing.T) {
+	f := []*tetragon.Filter{{MountTargetPrefix: []string{"/var/lib/kubelet/", "/mnt"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&MountTargetPrefixFilter{}})
+	assert.NoError(t, err)
+
+	mountEvent := func(target string) *v1.Event {
+		return &v1.Event{
+			Event: &tetragon.GetEventsResponse{
+				Event: &tetragon.GetEventsResponse_ProcessMount{
+					ProcessMount: &tetragon.ProcessMount{
+						Operation: tetragon.MountOperation_MOUNT_OPERATION_MOUNT,
+						Target:    target,
+					},
+				},
+			},
+		}
+	}
+	assert.True(t, fl.MatchOne(mountEvent("/var/lib/kubelet/pods/foo")))
+	assert.True(t, fl.MatchOne(mountEvent("/mnt")))
+	assert.True(t, fl.MatchOne(mountEvent("/mnt/data")))
+	assert.False(t, fl.MatchOne(mountEvent("/var/lib/docker")))
+	assert.False(t, fl.MatchOne(mountEvent("")))
+
+	// other events never match
+	ev := v1.Event{
+		Event: &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessExec{
+				ProcessExec: &tetragon.ProcessExec{
+					Process: &tetragon.Process{Cwd: "/mnt"},
+				},
+			},
+		},
+	}
+	assert.False(t, fl.MatchOne(&ev))
+}
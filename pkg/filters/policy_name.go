@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+func getPolicyName(response *tetragon.GetEventsResponse) (string, bool) {
+	switch ev := response.Event.(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		return ev.ProcessKprobe.PolicyName, true
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		return ev.ProcessTracepoint.PolicyName, true
+	}
+	return "", false
+}
+
+func filterByPolicyName(names []string) hubbleFilters.FilterFunc {
+	return func(ev *v1.Event) bool {
+		response, ok := ev.Event.(*tetragon.GetEventsResponse)
+		if !ok {
+			return false
+		}
+		policy, ok := getPolicyName(response)
+		if !ok {
+			return false
+		}
+		for _, name := range names {
+			if policy == name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// PolicyNameFilter matches process_kprobe and process_tracepoint events by
+// the name of the tracing policy that created the probe. Other events never
+// match.
+type PolicyNameFilter struct{}
+
+func (f *PolicyNameFilter) OnBuildFilter(_ context.Context, ff *tetragon.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.PolicyName != nil {
+		fs = append(fs, filterByPolicyName(ff.PolicyName))
+	}
+	return fs, nil
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/cilium/hubble/pkg/api/v1"
+	hubbleFilters "github.com/cilium/hubble/pkg/filters"
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+func filterByTracepoint(tracepoints []string) hubbleFilters.FilterFunc {
+	return func(ev *v1.Event) bool {
+		response, ok := ev.Event.(*tetragon.GetEventsResponse)
+		if !ok {
+			return false
+		}
+		tp := response.GetProcessTracepoint()
+		if tp == nil {
+			return false
+		}
+		for _, tracepoint := range tracepoints {
+			subsys, event, hasEvent := strings.Cut(tracepoint, "/")
+			if tp.Subsys == subsys && (!hasEvent || tp.Event == event) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// TracepointFilter matches process_tracepoint events by "subsys/event", or by
+// "subsys" alone. Other events never match.
+type TracepointFilter struct{}
+
+func (f *TracepointFilter) OnBuildFilter(_ context.Context, ff *tetragon.Filter) ([]hubbleFilters.FilterFunc, error) {
+	var fs []hubbleFilters.FilterFunc
+	if ff.Tracepoint != nil {
+		fs = append(fs, filterByTracepoint(ff.Tracepoint))
+	}
+	return fs, nil
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracepointFilter(t *testing.T) {
+	f := []*tetragon.Filter{{Tracepoint: []string{"syscalls/sys_enter_openat", "sched"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&TracepointFilter{}})
+	assert.NoError(t, err)
+	assert.True(t, fl.MatchOne(tracepointEvent(&tetragon.ProcessTracepoint{Subsys: "syscalls", Event: "sys_enter_openat"})))
+	assert.True(t, fl.MatchOne(tracepointEvent(&tetragon.ProcessTracepoint{Subsys: "sched", Event: "sched_process_fork"})))
+	assert.False(t, fl.MatchOne(tracepointEvent(&tetragon.ProcessTracepoint{Subsys: "syscalls", Event: "sys_enter_open"})))
+	assert.False(t, fl.MatchOne(tracepointEvent(&tetragon.ProcessTracepoint{Subsys: "raw_syscalls", Event: "sys_enter"})))
+	assert.False(t, fl.MatchOne(execEvent))
+}
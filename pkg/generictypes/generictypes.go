@@ -28,6 +28,11 @@ const (
 	GenericBpfAttr     = 19
 	GenericPerfEvent   = 20
 
+	// GenericSyscallId and GenericSyscallArgs are the id and args fields
+	// of the raw_syscalls/sys_enter tracepoint in syscall audit mode.
+	GenericSyscallId   = 21
+	GenericSyscallArgs = 22
+
 	GenericNopType     = -1
 	GenericInvalidType = -2
 )
@@ -42,9 +47,9 @@ func GenericTypeFromString(arg string) int {
 		return GenericU64Type
 	case "uint32":
 		return GenericU32Type
-	case "sint64":
+	case "sint64", "int64":
 		return GenericS64Type
-	case "sint32":
+	case "sint32", "int32":
 		return GenericS32Type
 	case "skb":
 		return GenericSkbType
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+package cred
+
+import (
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/eventcache"
+	"github.com/cilium/tetragon/pkg/ktime"
+	"github.com/cilium/tetragon/pkg/process"
+	"github.com/cilium/tetragon/pkg/reader/caps"
+	"github.com/cilium/tetragon/pkg/reader/node"
+	"github.com/cilium/tetragon/pkg/reader/notify"
+	"github.com/cilium/tetragon/pkg/syscallinfo"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	nodeName = node.GetNodeNameForExport()
+)
+
+type MsgCredChangeEventUnix struct {
+	processapi.MsgCredChangeEvent
+}
+
+func getCredentials(ids *processapi.MsgCredIds, c processapi.MsgCapabilities) *tetragon.ProcessCredentials {
+	return &tetragon.ProcessCredentials{
+		Uid:        &wrapperspb.UInt32Value{Value: ids.Uid},
+		Gid:        &wrapperspb.UInt32Value{Value: ids.Gid},
+		Euid:       &wrapperspb.UInt32Value{Value: ids.Euid},
+		Egid:       &wrapperspb.UInt32Value{Value: ids.Egid},
+		Suid:       &wrapperspb.UInt32Value{Value: ids.Suid},
+		Sgid:       &wrapperspb.UInt32Value{Value: ids.Sgid},
+		Fsuid:      &wrapperspb.UInt32Value{Value: ids.Fsuid},
+		Fsgid:      &wrapperspb.UInt32Value{Value: ids.Fsgid},
+		Caps:       caps.GetMsgCapabilities(c),
+		Securebits: ids.Securebits,
+	}
+}
+
+// GetProcessCredentialsChange returns the ProcessCredentialsChange protobuf
+// message for event, or nil if the event was deferred to the event cache.
+func GetProcessCredentialsChange(event *MsgCredChangeEventUnix) *tetragon.ProcessCredentialsChange {
+	var tetragonParent, tetragonProcess *tetragon.Process
+
+	proc, parent := process.GetParentProcessInternal(event.ProcessKey.Pid, event.ProcessKey.Ktime)
+	if proc == nil {
+		tetragonProcess = &tetragon.Process{
+			Pid:       &wrapperspb.UInt32Value{Value: event.ProcessKey.Pid},
+			StartTime: ktime.ToProto(event.ProcessKey.Ktime),
+		}
+	} else {
+		tetragonProcess = proc.UnsafeGetProcess()
+	}
+	if parent == nil {
+		tetragonParent = &tetragon.Process{}
+	} else {
+		tetragonParent = parent.GetProcessCopy()
+	}
+
+	syscall := ""
+	if event.Syscall >= 0 {
+		syscall = syscallinfo.GetSyscallName(int(event.Syscall))
+	}
+
+	tetragonEvent := &tetragon.ProcessCredentialsChange{
+		Process:        tetragonProcess,
+		Parent:         tetragonParent,
+		OldCredentials: getCredentials(&event.OldIds, event.OldCaps),
+		NewCredentials: getCredentials(&event.NewIds, event.NewCaps),
+		Syscall:        syscall,
+	}
+
+	ec := eventcache.Get()
+	if ec != nil &&
+		(ec.Needed(tetragonProcess) ||
+			(tetragonProcess.Pid.Value > 1 && ec.Needed(tetragonParent))) {
+		ec.Add(proc, tetragonEvent, event.ProcessKey.Ktime, event)
+		return nil
+	}
+
+	if proc != nil {
+		tetragonEvent.Process = proc.GetProcessCopy()
+	}
+	return tetragonEvent
+}
+
+func (msg *MsgCredChangeEventUnix) RetryInternal(ev notify.Event, timestamp uint64) (*process.ProcessInternal, error) {
+	return eventcache.HandleGenericInternal(ev, timestamp)
+}
+
+func (msg *MsgCredChangeEventUnix) Retry(internal *process.ProcessInternal, ev notify.Event) error {
+	return eventcache.HandleGenericEvent(internal, ev)
+}
+
+func (msg *MsgCredChangeEventUnix) HandleMessage() *tetragon.GetEventsResponse {
+	c := GetProcessCredentialsChange(msg)
+	if c == nil {
+		return nil
+	}
+	return &tetragon.GetEventsResponse{
+		Event:    &tetragon.GetEventsResponse_ProcessCredentialsChange{ProcessCredentialsChange: c},
+		NodeName: nodeName,
+		Time:     ktime.ToProto(msg.Common.Ktime),
+	}
+}
@@ -95,6 +95,14 @@ func (msg *MsgExecveEventUnix) Retry(internal *process.ProcessInternal, ev notif
 	// want to panic anyway to help us catch the bug faster. So no need to do a nil check
 	// here.
 	internal.AddPodInfo(podInfo)
+
+	// The binary hash is computed asynchronously. Wait for it before
+	// taking a copy of the process, if it takes too long the event cache
+	// gives up and sends the event without it.
+	if internal.BinaryHashPending() {
+		return eventcache.ErrBinaryHashPending
+	}
+
 	ev.SetProcess(internal.GetProcessCopy())
 
 	// Check we have a parent with exception for pid 1, note we do this last because we want
@@ -122,7 +130,8 @@ func (msg *MsgExecveEventUnix) HandleMessage() *tetragon.GetEventsResponse {
 		ec := eventcache.Get()
 		if ec != nil &&
 			(ec.Needed(procEvent.Process) ||
-				(procEvent.Process.Pid.Value > 1 && ec.Needed(procEvent.Parent))) {
+				(procEvent.Process.Pid.Value > 1 && ec.Needed(procEvent.Parent)) ||
+				proc.BinaryHashPending()) {
 			ec.Add(proc, procEvent, msg.MsgExecveEventUnix.Process.Ktime, msg)
 		} else {
 			procEvent.Process = proc.GetProcessCopy()
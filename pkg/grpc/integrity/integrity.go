@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+package integrity
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/eventcache"
+	"github.com/cilium/tetragon/pkg/ktime"
+	"github.com/cilium/tetragon/pkg/process"
+	"github.com/cilium/tetragon/pkg/reader/bpfattr"
+	"github.com/cilium/tetragon/pkg/reader/node"
+	"github.com/cilium/tetragon/pkg/reader/notify"
+	"github.com/cilium/tetragon/pkg/syscallinfo"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	nodeName = node.GetNodeNameForExport()
+)
+
+// cString returns the NUL terminated string at the start of b.
+func cString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
+
+// handleEvent fills in the process and parent of ev and wraps it in a
+// GetEventsResponse, or defers it to the event cache and returns nil if the
+// process information is not complete yet.
+func handleEvent(key *processapi.MsgExecveKey, common *processapi.MsgCommon, ev notify.Event, msg notify.Message) *tetragon.GetEventsResponse {
+	var tetragonParent, tetragonProcess *tetragon.Process
+
+	proc, parent := process.GetParentProcessInternal(key.Pid, key.Ktime)
+	if proc == nil {
+		tetragonProcess = &tetragon.Process{
+			Pid:       &wrapperspb.UInt32Value{Value: key.Pid},
+			StartTime: ktime.ToProto(key.Ktime),
+		}
+	} else {
+		tetragonProcess = proc.UnsafeGetProcess()
+	}
+	if parent == nil {
+		tetragonParent = &tetragon.Process{}
+	} else {
+		tetragonParent = parent.GetProcessCopy()
+	}
+	ev.SetProcess(tetragonProcess)
+	ev.SetParent(tetragonParent)
+
+	ec := eventcache.Get()
+	if ec != nil &&
+		(ec.Needed(tetragonProcess) ||
+			(tetragonProcess.Pid.Value > 1 && ec.Needed(tetragonParent))) {
+		ec.Add(proc, ev, key.Ktime, msg)
+		return nil
+	}
+
+	if proc != nil {
+		ev.SetProcess(proc.GetProcessCopy())
+	}
+	return &tetragon.GetEventsResponse{
+		Event:    ev.Encapsulate(),
+		NodeName: nodeName,
+		Time:     ktime.ToProto(common.Ktime),
+	}
+}
+
+type MsgKmodLoadEventUnix struct {
+	processapi.MsgKmodLoadEvent
+}
+
+func GetKernelModule(msg *processapi.MsgKmodLoadEvent) *tetragon.KernelModule {
+	module := &tetragon.KernelModule{
+		Name:   cString(msg.Name[:]),
+		Taints: msg.Taints,
+	}
+	switch {
+	case msg.SigKnown == 0:
+		module.Signature = tetragon.KernelModuleSignature_KERNEL_MODULE_SIGNATURE_UNKNOWN
+	case msg.SigOk != 0:
+		module.Signature = tetragon.KernelModuleSignature_KERNEL_MODULE_SIGNATURE_OK
+	default:
+		module.Signature = tetragon.KernelModuleSignature_KERNEL_MODULE_SIGNATURE_UNVERIFIED
+	}
+	return module
+}
+
+func (msg *MsgKmodLoadEventUnix) RetryInternal(ev notify.Event, timestamp uint64) (*process.ProcessInternal, error) {
+	return eventcache.HandleGenericInternal(ev, timestamp)
+}
+
+func (msg *MsgKmodLoadEventUnix) Retry(internal *process.ProcessInternal, ev notify.Event) error {
+	return eventcache.HandleGenericEvent(internal, ev)
+}
+
+func (msg *MsgKmodLoadEventUnix) HandleMessage() *tetragon.GetEventsResponse {
+	syscall := ""
+	if msg.Syscall >= 0 {
+		syscall = syscallinfo.GetSyscallName(int(msg.Syscall))
+	}
+	ev := &tetragon.ProcessKernelModuleLoad{
+		Module:  GetKernelModule(&msg.MsgKmodLoadEvent),
+		Syscall: syscall,
+	}
+	return handleEvent(&msg.ProcessKey, &msg.Common, ev, msg)
+}
+
+type MsgBpfProgLoadEventUnix struct {
+	processapi.MsgBpfProgLoadEvent
+}
+
+func (msg *MsgBpfProgLoadEventUnix) RetryInternal(ev notify.Event, timestamp uint64) (*process.ProcessInternal, error) {
+	return eventcache.HandleGenericInternal(ev, timestamp)
+}
+
+func (msg *MsgBpfProgLoadEventUnix) Retry(internal *process.ProcessInternal, ev notify.Event) error {
+	return eventcache.HandleGenericEvent(internal, ev)
+}
+
+func (msg *MsgBpfProgLoadEventUnix) HandleMessage() *tetragon.GetEventsResponse {
+	ev := &tetragon.ProcessBpfProgLoad{
+		ProgType:   bpfattr.GetProgType(msg.ProgType),
+		Name:       cString(msg.Name[:]),
+		InsnCnt:    msg.InsnCnt,
+		AttachType: bpfattr.GetAttachType(msg.AttachType),
+		Tag:        hex.EncodeToString(msg.Tag[:]),
+		Id:         msg.Id,
+	}
+	return handleEvent(&msg.ProcessKey, &msg.Common, ev, msg)
+}
+
+type MsgBpfMapCreateEventUnix struct {
+	processapi.MsgBpfMapCreateEvent
+}
+
+func (msg *MsgBpfMapCreateEventUnix) RetryInternal(ev notify.Event, timestamp uint64) (*process.ProcessInternal, error) {
+	return eventcache.HandleGenericInternal(ev, timestamp)
+}
+
+func (msg *MsgBpfMapCreateEventUnix) Retry(internal *process.ProcessInternal, ev notify.Event) error {
+	return eventcache.HandleGenericEvent(internal, ev)
+}
+
+func (msg *MsgBpfMapCreateEventUnix) HandleMessage() *tetragon.GetEventsResponse {
+	ev := &tetragon.ProcessBpfMapCreate{
+		MapType:    bpfattr.GetMapType(msg.MapType),
+		Name:       cString(msg.Name[:]),
+		KeySize:    msg.KeySize,
+		ValueSize:  msg.ValueSize,
+		MaxEntries: msg.MaxEntries,
+		MapFlags:   msg.MapFlags,
+	}
+	return handleEvent(&msg.ProcessKey, &msg.Common, ev, msg)
+}
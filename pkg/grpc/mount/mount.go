@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+package mount
+
+import (
+	"bytes"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/eventcache"
+	"github.com/cilium/tetragon/pkg/ktime"
+	"github.com/cilium/tetragon/pkg/process"
+	"github.com/cilium/tetragon/pkg/reader/namespace"
+	"github.com/cilium/tetragon/pkg/reader/node"
+	"github.com/cilium/tetragon/pkg/reader/notify"
+	"github.com/cilium/tetragon/pkg/reader/path"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	nodeName = node.GetNodeNameForExport()
+)
+
+type MsgMountEventUnix struct {
+	processapi.MsgMountEvent
+}
+
+// pathString returns the first n bytes of b, capped to the size of b.
+func pathString(b []byte, n uint32) string {
+	if int(n) > len(b) {
+		n = uint32(len(b))
+	}
+	return string(b[:n])
+}
+
+// cString returns the NUL terminated string at the start of b.
+func cString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
+
+// GetProcessMount returns the ProcessMount protobuf message for event, or
+// nil if the event was deferred to the event cache.
+func GetProcessMount(event *MsgMountEventUnix) *tetragon.ProcessMount {
+	var tetragonParent, tetragonProcess *tetragon.Process
+
+	proc, parent := process.GetParentProcessInternal(event.ProcessKey.Pid, event.ProcessKey.Ktime)
+	if proc == nil {
+		tetragonProcess = &tetragon.Process{
+			Pid:       &wrapperspb.UInt32Value{Value: event.ProcessKey.Pid},
+			StartTime: ktime.ToProto(event.ProcessKey.Ktime),
+		}
+	} else {
+		tetragonProcess = proc.UnsafeGetProcess()
+	}
+	if parent == nil {
+		tetragonParent = &tetragon.Process{}
+	} else {
+		tetragonParent = parent.GetProcessCopy()
+	}
+
+	tetragonEvent := &tetragon.ProcessMount{
+		Process:     tetragonProcess,
+		Parent:      tetragonParent,
+		Operation:   tetragon.MountOperation(event.Op),
+		Source:      pathString(event.Source[:], event.SourceLen),
+		Target:      pathString(event.Target[:], event.TargetLen),
+		TargetFlags: path.FilePathFlagsToStr(event.TargetFlags),
+		Fstype:      cString(event.Fstype[:]),
+		Flags:       event.Flags,
+		MntNamespace: &tetragon.Namespace{
+			Inum:   event.MntNs,
+			IsHost: namespace.GetHostNamespace().Mnt.Inum == event.MntNs,
+		},
+	}
+
+	ec := eventcache.Get()
+	if ec != nil &&
+		(ec.Needed(tetragonProcess) ||
+			(tetragonProcess.Pid.Value > 1 && ec.Needed(tetragonParent))) {
+		ec.Add(proc, tetragonEvent, event.ProcessKey.Ktime, event)
+		return nil
+	}
+
+	if proc != nil {
+		tetragonEvent.Process = proc.GetProcessCopy()
+	}
+	return tetragonEvent
+}
+
+func (msg *MsgMountEventUnix) RetryInternal(ev notify.Event, timestamp uint64) (*process.ProcessInternal, error) {
+	return eventcache.HandleGenericInternal(ev, timestamp)
+}
+
+func (msg *MsgMountEventUnix) Retry(internal *process.ProcessInternal, ev notify.Event) error {
+	return eventcache.HandleGenericEvent(internal, ev)
+}
+
+func (msg *MsgMountEventUnix) HandleMessage() *tetragon.GetEventsResponse {
+	m := GetProcessMount(msg)
+	if m == nil {
+		return nil
+	}
+	return &tetragon.GetEventsResponse{
+		Event:    &tetragon.GetEventsResponse_ProcessMount{ProcessMount: m},
+		NodeName: nodeName,
+		Time:     ktime.ToProto(msg.Common.Ktime),
+	}
+}
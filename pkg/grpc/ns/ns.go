@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+package ns
+
+import (
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/eventcache"
+	"github.com/cilium/tetragon/pkg/ktime"
+	"github.com/cilium/tetragon/pkg/process"
+	"github.com/cilium/tetragon/pkg/reader/namespace"
+	"github.com/cilium/tetragon/pkg/reader/node"
+	"github.com/cilium/tetragon/pkg/reader/notify"
+	"github.com/cilium/tetragon/pkg/syscallinfo"
+	"golang.org/x/sys/unix"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	nodeName = node.GetNodeNameForExport()
+)
+
+type MsgNsChangeEventUnix struct {
+	processapi.MsgNsChangeEvent
+}
+
+// GetChangeFlags returns the CLONE_NEW* flags of the namespaces that differ
+// between o and n. A change of the pid or time namespace of the process
+// itself is reported the same way as a change of the corresponding
+// namespace for children.
+func GetChangeFlags(o, n *processapi.MsgNamespaces) uint32 {
+	changes := []struct {
+		changed bool
+		flag    uint32
+	}{
+		{o.UtsInum != n.UtsInum, unix.CLONE_NEWUTS},
+		{o.IpcInum != n.IpcInum, unix.CLONE_NEWIPC},
+		{o.MntInum != n.MntInum, unix.CLONE_NEWNS},
+		{o.PidInum != n.PidInum || o.PidChildInum != n.PidChildInum, unix.CLONE_NEWPID},
+		{o.NetInum != n.NetInum, unix.CLONE_NEWNET},
+		{o.TimeInum != n.TimeInum || o.TimeChildInum != n.TimeChildInum, unix.CLONE_NEWTIME},
+		{o.CgroupInum != n.CgroupInum, unix.CLONE_NEWCGROUP},
+		{o.UserInum != n.UserInum, unix.CLONE_NEWUSER},
+	}
+	flags := uint32(0)
+	for _, c := range changes {
+		if c.changed {
+			flags |= c.flag
+		}
+	}
+	return flags
+}
+
+// GetProcessNamespaceChange returns the ProcessNamespaceChange protobuf
+// message for event, or nil if the event was deferred to the event cache.
+func GetProcessNamespaceChange(event *MsgNsChangeEventUnix) *tetragon.ProcessNamespaceChange {
+	var tetragonParent, tetragonProcess *tetragon.Process
+
+	proc, parent := process.GetParentProcessInternal(event.ProcessKey.Pid, event.ProcessKey.Ktime)
+	if proc == nil {
+		tetragonProcess = &tetragon.Process{
+			Pid:       &wrapperspb.UInt32Value{Value: event.ProcessKey.Pid},
+			StartTime: ktime.ToProto(event.ProcessKey.Ktime),
+		}
+	} else {
+		tetragonProcess = proc.UnsafeGetProcess()
+	}
+	if parent == nil {
+		tetragonParent = &tetragon.Process{}
+	} else {
+		tetragonParent = parent.GetProcessCopy()
+	}
+
+	syscall := ""
+	if event.Syscall >= 0 {
+		syscall = syscallinfo.GetSyscallName(int(event.Syscall))
+	}
+
+	tetragonEvent := &tetragon.ProcessNamespaceChange{
+		Process:       tetragonProcess,
+		Parent:        tetragonParent,
+		OldNamespaces: namespace.GetMsgNamespaces(event.OldNs),
+		NewNamespaces: namespace.GetMsgNamespaces(event.NewNs),
+		Flags:         GetChangeFlags(&event.OldNs, &event.NewNs),
+		Syscall:       syscall,
+	}
+	if event.ChildPid != 0 {
+		tetragonEvent.ChildPid = &wrapperspb.UInt32Value{Value: event.ChildPid}
+	}
+
+	ec := eventcache.Get()
+	if ec != nil &&
+		(ec.Needed(tetragonProcess) ||
+			(tetragonProcess.Pid.Value > 1 && ec.Needed(tetragonParent))) {
+		ec.Add(proc, tetragonEvent, event.ProcessKey.Ktime, event)
+		return nil
+	}
+
+	if proc != nil {
+		tetragonEvent.Process = proc.GetProcessCopy()
+	}
+	return tetragonEvent
+}
+
+func (msg *MsgNsChangeEventUnix) RetryInternal(ev notify.Event, timestamp uint64) (*process.ProcessInternal, error) {
+	return eventcache.HandleGenericInternal(ev, timestamp)
+}
+
+func (msg *MsgNsChangeEventUnix) Retry(internal *process.ProcessInternal, ev notify.Event) error {
+	return eventcache.HandleGenericEvent(internal, ev)
+}
+
+func (msg *MsgNsChangeEventUnix) HandleMessage() *tetragon.GetEventsResponse {
+	c := GetProcessNamespaceChange(msg)
+	if c == nil {
+		return nil
+	}
+	return &tetragon.GetEventsResponse{
+		Event:    &tetragon.GetEventsResponse_ProcessNamespaceChange{ProcessNamespaceChange: c},
+		NodeName: nodeName,
+		Time:     ktime.ToProto(msg.Common.Ktime),
+	}
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+package ns
+
+import (
+	"testing"
+
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+func TestGetChangeFlags(t *testing.T) {
+	o := processapi.MsgNamespaces{
+		UtsInum:      1,
+		MntInum:      2,
+		PidInum:      3,
+		PidChildInum: 3,
+		NetInum:      4,
+	}
+	n := o
+	assert.Equal(t, uint32(0), GetChangeFlags(&o, &n))
+
+	// setns(CLONE_NEWNS)
+	n.MntInum = 5
+	assert.Equal(t, uint32(unix.CLONE_NEWNS), GetChangeFlags(&o, &n))
+
+	// unshare(CLONE_NEWPID) only changes the namespace for children
+	n.PidChildInum = 6
+	n.NetInum = 7
+	assert.Equal(t, uint32(unix.CLONE_NEWNS|unix.CLONE_NEWPID|unix.CLONE_NEWNET), GetChangeFlags(&o, &n))
+}
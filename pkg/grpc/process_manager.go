@@ -11,6 +11,7 @@ import (
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/eventcache"
 	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/metrics/derivedmetrics"
 	"github.com/cilium/tetragon/pkg/metrics/eventmetrics"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/reader/node"
@@ -44,6 +45,11 @@ func NewProcessManager(
 	}
 
 	pm.Server = server.NewServer(ctx, wg, pm, manager)
+	if option.Config.EventRingFile != "" {
+		if err := pm.Server.EventRing().Persist(ctx, option.Config.EventRingFile); err != nil {
+			return nil, err
+		}
+	}
 
 	// Exec cache is always needed to ensure events have an associated Process{}
 	eventcache.New(pm.Server)
@@ -52,6 +58,7 @@ func NewProcessManager(
 		"enableK8s":         option.Config.EnableK8s,
 		"enableProcessCred": option.Config.EnableProcessCred,
 		"enableProcessNs":   option.Config.EnableProcessNs,
+		"enableBinaryInfo":  option.Config.EnableProcessBinaryInfo,
 	}).Info("Starting process manager")
 	return pm, nil
 }
@@ -86,9 +93,13 @@ func (pm *ProcessManager) RemoveListener(listener server.Listener) {
 
 func (pm *ProcessManager) NotifyListener(original interface{}, processed *tetragon.GetEventsResponse) {
 	pm.mux.Lock()
-	defer pm.mux.Unlock()
+	pm.Server.RecordEvent(processed)
 	for l := range pm.listeners {
 		l.Notify(processed)
 	}
 	eventmetrics.ProcessEvent(original, processed)
+	pm.mux.Unlock()
+	// Derived metrics evaluate expressions, do not hold back the other
+	// notifications while they do.
+	derivedmetrics.ProcessEvent(processed)
 }
@@ -45,6 +45,15 @@ func kprobeAction(act uint64) tetragon.KprobeAction {
 	}
 }
 
+// selectorIndex converts the selector reported by BPF, 1 + the index of the
+// selector that matched or 0 if there are no selectors, to the event field.
+func selectorIndex(selector uint64) *wrapperspb.UInt32Value {
+	if selector == 0 {
+		return nil
+	}
+	return &wrapperspb.UInt32Value{Value: uint32(selector - 1)}
+}
+
 func GetProcessKprobe(event *MsgGenericKprobeUnix) *tetragon.ProcessKprobe {
 	var tetragonParent, tetragonProcess *tetragon.Process
 	var tetragonArgs []*tetragon.KprobeArgument
@@ -76,6 +85,8 @@ func GetProcessKprobe(event *MsgGenericKprobeUnix) *tetragon.ProcessKprobe {
 			a.Arg = &tetragon.KprobeArgument_IntArg{IntArg: e.Value}
 		case api.MsgGenericKprobeArgSize:
 			a.Arg = &tetragon.KprobeArgument_SizeArg{SizeArg: e.Value}
+		case api.MsgGenericKprobeArgLong:
+			a.Arg = &tetragon.KprobeArgument_LongArg{LongArg: e.Value}
 		case api.MsgGenericKprobeArgString:
 			a.Arg = &tetragon.KprobeArgument_StringArg{StringArg: e.Value}
 		case api.MsgGenericKprobeArgSock:
@@ -162,12 +173,18 @@ func GetProcessKprobe(event *MsgGenericKprobeUnix) *tetragon.ProcessKprobe {
 	}
 
 	tetragonEvent := &tetragon.ProcessKprobe{
-		Process:      tetragonProcess,
-		Parent:       tetragonParent,
-		FunctionName: event.FuncName,
-		Args:         tetragonArgs,
-		Return:       tetragonReturnArg,
-		Action:       kprobeAction(event.Action),
+		Process:          tetragonProcess,
+		Parent:           tetragonParent,
+		FunctionName:     event.FuncName,
+		Args:             tetragonArgs,
+		Return:           tetragonReturnArg,
+		Action:           kprobeAction(event.Action),
+		PolicyName:       event.PolicyName,
+		SelectorIndex:    selectorIndex(event.Selector),
+		Tags:             event.Tags,
+		Message:          event.Message,
+		KernelStackTrace: event.KernelStackTrace,
+		UserStackTrace:   event.UserStackTrace,
 	}
 
 	ec := eventcache.Get()
@@ -190,7 +207,13 @@ type MsgGenericTracepointUnix struct {
 	Id         int64
 	Subsys     string
 	Event      string
+	PolicyName string
+	Message    string
+	Tags       []string
+	Selector   uint64
 	Args       []tracingapi.MsgGenericTracepointArg
+	// ArgLabels are the labels of Args: the names of their fields.
+	ArgLabels []string
 }
 
 func (msg *MsgGenericTracepointUnix) RetryInternal(ev notify.Event, timestamp uint64) (*process.ProcessInternal, error) {
@@ -220,7 +243,8 @@ func (msg *MsgGenericTracepointUnix) HandleMessage() *tetragon.GetEventsResponse
 	}
 
 	var tetragonArgs []*tetragon.KprobeArgument
-	for _, arg := range msg.Args {
+	for i, arg := range msg.Args {
+		nargs := len(tetragonArgs)
 		switch v := arg.(type) {
 		case uint64:
 			tetragonArgs = append(tetragonArgs, &tetragon.KprobeArgument{Arg: &tetragon.KprobeArgument_SizeArg{
@@ -243,14 +267,21 @@ func (msg *MsgGenericTracepointUnix) HandleMessage() *tetragon.GetEventsResponse
 		default:
 			logger.GetLogger().Warnf("handleGenericTracepointMessage: unhandled value: %+v (%T)", arg, arg)
 		}
+		if len(tetragonArgs) > nargs && i < len(msg.ArgLabels) {
+			tetragonArgs[nargs].Label = msg.ArgLabels[i]
+		}
 	}
 
 	tetragonEvent := &tetragon.ProcessTracepoint{
-		Process: tetragonProcess,
-		Parent:  tetragonParent,
-		Subsys:  msg.Subsys,
-		Event:   msg.Event,
-		Args:    tetragonArgs,
+		Process:       tetragonProcess,
+		Parent:        tetragonParent,
+		Subsys:        msg.Subsys,
+		Event:         msg.Event,
+		Args:          tetragonArgs,
+		PolicyName:    msg.PolicyName,
+		SelectorIndex: selectorIndex(msg.Selector),
+		Tags:          msg.Tags,
+		Message:       msg.Message,
 	}
 
 	ec := eventcache.Get()
@@ -279,7 +310,15 @@ type MsgGenericKprobeUnix struct {
 	Id           uint64
 	Action       uint64
 	FuncName     string
+	PolicyName   string
+	Message      string
+	Tags         []string
+	Selector     uint64
 	Args         []tracingapi.MsgGenericKprobeArg
+	// Symbolized stack traces, if the kprobe spec sets kernelStack or
+	// userStack.
+	KernelStackTrace []*tetragon.StackAddress
+	UserStackTrace   []*tetragon.StackAddress
 }
 
 func (msg *MsgGenericKprobeUnix) RetryInternal(ev notify.Event, timestamp uint64) (*process.ProcessInternal, error) {
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package health
+
+import (
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Components of the agent reporting their health. They are also the service
+// names of the grpc.health.v1 service. The empty service name reports the
+// health of the agent as a whole.
+const (
+	SensorManager  = "sensor-manager"
+	ObserverReader = "observer-reader"
+	K8sWatcher     = "k8s-watcher"
+	CiliumState    = "cilium-state"
+	Exporter       = "exporter"
+)
+
+// State is the state of a component.
+type State int
+
+const (
+	// Starting components are not serving yet, but are not failing the
+	// liveness check either.
+	Starting State = iota
+	Serving
+	Failed
+)
+
+func (s State) String() string {
+	switch s {
+	case Starting:
+		return "starting"
+	case Serving:
+		return "serving"
+	case Failed:
+		return "failed"
+	}
+	return "unknown"
+}
+
+// ComponentStatus is the state of a component and a human readable detail.
+type ComponentStatus struct {
+	Name    string
+	State   State
+	Details string
+}
+
+var (
+	mu         sync.Mutex
+	components = map[string]ComponentStatus{}
+	ready      bool
+
+	grpcHealthServer = health.NewServer()
+)
+
+func init() {
+	grpcHealthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// SetStatus sets the state of component. Components are only taken into
+// account by the health checks once they reported a state.
+func SetStatus(component string, state State, details string) {
+	mu.Lock()
+	defer mu.Unlock()
+	components[component] = ComponentStatus{Name: component, State: state, Details: details}
+	grpcHealthServer.SetServingStatus(component, servingStatus(state == Serving))
+	updateOverallLocked()
+}
+
+// SetReady marks the agent as ready once the base sensors are loaded and the
+// process cache is populated with the running processes.
+func SetReady() {
+	mu.Lock()
+	defer mu.Unlock()
+	ready = true
+	updateOverallLocked()
+}
+
+func servingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+func updateOverallLocked() {
+	grpcHealthServer.SetServingStatus("", servingStatus(isReadyLocked()))
+}
+
+func isReadyLocked() bool {
+	if !ready {
+		return false
+	}
+	for _, c := range components {
+		if c.State != Serving {
+			return false
+		}
+	}
+	return true
+}
+
+// IsLive returns false if one of the components failed.
+func IsLive() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, c := range components {
+		if c.State == Failed {
+			return false
+		}
+	}
+	return true
+}
+
+// IsReady returns true if the agent is ready and all its components are
+// serving.
+func IsReady() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return isReadyLocked()
+}
+
+// Components returns the status of the components sorted by name.
+func Components() []ComponentStatus {
+	mu.Lock()
+	defer mu.Unlock()
+	ret := make([]ComponentStatus, 0, len(components))
+	for _, c := range components {
+		ret = append(ret, c)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Name < ret[j].Name })
+	return ret
+}
+
+// GRPCServer returns the grpc.health.v1 service reporting the health of the
+// components.
+func GRPCServer() healthpb.HealthServer {
+	return grpcHealthServer
+}
+
+// reset forgets the components and readiness, for tests.
+func reset() {
+	mu.Lock()
+	defer mu.Unlock()
+	for name := range components {
+		grpcHealthServer.SetServingStatus(name, healthpb.HealthCheckResponse_SERVICE_UNKNOWN)
+	}
+	components = map[string]ComponentStatus{}
+	ready = false
+	updateOverallLocked()
+}
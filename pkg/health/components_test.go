@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package health
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func checkService(t *testing.T, service string) healthpb.HealthCheckResponse_ServingStatus {
+	res, err := GRPCServer().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return res.Status
+}
+
+func TestComponents(t *testing.T) {
+	reset()
+	defer reset()
+
+	// Not ready until SetReady, even without components.
+	assert.True(t, IsLive())
+	assert.False(t, IsReady())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkService(t, ""))
+
+	SetStatus(SensorManager, Serving, "")
+	SetStatus(ObserverReader, Starting, "")
+	SetReady()
+	assert.True(t, IsLive())
+	assert.False(t, IsReady())
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, checkService(t, SensorManager))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkService(t, ObserverReader))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkService(t, ""))
+
+	SetStatus(ObserverReader, Serving, "")
+	assert.True(t, IsReady())
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, checkService(t, ""))
+
+	SetStatus(ObserverReader, Failed, "poll failed")
+	assert.False(t, IsLive())
+	assert.False(t, IsReady())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkService(t, ""))
+	assert.Equal(t, []ComponentStatus{
+		{Name: ObserverReader, State: Failed, Details: "poll failed"},
+		{Name: SensorManager, State: Serving},
+	}, Components())
+}
+
+func TestHTTPHandlers(t *testing.T) {
+	reset()
+	defer reset()
+
+	get := func(handler http.HandlerFunc) (int, string) {
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		return rec.Code, rec.Body.String()
+	}
+
+	SetStatus(Exporter, Starting, "")
+	code, body := get(HealthzHandler)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok\nexporter: starting\n", body)
+	code, body = get(ReadyzHandler)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "not ready\nexporter: starting\n", body)
+
+	SetStatus(Exporter, Serving, "")
+	SetReady()
+	code, body = get(ReadyzHandler)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ready\nexporter: serving\n", body)
+
+	SetStatus(Exporter, Failed, "write failed")
+	code, body = get(HealthzHandler)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "failed\nexporter: failed (write failed)\n", body)
+}
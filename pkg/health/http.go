@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package health
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// writeStatus writes the status of the components, one per line, with status
+// code 200 if ok and 503 otherwise.
+func writeStatus(w http.ResponseWriter, ok bool, summary string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if ok {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	fmt.Fprintln(w, summary)
+	for _, c := range Components() {
+		if c.Details != "" {
+			fmt.Fprintf(w, "%s: %s (%s)\n", c.Name, c.State, c.Details)
+		} else {
+			fmt.Fprintf(w, "%s: %s\n", c.Name, c.State)
+		}
+	}
+}
+
+// HealthzHandler is the liveness check. It fails if one of the components
+// failed.
+func HealthzHandler(w http.ResponseWriter, _ *http.Request) {
+	if IsLive() {
+		writeStatus(w, true, "ok")
+	} else {
+		writeStatus(w, false, "failed")
+	}
+}
+
+// ReadyzHandler is the readiness check. It succeeds once the agent is ready
+// and all the components are serving.
+func ReadyzHandler(w http.ResponseWriter, _ *http.Request) {
+	if IsReady() {
+		writeStatus(w, true, "ready")
+	} else {
+		writeStatus(w, false, "not ready")
+	}
+}
@@ -43,6 +43,20 @@ type TracingPolicySpec struct {
 	// +kubebuilder:validation:Optional
 	// A list of tracepoint specs.
 	Tracepoints []TracepointSpec `json:"tracepoints"`
+	// +kubebuilder:validation:Optional
+	// A list of metrics derived from the events of the agent.
+	Metrics []MetricSpec `json:"metrics"`
+	// +kubebuilder:validation:Optional
+	// Mount events to report.
+	Mount *MountSpec `json:"mount,omitempty"`
+}
+
+type MountSpec struct {
+	// +kubebuilder:validation:Optional
+	// A list of filters on the mount target. Only the Prefix operator on
+	// the target (index 0) is supported, events whose target starts with
+	// one of the values are reported.
+	MatchArgs []ArgSelector `json:"matchArgs"`
 }
 
 type KProbeSpec struct {
@@ -65,14 +79,37 @@ type KProbeSpec struct {
 	// +kubebuilder:validation:Optional
 	// Selectors to apply before producing trace output. Selectors are ORed.
 	Selectors []KProbeSelector `json:"selectors"`
+	// +kubebuilder:validation:Optional
+	// A short message to include in the events of this kprobe.
+	Message string `json:"message"`
+	// +kubebuilder:validation:Optional
+	// Tags to include in the events of this kprobe.
+	Tags []string `json:"tags"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	// Indicates whether to include the kernel stack trace of the call in
+	// the events of this kprobe.
+	KernelStack bool `json:"kernelStack"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	// Indicates whether to include the user stack trace of the call in the
+	// events of this kprobe.
+	UserStack bool `json:"userStack"`
 }
 
 type KProbeArg struct {
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Minimum=0
 	// Position of the argument.
 	Index uint32 `json:"index"`
+	// +kubebuilder:validation:Optional
+	// Name of the field of the argument. This field is used only for
+	// tracepoints, as an alternative to the index.
+	Name string `json:"name,omitempty"`
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=int;uint32;int32;uint64;int64;char_buf;char_iovec;size_t;skb;sock;string;fd;file;filename;path;nop;bpf_attr;perf_event;
-	// Argument type.
+	// Argument type. Required for kprobes, tracepoint argument types are
+	// inferred from the tracepoint format.
 	Type string `json:"type"`
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Minimum=0
@@ -83,6 +120,12 @@ type KProbeArg struct {
 	// +kubebuilder:default=false
 	// This field is used only for char_buf and char_iovec types.
 	ReturnCopy bool `json:"returnCopy"`
+	// +kubebuilder:validation:Optional
+	// Path of the field to read from the argument, resolved against the
+	// kernel BTF (e.g. "f_inode.i_mode"). The argument type is inferred
+	// from the field if not set. This field is used only for the args of
+	// kprobes, not for returnArg.
+	Resolve string `json:"resolve,omitempty"`
 }
 
 type BinarySelector struct {
@@ -214,6 +257,63 @@ type TracepointSpec struct {
 	// +kubebuilder:validation:Optional
 	// Selectors to apply before producing trace output. Selectors are ORed.
 	Selectors []KProbeSelector `json:"selectors"`
+	// +kubebuilder:validation:Optional
+	// A short message to include in the events of this tracepoint.
+	Message string `json:"message"`
+	// +kubebuilder:validation:Optional
+	// Tags to include in the events of this tracepoint.
+	Tags []string `json:"tags"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	// Enables the syscall audit mode of the raw_syscalls/sys_enter
+	// tracepoint. The events include the name of the system call and its
+	// arguments decoded according to its signature, and args must be
+	// empty. In selectors, matchArgs of index 0 match the system call
+	// against names and sets of system calls (e.g., @mount) with the In
+	// and NotIn operators. Compat system calls (e.g., int 0x80 on x86_64)
+	// are not reported.
+	SyscallAudit bool `json:"syscallAudit"`
+}
+
+type MetricSpec struct {
+	// Name of the metric, exported with the tetragon_ prefix.
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// Description of the metric.
+	Help string `json:"help"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=counter;histogram
+	// +kubebuilder:default=counter
+	// Type of the metric. Counters count the events, histograms observe
+	// the value of the events.
+	Type string `json:"type"`
+	// +kubebuilder:validation:Optional
+	// Filter expression selecting the events of the metric, e.g.
+	// process_kprobe.function_name == "tcp_connect". All events by default.
+	Filter string `json:"filter"`
+	// +kubebuilder:validation:Optional
+	// Expression of the value observed by histograms, e.g.
+	// process_kprobe.args[2].size_arg.
+	Value string `json:"value"`
+	// +kubebuilder:validation:Optional
+	// Buckets of histograms. The Prometheus default buckets by default.
+	Buckets []float64 `json:"buckets"`
+	// +kubebuilder:validation:Optional
+	// Labels of the metric, in addition to the namespace and pod labels
+	// from the process of the event. Only these labels are exported.
+	Labels []MetricLabel `json:"labels"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=1000
+	// Maximum number of label value combinations. Events with new
+	// combinations are dropped once it is reached.
+	MaxSeries uint32 `json:"maxSeries"`
+}
+
+type MetricLabel struct {
+	// Name of the label.
+	Name string `json:"name"`
+	// Expression of the label value, e.g. process.binary.
+	Value string `json:"value"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -147,11 +147,8 @@ func (k *Ksyms) GetFnOffset(addr uint64) (*FnOffset, error) {
 // GetFnOffset -- retruns the FnOffset for a given address
 func (k *Ksyms) getFnOffset(addr uint64) (*FnOffset, error) {
 
-	// TODO: we can do binary search here if we care about performance
-	i := 0
-	for k.table[i].addr < addr {
-		i++
-	}
+	// index of the first symbol after addr, the function is the one before
+	i := sort.Search(len(k.table), func(i int) bool { return k.table[i].addr > addr })
 
 	if i == 0 {
 		return nil, fmt.Errorf("address %d is before first sumbol %s@%d", addr, k.table[0].name, k.table[0].addr)
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package ksyms
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFnOffset(t *testing.T) {
+	procfs := t.TempDir()
+	kallsyms := "" +
+		"ffffffff81000000 T _stext\n" +
+		"ffffffff81000100 t do_one_initcall\n" +
+		"ffffffff81000200 D some_data\n" +
+		"ffffffff81000300 T tcp_connect\n"
+	require.NoError(t, os.WriteFile(filepath.Join(procfs, "kallsyms"), []byte(kallsyms), 0644))
+
+	ks, err := NewKsyms(procfs)
+	require.NoError(t, err)
+
+	fo, err := ks.GetFnOffset(0xffffffff81000110)
+	require.NoError(t, err)
+	assert.Equal(t, "do_one_initcall()+0x10", fo.ToString())
+
+	// Addresses of symbols belong to them.
+	fo, err = ks.GetFnOffset(0xffffffff81000100)
+	require.NoError(t, err)
+	assert.Equal(t, "do_one_initcall()+0x0", fo.ToString())
+
+	// Addresses after the last symbol.
+	fo, err = ks.GetFnOffset(0xffffffff81000320)
+	require.NoError(t, err)
+	assert.Equal(t, "tcp_connect()+0x20", fo.ToString())
+
+	_, err = ks.GetFnOffset(0xffffffff81000210)
+	assert.Error(t, err)
+	_, err = ks.GetFnOffset(0x1000)
+	assert.Error(t, err)
+}
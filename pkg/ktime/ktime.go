@@ -10,6 +10,44 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// ClockReference is a reading of the clocks, to decode ktimes relative to it
+// instead of the current clocks.
+type ClockReference struct {
+	// Wall is the wall clock time of the reading.
+	Wall time.Time
+	// Monotonic and Boot are the CLOCK_MONOTONIC and CLOCK_BOOTTIME values
+	// of the reading, in nanoseconds.
+	Monotonic int64
+	Boot      int64
+}
+
+// clockReference is used by DecodeKtime if set, see SetClockReference.
+var clockReference *ClockReference
+
+// SetClockReference makes DecodeKtime decode ktimes relative to ref instead
+// of the current clocks, for example to decode events recorded on another
+// host. It must be called before decoding events.
+func SetClockReference(ref *ClockReference) {
+	clockReference = ref
+}
+
+// NewClockReference reads the current clocks.
+func NewClockReference() (*ClockReference, error) {
+	mono := unix.Timespec{}
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &mono); err != nil {
+		return nil, err
+	}
+	boot := unix.Timespec{}
+	if err := unix.ClockGettime(unix.CLOCK_BOOTTIME, &boot); err != nil {
+		return nil, err
+	}
+	return &ClockReference{
+		Wall:      time.Now(),
+		Monotonic: mono.Nano(),
+		Boot:      boot.Nano(),
+	}, nil
+}
+
 func ToProto(ktime uint64) *timestamppb.Timestamp {
 	return ToProtoOpt(ktime, true)
 }
@@ -37,6 +75,13 @@ func NanoTimeSince(ktime int64) (time.Duration, error) {
 	return time.Duration(diff), nil
 }
 func DecodeKtime(ktime int64, monotonic bool) (time.Time, error) {
+	if ref := clockReference; ref != nil {
+		if monotonic {
+			return ref.Wall.Add(time.Duration(ktime - ref.Monotonic)), nil
+		}
+		return ref.Wall.Add(time.Duration(ktime - ref.Boot)), nil
+	}
+
 	var clk int32
 	if monotonic {
 		clk = int32(unix.CLOCK_MONOTONIC)
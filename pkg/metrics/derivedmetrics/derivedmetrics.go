@@ -0,0 +1,374 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package derivedmetrics implements the metrics that tracing policies and the
+// agent configuration derive from the events, so that they can be aggregated
+// without shipping every event to a log pipeline.
+//
+// Metrics are declared with v1alpha1.MetricSpec: a filter expression selects
+// the events of the metric and label expressions compute its labels, see
+// pkg/filters/expr. Every metric also has the namespace and pod labels of the
+// process of the event. Counters count the events, and histograms observe the
+// value of their value expression. Series without events for seriesTTL are
+// removed, so that the series of short-lived pods do not accumulate.
+package derivedmetrics
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/api/v1/tetragon/codegen/helpers"
+	"github.com/cilium/tetragon/pkg/filters/expr"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/metrics/consts"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConfigSource is the source of the metrics of the agent
+	// configuration. It is not a valid tracing policy name.
+	ConfigSource = "<agent config>"
+
+	defaultMaxSeries = 1000
+
+	// seriesTTL is the time after which series without events are
+	// removed, checked every seriesExpireInterval.
+	seriesTTL            = 10 * time.Minute
+	seriesExpireInterval = time.Minute
+
+	typeCounter   = "counter"
+	typeHistogram = "histogram"
+)
+
+var (
+	DroppedEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name:        consts.MetricNamePrefix + "derived_metrics_dropped_events_total",
+		Help:        "The total number of events not accounted in a derived metric because it reached its maxSeries limit.",
+		ConstLabels: nil,
+	}, []string{"metric"})
+
+	nameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	podLabels = []string{"namespace", "pod"}
+
+	defaultRegistry = newRegistry(prometheus.DefaultRegisterer)
+)
+
+type label struct {
+	name  string
+	value *expr.Program
+}
+
+// metric is a compiled v1alpha1.MetricSpec.
+type metric struct {
+	name      string
+	filter    *expr.Program
+	value     *expr.Program
+	labels    []label
+	maxSeries int
+
+	counter   *prometheus.CounterVec
+	histogram *prometheus.HistogramVec
+
+	// series are the label values combinations of the metric, by their
+	// values joined with \xff.
+	seriesLock sync.Mutex
+	series     map[string]*series
+	lastExpire time.Time
+	now        func() time.Time
+}
+
+type series struct {
+	values   []string
+	lastSeen time.Time
+}
+
+func compileExpr(what, src string) (*expr.Program, error) {
+	if src == "" {
+		return nil, nil
+	}
+	p, err := expr.Compile(src)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", what, err)
+	}
+	return p, nil
+}
+
+func newMetric(spec *v1alpha1.MetricSpec) (*metric, error) {
+	if !nameRegexp.MatchString(spec.Name) {
+		return nil, fmt.Errorf("invalid metric name %q", spec.Name)
+	}
+	m := &metric{
+		name:      consts.MetricNamePrefix + spec.Name,
+		maxSeries: int(spec.MaxSeries),
+		series:    map[string]*series{},
+		now:       time.Now,
+	}
+	if m.maxSeries == 0 {
+		m.maxSeries = defaultMaxSeries
+	}
+
+	var err error
+	if m.filter, err = compileExpr("filter", spec.Filter); err != nil {
+		return nil, err
+	}
+	if m.value, err = compileExpr("value", spec.Value); err != nil {
+		return nil, err
+	}
+
+	names := append([]string{}, podLabels...)
+	for _, l := range spec.Labels {
+		if !nameRegexp.MatchString(l.Name) || strings.HasPrefix(l.Name, "__") {
+			return nil, fmt.Errorf("invalid label name %q", l.Name)
+		}
+		for _, n := range names {
+			if n == l.Name {
+				return nil, fmt.Errorf("duplicate label %q", l.Name)
+			}
+		}
+		value, err := compileExpr(fmt.Sprintf("label %s", l.Name), l.Value)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			return nil, fmt.Errorf("label %s: missing value", l.Name)
+		}
+		names = append(names, l.Name)
+		m.labels = append(m.labels, label{name: l.Name, value: value})
+	}
+
+	help := spec.Help
+	if help == "" {
+		help = fmt.Sprintf("Metric %s derived from the Tetragon events.", spec.Name)
+	}
+	switch spec.Type {
+	case "", typeCounter:
+		m.counter = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: m.name,
+			Help: help,
+		}, names)
+	case typeHistogram:
+		if m.value == nil {
+			return nil, fmt.Errorf("histogram %s: missing value", spec.Name)
+		}
+		m.histogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    m.name,
+			Help:    help,
+			Buckets: spec.Buckets,
+		}, names)
+	default:
+		return nil, fmt.Errorf("metric %s: unknown type %q", spec.Name, spec.Type)
+	}
+	return m, nil
+}
+
+func (m *metric) collector() prometheus.Collector {
+	if m.counter != nil {
+		return m.counter
+	}
+	return m.histogram
+}
+
+// addSeries returns whether the metric can have the label values combination
+// values, i.e. whether it has it already or is below its maxSeries limit once
+// the expired series are removed.
+func (m *metric) addSeries(values []string) bool {
+	m.seriesLock.Lock()
+	defer m.seriesLock.Unlock()
+	now := m.now()
+	if now.Sub(m.lastExpire) >= seriesExpireInterval {
+		m.expireLocked(now)
+	}
+	key := strings.Join(values, "\xff")
+	if s, ok := m.series[key]; ok {
+		s.lastSeen = now
+		return true
+	}
+	if len(m.series) >= m.maxSeries {
+		return false
+	}
+	m.series[key] = &series{values: values, lastSeen: now}
+	return true
+}
+
+// expireLocked removes the series without events for seriesTTL.
+func (m *metric) expireLocked(now time.Time) {
+	m.lastExpire = now
+	for key, s := range m.series {
+		if now.Sub(s.lastSeen) < seriesTTL {
+			continue
+		}
+		if m.counter != nil {
+			m.counter.DeleteLabelValues(s.values...)
+		} else {
+			m.histogram.DeleteLabelValues(s.values...)
+		}
+		delete(m.series, key)
+	}
+}
+
+func (m *metric) process(res *tetragon.GetEventsResponse) {
+	if m.filter != nil && !m.filter.Match(res) {
+		return
+	}
+	var observed float64
+	if m.histogram != nil {
+		var ok bool
+		if observed, ok = m.value.EvalNumber(res); !ok {
+			return
+		}
+	}
+
+	values := make([]string, 0, len(podLabels)+len(m.labels))
+	pod := helpers.ResponseGetProcess(res).GetPod()
+	values = append(values, pod.GetNamespace(), pod.GetName())
+	for _, l := range m.labels {
+		v, _ := l.value.EvalString(res)
+		values = append(values, v)
+	}
+	if !m.addSeries(values) {
+		DroppedEvents.WithLabelValues(m.name).Inc()
+		return
+	}
+
+	if m.counter != nil {
+		m.counter.WithLabelValues(values...).Inc()
+	} else {
+		m.histogram.WithLabelValues(values...).Observe(observed)
+	}
+}
+
+// registry holds the derived metrics by source: the name of the tracing
+// policy declaring them, or ConfigSource.
+type registry struct {
+	registerer prometheus.Registerer
+
+	lock    sync.RWMutex
+	sources map[string][]*metric
+}
+
+func newRegistry(registerer prometheus.Registerer) *registry {
+	return &registry{
+		registerer: registerer,
+		sources:    map[string][]*metric{},
+	}
+}
+
+func (r *registry) set(source string, specs []v1alpha1.MetricSpec) error {
+	metrics := make([]*metric, 0, len(specs))
+	for i := range specs {
+		m, err := newMetric(&specs[i])
+		if err != nil {
+			return err
+		}
+		metrics = append(metrics, m)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.deleteLocked(source)
+	for i, m := range metrics {
+		if err := r.registerer.Register(m.collector()); err != nil {
+			for _, m := range metrics[:i] {
+				r.registerer.Unregister(m.collector())
+			}
+			return fmt.Errorf("failed to register metric %s: %w", m.name, err)
+		}
+	}
+	if len(metrics) > 0 {
+		r.sources[source] = metrics
+	}
+	return nil
+}
+
+func (r *registry) deleteLocked(source string) {
+	for _, m := range r.sources[source] {
+		r.registerer.Unregister(m.collector())
+	}
+	delete(r.sources, source)
+}
+
+func (r *registry) delete(source string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.deleteLocked(source)
+}
+
+func (r *registry) processEvent(res *tetragon.GetEventsResponse) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	for _, metrics := range r.sources {
+		for _, m := range metrics {
+			m.process(res)
+		}
+	}
+}
+
+// Set replaces the derived metrics of source with the metrics of specs. On
+// error, source has no metrics.
+func Set(source string, specs []v1alpha1.MetricSpec) error {
+	return defaultRegistry.set(source, specs)
+}
+
+// Delete removes the derived metrics of source.
+func Delete(source string) {
+	defaultRegistry.delete(source)
+}
+
+// ProcessEvent updates the derived metrics from an event.
+func ProcessEvent(res *tetragon.GetEventsResponse) {
+	if res == nil {
+		return
+	}
+	defaultRegistry.processEvent(res)
+}
+
+// ReadConfigFile reads the derived metrics of the agent configuration from a
+// YAML file, with the same format as the metrics of tracing policies:
+//
+//	metrics:
+//	- name: process_exec_total
+//	  filter: event_type == "PROCESS_EXEC"
+//	  labels:
+//	  - name: binary
+//	    value: process.binary
+func ReadConfigFile(fileName string) ([]v1alpha1.MetricSpec, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	var conf struct {
+		Metrics []v1alpha1.MetricSpec `json:"metrics"`
+	}
+	if err := yaml.UnmarshalStrict(data, &conf); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", fileName, err)
+	}
+	return conf.Metrics, nil
+}
+
+// policyHandler sets the derived metrics of the tracing policies.
+type policyHandler struct{}
+
+func (policyHandler) PolicyAdded(policyName string, raw interface{}) error {
+	spec, ok := raw.(*v1alpha1.TracingPolicySpec)
+	if !ok || len(spec.Metrics) == 0 {
+		return nil
+	}
+	return Set(policyName, spec.Metrics)
+}
+
+func (policyHandler) PolicyDeleted(policyName string) {
+	Delete(policyName)
+}
+
+func init() {
+	sensors.RegisterPolicyHandlerAtInit("derived metrics", policyHandler{})
+}
@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package derivedmetrics
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func execEvent(binary, namespace, pod string) *tetragon.GetEventsResponse {
+	process := &tetragon.Process{Binary: binary}
+	if pod != "" {
+		process.Pod = &tetragon.Pod{Namespace: namespace, Name: pod}
+	}
+	return &tetragon.GetEventsResponse{Event: &tetragon.GetEventsResponse_ProcessExec{ProcessExec: &tetragon.ProcessExec{
+		Process: process,
+	}}}
+}
+
+func writeEvent(size int64) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: &tetragon.ProcessKprobe{
+		Process:      &tetragon.Process{Binary: "/usr/bin/dd"},
+		FunctionName: "__x64_sys_write",
+		Args: []*tetragon.KprobeArgument{
+			{Arg: &tetragon.KprobeArgument_IntArg{IntArg: 1}},
+			{Arg: &tetragon.KprobeArgument_SizeArg{SizeArg: uint64(size)}},
+		},
+	}}}
+}
+
+func TestCounter(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	r := newRegistry(reg)
+	err := r.set("policy", []v1alpha1.MetricSpec{{
+		Name:   "test_execs_total",
+		Help:   "Execs per binary.",
+		Filter: `event_type == "PROCESS_EXEC"`,
+		Labels: []v1alpha1.MetricLabel{{Name: "binary", Value: "process.binary"}},
+	}})
+	require.NoError(t, err)
+
+	r.processEvent(execEvent("/bin/ls", "default", "pod-a"))
+	r.processEvent(execEvent("/bin/ls", "default", "pod-a"))
+	r.processEvent(execEvent("/bin/cat", "", ""))
+	r.processEvent(writeEvent(10))
+
+	expected := strings.NewReader(`# HELP tetragon_test_execs_total Execs per binary.
+# TYPE tetragon_test_execs_total counter
+tetragon_test_execs_total{binary="/bin/cat",namespace="",pod=""} 1
+tetragon_test_execs_total{binary="/bin/ls",namespace="default",pod="pod-a"} 2
+`)
+	assert.NoError(t, testutil.GatherAndCompare(reg, expected, "tetragon_test_execs_total"))
+
+	r.delete("policy")
+	count, err := testutil.GatherAndCount(reg, "tetragon_test_execs_total")
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestHistogram(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	r := newRegistry(reg)
+	err := r.set("policy", []v1alpha1.MetricSpec{{
+		Name:    "test_write_bytes",
+		Help:    "Write sizes.",
+		Type:    typeHistogram,
+		Filter:  `process_kprobe.function_name == "__x64_sys_write"`,
+		Value:   "process_kprobe.args[1].size_arg",
+		Buckets: []float64{10, 100},
+	}})
+	require.NoError(t, err)
+
+	r.processEvent(writeEvent(5))
+	r.processEvent(writeEvent(50))
+	r.processEvent(writeEvent(500))
+	r.processEvent(execEvent("/bin/ls", "", ""))
+
+	expected := strings.NewReader(`# HELP tetragon_test_write_bytes Write sizes.
+# TYPE tetragon_test_write_bytes histogram
+tetragon_test_write_bytes_bucket{namespace="",pod="",le="10"} 1
+tetragon_test_write_bytes_bucket{namespace="",pod="",le="100"} 2
+tetragon_test_write_bytes_bucket{namespace="",pod="",le="+Inf"} 3
+tetragon_test_write_bytes_sum{namespace="",pod=""} 555
+tetragon_test_write_bytes_count{namespace="",pod=""} 3
+`)
+	assert.NoError(t, testutil.GatherAndCompare(reg, expected, "tetragon_test_write_bytes"))
+}
+
+func TestMaxSeries(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	r := newRegistry(reg)
+	err := r.set("policy", []v1alpha1.MetricSpec{{
+		Name:      "test_limited_total",
+		Labels:    []v1alpha1.MetricLabel{{Name: "binary", Value: "process.binary"}},
+		MaxSeries: 2,
+	}})
+	require.NoError(t, err)
+
+	r.processEvent(execEvent("/bin/a", "", ""))
+	r.processEvent(execEvent("/bin/b", "", ""))
+	r.processEvent(execEvent("/bin/c", "", ""))
+	r.processEvent(execEvent("/bin/a", "", ""))
+
+	count, err := testutil.GatherAndCount(reg, "tetragon_test_limited_total")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, float64(1), testutil.ToFloat64(DroppedEvents.WithLabelValues("tetragon_test_limited_total")))
+}
+
+func TestSeriesExpire(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	r := newRegistry(reg)
+	err := r.set("policy", []v1alpha1.MetricSpec{{
+		Name:      "test_expire_total",
+		Labels:    []v1alpha1.MetricLabel{{Name: "binary", Value: "process.binary"}},
+		MaxSeries: 2,
+	}})
+	require.NoError(t, err)
+	m := r.sources["policy"][0]
+	now := time.Unix(1000, 0)
+	m.now = func() time.Time { return now }
+
+	r.processEvent(execEvent("/bin/a", "", ""))
+	r.processEvent(execEvent("/bin/b", "", ""))
+	now = now.Add(seriesTTL / 2)
+	r.processEvent(execEvent("/bin/a", "", ""))
+
+	// /bin/b expired, making room for /bin/c
+	now = now.Add(seriesTTL / 2)
+	r.processEvent(execEvent("/bin/c", "", ""))
+
+	expected := strings.NewReader(`# HELP tetragon_test_expire_total Metric test_expire_total derived from the Tetragon events.
+# TYPE tetragon_test_expire_total counter
+tetragon_test_expire_total{binary="/bin/a",namespace="",pod=""} 2
+tetragon_test_expire_total{binary="/bin/c",namespace="",pod=""} 1
+`)
+	assert.NoError(t, testutil.GatherAndCompare(reg, expected, "tetragon_test_expire_total"))
+	assert.Len(t, m.series, 2)
+}
+
+func TestInvalidSpecs(t *testing.T) {
+	specs := []v1alpha1.MetricSpec{
+		{Name: "bad-name"},
+		{Name: "bad_filter", Filter: "process.nope == 1"},
+		{Name: "bad_type", Type: "gauge"},
+		{Name: "no_value", Type: typeHistogram},
+		{Name: "bad_label", Labels: []v1alpha1.MetricLabel{{Name: "pod", Value: "process.binary"}}},
+		{Name: "empty_label", Labels: []v1alpha1.MetricLabel{{Name: "binary"}}},
+	}
+	r := newRegistry(prometheus.NewRegistry())
+	for _, spec := range specs {
+		assert.Error(t, r.set("policy", []v1alpha1.MetricSpec{spec}), spec.Name)
+	}
+
+	// metric names are unique across sources
+	require.NoError(t, r.set("policy1", []v1alpha1.MetricSpec{{Name: "test_dup_total"}}))
+	assert.Error(t, r.set("policy2", []v1alpha1.MetricSpec{{Name: "test_dup_total"}}))
+	r.delete("policy1")
+	assert.NoError(t, r.set("policy2", []v1alpha1.MetricSpec{{Name: "test_dup_total"}}))
+}
+
+func TestReadConfigFile(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "metrics.yaml")
+	err := os.WriteFile(fileName, []byte(`metrics:
+- name: process_exec_total
+  filter: event_type == "PROCESS_EXEC"
+  labels:
+  - name: binary
+    value: process.binary
+`), 0o600)
+	require.NoError(t, err)
+
+	specs, err := ReadConfigFile(fileName)
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.MetricSpec{{
+		Name:   "process_exec_total",
+		Filter: `event_type == "PROCESS_EXEC"`,
+		Labels: []v1alpha1.MetricLabel{{Name: "binary", Value: "process.binary"}},
+	}}, specs)
+}
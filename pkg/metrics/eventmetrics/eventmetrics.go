@@ -11,6 +11,7 @@ import (
 	"github.com/cilium/tetragon/pkg/filters"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/metrics/consts"
+	"github.com/cilium/tetragon/pkg/metrics/policymetrics"
 	"github.com/cilium/tetragon/pkg/reader/exec"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -51,6 +52,18 @@ func handleOriginalEvent(originalEvent interface{}) {
 	}
 }
 
+// handlePolicyEvent counts the events of the tracing policies by policy and
+// hook: the function of kprobes and "subsys/event" of tracepoints.
+func handlePolicyEvent(ev *tetragon.GetEventsResponse) {
+	switch e := ev.Event.(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		policymetrics.EventsInc(e.ProcessKprobe.GetPolicyName(), e.ProcessKprobe.GetFunctionName())
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		tp := e.ProcessTracepoint
+		policymetrics.EventsInc(tp.GetPolicyName(), tp.GetSubsys()+"/"+tp.GetEvent())
+	}
+}
+
 func handleProcessedEvent(processedEvent interface{}) {
 	var eventType, namespace, pod, binary string
 	switch ev := processedEvent.(type) {
@@ -62,6 +75,7 @@ func handleProcessedEvent(processedEvent interface{}) {
 			logger.GetLogger().WithField("event", processedEvent).WithError(err).Warn("metrics: handleProcessedEvent: unhandled event")
 			eventType = "unhandled"
 		}
+		handlePolicyEvent(ev)
 	default:
 		eventType = "unknown"
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/api"
 	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/metrics/policymetrics"
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 )
@@ -98,3 +99,28 @@ tetragon_flags_total{type="execve"} 1
 `)
 	assert.NoError(t, testutil.CollectAndCompare(FlagCount, expected))
 }
+
+func TestHandlePolicyEvent(t *testing.T) {
+	policymetrics.PolicyEvents.Reset()
+	handleProcessedEvent(&tetragon.GetEventsResponse{Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: &tetragon.ProcessKprobe{
+		FunctionName: "__x64_sys_write",
+		PolicyName:   "policy_a",
+	}}})
+	handleProcessedEvent(&tetragon.GetEventsResponse{Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: &tetragon.ProcessKprobe{
+		FunctionName: "__x64_sys_write",
+		PolicyName:   "policy_a",
+	}}})
+	handleProcessedEvent(&tetragon.GetEventsResponse{Event: &tetragon.GetEventsResponse_ProcessTracepoint{ProcessTracepoint: &tetragon.ProcessTracepoint{
+		Subsys:     "syscalls",
+		Event:      "sys_enter_lseek",
+		PolicyName: "policy_b",
+	}}})
+	handleProcessedEvent(&tetragon.GetEventsResponse{Event: &tetragon.GetEventsResponse_ProcessExec{ProcessExec: &tetragon.ProcessExec{}}})
+
+	expected := strings.NewReader(`# HELP tetragon_policy_events_total The total number of events emitted per tracing policy and hook.
+# TYPE tetragon_policy_events_total counter
+tetragon_policy_events_total{hook="__x64_sys_write",policy="policy_a"} 2
+tetragon_policy_events_total{hook="syscalls/sys_enter_lseek",policy="policy_b"} 1
+`)
+	assert.NoError(t, testutil.CollectAndCompare(policymetrics.PolicyEvents, expected))
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package listenermetrics
+
+import (
+	"github.com/cilium/tetragon/pkg/metrics/consts"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	EventsDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name:        consts.MetricNamePrefix + "listener_events_dropped_total",
+		Help:        "The total number of events dropped because a GetEvents client did not keep up.",
+		ConstLabels: nil,
+	}, []string{"client"})
+)
+
+// Get a new handle on the events dropped metric of a client
+func GetEventsDropped(client string) prometheus.Counter {
+	return EventsDropped.WithLabelValues(client)
+}
+
+// DeleteClient removes the metrics of a client once it disconnected.
+func DeleteClient(client string) {
+	EventsDropped.DeleteLabelValues(client)
+}
@@ -6,6 +6,7 @@ package metrics
 import (
 	"net/http"
 
+	"github.com/cilium/tetragon/pkg/health"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
@@ -13,5 +14,7 @@ import (
 func EnableMetrics(address string) {
 	logger.GetLogger().WithField("addr", address).Info("Starting metrics server")
 	http.Handle("/metrics", promhttp.Handler())
+	http.HandleFunc("/healthz", health.HealthzHandler)
+	http.HandleFunc("/readyz", health.ReadyzHandler)
 	http.ListenAndServe(address, nil)
 }
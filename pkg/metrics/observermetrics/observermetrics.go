@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package observermetrics
+
+import (
+	"github.com/cilium/tetragon/pkg/metrics/consts"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// StageDecode is the queue of the raw samples waiting to be decoded.
+	StageDecode = "decode"
+	// StageDispatch is the queue of the decoded events waiting to be
+	// enriched and fanned out by the listeners.
+	StageDispatch = "dispatch"
+)
+
+var (
+	QueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        consts.MetricNamePrefix + "observer_queue_depth",
+		Help:        "The number of events waiting in the queue of an observer stage.",
+		ConstLabels: nil,
+	}, []string{"stage"})
+	QueueCapacity = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        consts.MetricNamePrefix + "observer_queue_capacity",
+		Help:        "The number of events the queue of an observer stage can hold.",
+		ConstLabels: nil,
+	}, []string{"stage"})
+)
+
+// Get a new handle on the queue depth metric of a stage
+func GetQueueDepth(stage string) prometheus.Gauge {
+	return QueueDepth.WithLabelValues(stage)
+}
+
+// Set the queue depth metric of a stage
+func QueueDepthSet(stage string, val float64) {
+	GetQueueDepth(stage).Set(val)
+}
+
+// Set the queue capacity metric of a stage
+func QueueCapacitySet(stage string, val float64) {
+	QueueCapacity.WithLabelValues(stage).Set(val)
+}
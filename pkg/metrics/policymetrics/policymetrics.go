@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policymetrics
+
+import (
+	"sync"
+
+	"github.com/cilium/tetragon/pkg/metrics/consts"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	PolicyEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name:        consts.MetricNamePrefix + "policy_events_total",
+		Help:        "The total number of events emitted per tracing policy and hook.",
+		ConstLabels: nil,
+	}, []string{"policy", "hook"})
+
+	// hooks of the PolicyEvents series of each policy, to delete them
+	// with the policy
+	policyHooks   = map[string]map[string]struct{}{}
+	policyHooksMu sync.Mutex
+)
+
+// Get a new handle on the metric for events emitted by a policy hook
+func GetEvents(policy, hook string) prometheus.Counter {
+	policyHooksMu.Lock()
+	defer policyHooksMu.Unlock()
+	hooks, ok := policyHooks[policy]
+	if !ok {
+		hooks = map[string]struct{}{}
+		policyHooks[policy] = hooks
+	}
+	hooks[hook] = struct{}{}
+	return PolicyEvents.WithLabelValues(policy, hook)
+}
+
+// Increment the metric for events emitted by a policy hook
+func EventsInc(policy, hook string) {
+	GetEvents(policy, hook).Inc()
+}
+
+// DeletePolicy deletes the metrics of the events emitted by a policy.
+func DeletePolicy(policy string) {
+	policyHooksMu.Lock()
+	defer policyHooksMu.Unlock()
+	for hook := range policyHooks[policy] {
+		PolicyEvents.DeleteLabelValues(policy, hook)
+	}
+	delete(policyHooks, policy)
+}
+
+// policyHandler deletes the metrics of deleted tracing policies.
+type policyHandler struct{}
+
+func (policyHandler) PolicyAdded(policyName string, spec interface{}) error {
+	return nil
+}
+
+func (policyHandler) PolicyDeleted(policyName string) {
+	DeletePolicy(policyName)
+}
+
+func init() {
+	sensors.RegisterPolicyHandlerAtInit("policy metrics", policyHandler{})
+}
+
+var (
+	policyEventsFiltered = prometheus.NewDesc(
+		consts.MetricNamePrefix+"policy_events_filtered_total",
+		"The total number of events filtered in-kernel by the selectors per tracing policy and hook.",
+		[]string{"policy", "hook"}, nil)
+	policyEventsLost = prometheus.NewDesc(
+		consts.MetricNamePrefix+"policy_events_lost_total",
+		"The total number of events per tracing policy and hook that could not be sent to the perf or ring buffer.",
+		[]string{"policy", "hook"}, nil)
+	policyActions = prometheus.NewDesc(
+		consts.MetricNamePrefix+"policy_actions_total",
+		"The total number of actions taken in-kernel per tracing policy, hook and action.",
+		[]string{"policy", "hook", "action"}, nil)
+	progRuns = prometheus.NewDesc(
+		consts.MetricNamePrefix+"bpf_prog_runs_total",
+		"The total number of runs per BPF program. Requires kernel.bpf_stats_enabled or --enable-bpf-stats.",
+		[]string{"policy", "hook", "program"}, nil)
+	progRunTime = prometheus.NewDesc(
+		consts.MetricNamePrefix+"bpf_prog_run_time_seconds_total",
+		"The total run time per BPF program. Requires kernel.bpf_stats_enabled or --enable-bpf-stats.",
+		[]string{"policy", "hook", "program"}, nil)
+)
+
+// BPFStats reports the counters read from the BPF maps and programs of the
+// loaded tracing policies when the metrics are collected.
+type BPFStats struct {
+	ch chan<- prometheus.Metric
+}
+
+func (s BPFStats) counter(desc *prometheus.Desc, val float64, labels ...string) {
+	s.ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, val, labels...)
+}
+
+// Filtered reports the events filtered in-kernel by a policy hook
+func (s BPFStats) Filtered(policy, hook string, val float64) {
+	s.counter(policyEventsFiltered, val, policy, hook)
+}
+
+// Lost reports the events lost by a policy hook
+func (s BPFStats) Lost(policy, hook string, val float64) {
+	s.counter(policyEventsLost, val, policy, hook)
+}
+
+// Actions reports the actions taken by a policy hook
+func (s BPFStats) Actions(policy, hook, action string, val float64) {
+	s.counter(policyActions, val, policy, hook, action)
+}
+
+// ProgRuns reports the runs of a BPF program
+func (s BPFStats) ProgRuns(policy, hook, program string, val float64) {
+	s.counter(progRuns, val, policy, hook, program)
+}
+
+// ProgRunTime reports the run time of a BPF program, in seconds
+func (s BPFStats) ProgRunTime(policy, hook, program string, val float64) {
+	s.counter(progRunTime, val, policy, hook, program)
+}
+
+// bpfStatsCollector reads the BPF statistics on each collection, so that the
+// hooks and programs of deleted policies are not reported anymore without
+// tracking them.
+type bpfStatsCollector struct {
+	read func(BPFStats)
+}
+
+func (c *bpfStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- policyEventsFiltered
+	ch <- policyEventsLost
+	ch <- policyActions
+	ch <- progRuns
+	ch <- progRunTime
+}
+
+func (c *bpfStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.read(BPFStats{ch: ch})
+}
+
+// NewBPFStatsCollector returns a collector of the statistics reported by read.
+func NewBPFStatsCollector(read func(BPFStats)) prometheus.Collector {
+	return &bpfStatsCollector{read: read}
+}
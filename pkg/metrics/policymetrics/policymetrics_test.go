@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policymetrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBPFStatsCollector(t *testing.T) {
+	loaded := true
+	c := NewBPFStatsCollector(func(s BPFStats) {
+		if !loaded {
+			return
+		}
+		s.Filtered("policy_a", "fd_install", 3)
+		s.Lost("policy_a", "fd_install", 1)
+		s.Actions("policy_a", "fd_install", "sigkill", 2)
+		s.ProgRuns("policy_a", "fd_install", "gkp-sensor-1-fd_install", 10)
+	})
+
+	expected := `# HELP tetragon_bpf_prog_runs_total The total number of runs per BPF program. Requires kernel.bpf_stats_enabled or --enable-bpf-stats.
+# TYPE tetragon_bpf_prog_runs_total counter
+tetragon_bpf_prog_runs_total{hook="fd_install",policy="policy_a",program="gkp-sensor-1-fd_install"} 10
+# HELP tetragon_policy_actions_total The total number of actions taken in-kernel per tracing policy, hook and action.
+# TYPE tetragon_policy_actions_total counter
+tetragon_policy_actions_total{action="sigkill",hook="fd_install",policy="policy_a"} 2
+# HELP tetragon_policy_events_filtered_total The total number of events filtered in-kernel by the selectors per tracing policy and hook.
+# TYPE tetragon_policy_events_filtered_total counter
+tetragon_policy_events_filtered_total{hook="fd_install",policy="policy_a"} 3
+# HELP tetragon_policy_events_lost_total The total number of events per tracing policy and hook that could not be sent to the perf or ring buffer.
+# TYPE tetragon_policy_events_lost_total counter
+tetragon_policy_events_lost_total{hook="fd_install",policy="policy_a"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(expected)))
+
+	// The programs of deleted policies are not reported anymore.
+	loaded = false
+	assert.Equal(t, 0, testutil.CollectAndCount(c))
+}
+
+func TestDeletePolicy(t *testing.T) {
+	EventsInc("policy_b", "fd_install")
+	EventsInc("policy_b", "sys_enter")
+	EventsInc("policy_c", "fd_install")
+	assert.Equal(t, float64(1), testutil.ToFloat64(GetEvents("policy_b", "fd_install")))
+
+	DeletePolicy("policy_b")
+	expected := `# HELP tetragon_policy_events_total The total number of events emitted per tracing policy and hook.
+# TYPE tetragon_policy_events_total counter
+tetragon_policy_events_total{hook="fd_install",policy="policy_c"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(PolicyEvents, strings.NewReader(expected)))
+}
@@ -20,6 +20,23 @@ var (
 		Help:        "The total of times an error occurs due to a parent and child process have the same exec id.",
 		ConstLabels: nil,
 	}, []string{"exec_id"})
+	BinaryHashes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name:        consts.MetricNamePrefix + "exec_binary_hash_total",
+		Help:        "The total of binary hash lookups for exec events by result.",
+		ConstLabels: nil,
+	}, []string{"result"})
+)
+
+// Binary hash lookup results
+const (
+	BinaryHashCacheHit = "cache_hit"
+	BinaryHashComputed = "computed"
+	BinaryHashDropped  = "dropped"
+	BinaryHashError    = "error"
+	// The file behind /proc/<pid>/exe is not the one of the exec event
+	BinaryHashExeMismatch = "exe_mismatch"
+	// The exec event was sent without waiting any longer for the hash
+	BinaryHashTimeout = "timeout"
 )
 
 // Get a new handle on the missingParentErrors metric for an execId
@@ -41,3 +58,8 @@ func GetSameExecId(execId string) prometheus.Counter {
 func SameExecIdInc(execId string) {
 	GetSameExecId(execId).Inc()
 }
+
+// Increment the binaryHashes metric for a lookup result
+func BinaryHashInc(result string) {
+	BinaryHashes.WithLabelValues(result).Inc()
+}
@@ -25,6 +25,11 @@ var (
 		Help:        "The total number of Tetragon ringbuf perf event error count.",
 		ConstLabels: nil,
 	}, nil)
+	ReserveErrors = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        consts.MetricNamePrefix + "ringbuf_reserve_errors",
+		Help:        "The total number of Tetragon events that could not be reserved in the BPF ring buffer.",
+		ConstLabels: nil,
+	}, nil)
 )
 
 // Get a new handle on the metric for received events
@@ -56,3 +61,13 @@ func GetErrors() prometheus.Gauge {
 func ErrorsSet(val float64) {
 	GetErrors().Set(val)
 }
+
+// Get a new handle on the metric for ring buffer reserve errors
+func GetReserveErrors() prometheus.Gauge {
+	return ReserveErrors.WithLabelValues()
+}
+
+// Get a new handle on the metric for ring buffer reserve errors
+func ReserveErrorsSet(val float64) {
+	GetReserveErrors().Set(val)
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package multiplexer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/cilium/tetragon/pkg/logger"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// How to connect to the gRPC servers of discovered pods.
+const (
+	// ConnectPortForward connects through a port-forward of the Kubernetes
+	// API server. It works from outside the cluster, and with gRPC servers
+	// listening on localhost only.
+	ConnectPortForward = "port-forward"
+	// ConnectPodIP connects to the IP of the pods. The gRPC servers must
+	// listen on an address reachable from the client.
+	ConnectPodIP = "pod-ip"
+)
+
+// K8sDiscovery finds the gRPC servers of the tetragon DaemonSet pods.
+type K8sDiscovery struct {
+	// Kubeconfig is the path to the kubeconfig file. The default loading
+	// rules (KUBECONFIG, ~/.kube/config, in-cluster) apply if empty.
+	Kubeconfig string
+	// Namespace and LabelSelector select the tetragon pods.
+	Namespace     string
+	LabelSelector string
+	// Port is the gRPC port of the tetragon pods.
+	Port int
+	// Connect is ConnectPortForward or ConnectPodIP.
+	Connect string
+}
+
+func (d *K8sDiscovery) restConfig() (*rest.Config, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = d.Kubeconfig
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{}).ClientConfig()
+}
+
+// Discover returns the addresses of the gRPC servers of the running tetragon
+// pods, and a function releasing the port-forwards, if any, once the
+// connections are no longer needed.
+func (d *K8sDiscovery) Discover(ctx context.Context) ([]string, func(), error) {
+	config, err := d.restConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	pods, err := client.CoreV1().Pods(d.Namespace).List(ctx, metav1.ListOptions{LabelSelector: d.LabelSelector})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list tetragon pods: %w", err)
+	}
+
+	var running []corev1.Pod
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodRunning {
+			running = append(running, pod)
+		}
+	}
+	if len(running) == 0 {
+		return nil, nil, fmt.Errorf("no running pod matches %q in namespace %s", d.LabelSelector, d.Namespace)
+	}
+
+	switch d.Connect {
+	case ConnectPodIP:
+		addrs := make([]string, 0, len(running))
+		for _, pod := range running {
+			if pod.Status.PodIP == "" {
+				continue
+			}
+			addrs = append(addrs, net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(d.Port)))
+		}
+		return addrs, func() {}, nil
+	case ConnectPortForward, "":
+		return d.portForward(config, client, running)
+	}
+	return nil, nil, fmt.Errorf("unknown connection mode %q, expected %s or %s", d.Connect, ConnectPortForward, ConnectPodIP)
+}
+
+// portForward forwards a random local port to the gRPC port of each pod.
+func (d *K8sDiscovery) portForward(config *rest.Config, client kubernetes.Interface, pods []corev1.Pod) ([]string, func(), error) {
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stopChans []chan struct{}
+	stop := func() {
+		for _, c := range stopChans {
+			close(c)
+		}
+	}
+	addrs := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		url := client.CoreV1().RESTClient().Post().
+			Resource("pods").
+			Namespace(pod.Namespace).
+			Name(pod.Name).
+			SubResource("portforward").
+			URL()
+		dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+		stopChan := make(chan struct{})
+		readyChan := make(chan struct{})
+		ports := []string{fmt.Sprintf("0:%d", d.Port)}
+		fw, err := portforward.NewOnAddresses(dialer, []string{"localhost"}, ports, stopChan, readyChan, io.Discard, io.Discard)
+		if err != nil {
+			stop()
+			return nil, nil, err
+		}
+		stopChans = append(stopChans, stopChan)
+
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- fw.ForwardPorts()
+		}()
+		select {
+		case <-readyChan:
+		case err := <-errChan:
+			stop()
+			return nil, nil, fmt.Errorf("failed to port-forward to pod %s: %w", pod.Name, err)
+		}
+		forwarded, err := fw.GetPorts()
+		if err != nil {
+			stop()
+			return nil, nil, err
+		}
+		addr := net.JoinHostPort("localhost", strconv.Itoa(int(forwarded[0].Local)))
+		logger.GetLogger().WithField("pod", pod.Name).WithField("node", pod.Spec.NodeName).WithField("addr", addr).Debug("Forwarding port to tetragon pod")
+		addrs = append(addrs, addr)
+	}
+	return addrs, stop, nil
+}
@@ -3,5 +3,7 @@
 
 // This package provides a multiplexer for combine one or more gRPC event streams into
 // a single stream. Useful for running the eventchecker across multiple gRPC connections
-// simultaneously, for example in a multi-node cluster.
+// simultaneously, for example in a multi-node cluster, or for following pods across
+// nodes with tetra getevents. It can also discover the gRPC servers of the tetragon
+// pods of a Kubernetes cluster and sort the merged stream by event time.
 package multiplexer
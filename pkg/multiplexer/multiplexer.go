@@ -37,6 +37,7 @@ type ClientMultiplexer struct {
 	clients        []tetragon.FineGuidanceSensorsClient
 	connectRetries int
 	connectBackoff time.Duration
+	dialOptions    []grpc.DialOption
 }
 
 // NewClientMultiplexer constructs a new ClientMultiplexer.
@@ -45,9 +46,17 @@ func NewClientMultiplexer() *ClientMultiplexer {
 		clients:        []tetragon.FineGuidanceSensorsClient{},
 		connectRetries: defaultConnectRetries,
 		connectBackoff: defaultConnectBackoff,
+		dialOptions:    []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())},
 	}
 }
 
+// WithDialOptions replaces the options used to dial each gRPC server. The
+// default is to connect without TLS.
+func (cm *ClientMultiplexer) WithDialOptions(opts ...grpc.DialOption) *ClientMultiplexer {
+	cm.dialOptions = opts
+	return cm
+}
+
 // WithConnectRetries updates the number of attempts this multiplexer will make to connect
 // to each gRPC server. The default is 10.
 func (cm *ClientMultiplexer) WithConnectRetries(retries uint) *ClientMultiplexer {
@@ -62,6 +71,11 @@ func (cm *ClientMultiplexer) WithConnectBackoff(backoff time.Duration) *ClientMu
 	return cm
 }
 
+// NumClients returns the number of servers the multiplexer is connected to.
+func (cm *ClientMultiplexer) NumClients() int {
+	return len(cm.clients)
+}
+
 // Connect connects the ClientMultiplexer to one or more gRPC servers specified addrs
 func (cm *ClientMultiplexer) Connect(ctx context.Context, connTimeout time.Duration, addrs ...string) error {
 	connCtx, connCancel := context.WithTimeout(ctx, connTimeout)
@@ -76,11 +90,7 @@ func (cm *ClientMultiplexer) Connect(ctx context.Context, connTimeout time.Durat
 		go func(addr string) {
 			defer wg.Done()
 
-			conn, err := grpc.DialContext(
-				connCtx,
-				addr,
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-			)
+			conn, err := grpc.DialContext(connCtx, addr, cm.dialOptions...)
 
 			if err != nil {
 				queue <- connResult{nil, fmt.Errorf("%s: %w", addr, err)}
@@ -129,16 +139,23 @@ func (cm *ClientMultiplexer) Connect(ctx context.Context, connTimeout time.Durat
 // multiplexes the GetEventsResponses. allowList and denyList can be used to filter what
 // events we care about.
 func (cm *ClientMultiplexer) GetEvents(ctx context.Context, allowList, denyList []*tetragon.Filter) (chan GetEventsResult, error) {
+	return cm.GetEventsWithRequest(ctx, &tetragon.GetEventsRequest{
+		AllowList: allowList,
+		DenyList:  denyList,
+	})
+}
+
+// GetEventsWithRequest calls GetEvents with request for each client in the
+// multiplexer and returns a channel that multiplexes the GetEventsResponses.
+// A stream stops after sending its first error.
+func (cm *ClientMultiplexer) GetEventsWithRequest(ctx context.Context, request *tetragon.GetEventsRequest) (chan GetEventsResult, error) {
 	c := make(chan GetEventsResult)
 
 	for _, client := range cm.clients {
 		var stream tetragon.FineGuidanceSensors_GetEventsClient
 		var err error
 		for i := 0; i < cm.connectRetries; i++ {
-			stream, err = client.GetEvents(ctx, &tetragon.GetEventsRequest{
-				AllowList: allowList,
-				DenyList:  denyList,
-			})
+			stream, err = client.GetEvents(ctx, request)
 			if err == nil {
 				break
 			}
@@ -156,7 +173,14 @@ func (cm *ClientMultiplexer) GetEvents(ctx context.Context, allowList, denyList
 				default:
 				}
 				res, err := stream.Recv()
-				c <- GetEventsResult{res, err}
+				select {
+				case c <- GetEventsResult{res, err}:
+				case <-ctx.Done():
+					return
+				}
+				if err != nil {
+					return
+				}
 			}
 		}(stream)
 	}
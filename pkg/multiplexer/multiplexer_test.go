@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package multiplexer
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeServer sends events at the given times and waits for the client to
+// close the stream.
+type fakeServer struct {
+	tetragon.UnimplementedFineGuidanceSensorsServer
+	node  string
+	times []int64
+}
+
+func (s *fakeServer) GetEvents(_ *tetragon.GetEventsRequest, stream tetragon.FineGuidanceSensors_GetEventsServer) error {
+	for _, sec := range s.times {
+		if err := stream.Send(&tetragon.GetEventsResponse{
+			NodeName: s.node,
+			Time:     &timestamppb.Timestamp{Seconds: sec},
+		}); err != nil {
+			return err
+		}
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+func startServer(t *testing.T, srv tetragon.FineGuidanceSensorsServer) string {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	tetragon.RegisterFineGuidanceSensorsServer(grpcServer, srv)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+	return listener.Addr().String()
+}
+
+func TestMultiplexerSorted(t *testing.T) {
+	addrA := startServer(t, &fakeServer{node: "node-a", times: []int64{1, 4, 5}})
+	addrB := startServer(t, &fakeServer{node: "node-b", times: []int64{2, 3, 6}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	cm := NewClientMultiplexer().WithConnectRetries(1)
+	require.NoError(t, cm.Connect(ctx, 5*time.Second, addrA, addrB))
+	assert.Equal(t, 2, cm.NumClients())
+
+	events, err := cm.GetEventsWithRequest(ctx, &tetragon.GetEventsRequest{})
+	require.NoError(t, err)
+	sorted := SortByTime(ctx, events, 200*time.Millisecond, 0)
+
+	var times []int64
+	nodes := map[string]int{}
+	for len(times) < 6 {
+		res := <-sorted
+		require.NoError(t, res.Error)
+		times = append(times, res.Time.Seconds)
+		nodes[res.NodeName]++
+	}
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6}, times)
+	assert.Equal(t, map[string]int{"node-a": 3, "node-b": 3}, nodes)
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package multiplexer
+
+import (
+	"container/heap"
+	"context"
+	"time"
+)
+
+// DefaultSortBufferSize is the maximum number of events held by SortByTime.
+const DefaultSortBufferSize = 10000
+
+type pendingEvent struct {
+	GetEventsResult
+	received time.Time
+}
+
+// eventHeap orders pending events by event time.
+type eventHeap []pendingEvent
+
+func (h eventHeap) Len() int { return len(h) }
+func (h eventHeap) Less(i, j int) bool {
+	return h[i].GetTime().AsTime().Before(h[j].GetTime().AsTime())
+}
+func (h eventHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *eventHeap) Push(x interface{}) { *h = append(*h, x.(pendingEvent)) }
+func (h *eventHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// SortByTime reorders the events of in, merged from several servers, by event
+// time. Each event is held for up to delay after it was received, so that the
+// events of the other servers within that window can be sent before it. At
+// most bufferSize events are held, the oldest ones are sent early when the
+// buffer is full. Errors are forwarded after the held events. The returned
+// channel is closed when ctx is done or in is closed, after sending the held
+// events.
+func SortByTime(ctx context.Context, in <-chan GetEventsResult, delay time.Duration, bufferSize int) <-chan GetEventsResult {
+	if bufferSize <= 0 {
+		bufferSize = DefaultSortBufferSize
+	}
+	out := make(chan GetEventsResult)
+	go func() {
+		defer close(out)
+		var pending eventHeap
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		send := func(res GetEventsResult) bool {
+			select {
+			case out <- res:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		// flush sends the events received before deadline, or all of them
+		// if all is set, and rearms the timer for the next one.
+		flush := func(now time.Time, all bool) bool {
+			for pending.Len() > 0 {
+				if !all && pending.Len() <= bufferSize && pending[0].received.Add(delay).After(now) {
+					break
+				}
+				ev := heap.Pop(&pending).(pendingEvent)
+				if !send(ev.GetEventsResult) {
+					return false
+				}
+			}
+			if pending.Len() > 0 {
+				timer.Reset(pending[0].received.Add(delay).Sub(now))
+			}
+			return true
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case res, ok := <-in:
+				if !ok {
+					flush(time.Now(), true)
+					return
+				}
+				if res.Error != nil || res.GetEventsResponse == nil {
+					// The events received before the error are sent
+					// first, so that they are not lost if the
+					// consumer stops on errors.
+					if !flush(time.Now(), true) || !send(res) {
+						return
+					}
+					continue
+				}
+				heap.Push(&pending, pendingEvent{res, time.Now()})
+				if !flush(time.Now(), false) {
+					return
+				}
+			case now := <-timer.C:
+				if !flush(now, false) {
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
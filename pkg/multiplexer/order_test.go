@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package multiplexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func eventAt(node string, sec int64) GetEventsResult {
+	return GetEventsResult{GetEventsResponse: &tetragon.GetEventsResponse{
+		NodeName: node,
+		Time:     &timestamppb.Timestamp{Seconds: sec},
+	}}
+}
+
+func collect(c <-chan GetEventsResult) []GetEventsResult {
+	var ret []GetEventsResult
+	for res := range c {
+		ret = append(ret, res)
+	}
+	return ret
+}
+
+func TestSortByTime(t *testing.T) {
+	in := make(chan GetEventsResult, 10)
+	in <- eventAt("node-a", 3)
+	in <- eventAt("node-b", 1)
+	in <- eventAt("node-a", 4)
+	in <- eventAt("node-b", 2)
+	close(in)
+
+	out := collect(SortByTime(context.Background(), in, time.Minute, 0))
+	var times []int64
+	for _, res := range out {
+		times = append(times, res.Time.Seconds)
+	}
+	assert.Equal(t, []int64{1, 2, 3, 4}, times)
+}
+
+func TestSortByTimeDelay(t *testing.T) {
+	in := make(chan GetEventsResult)
+	out := SortByTime(context.Background(), in, 10*time.Millisecond, 0)
+
+	// Events are sent once the delay expired, without waiting for more.
+	in <- eventAt("node-a", 2)
+	in <- eventAt("node-b", 1)
+	assert.Equal(t, int64(1), (<-out).Time.Seconds)
+	assert.Equal(t, int64(2), (<-out).Time.Seconds)
+	close(in)
+	assert.Empty(t, collect(out))
+}
+
+func TestSortByTimeBufferSize(t *testing.T) {
+	in := make(chan GetEventsResult)
+	out := SortByTime(context.Background(), in, time.Minute, 2)
+
+	// The third event overflows the buffer, the oldest one is sent early.
+	go func() {
+		in <- eventAt("node-a", 3)
+		in <- eventAt("node-a", 2)
+		in <- eventAt("node-a", 1)
+	}()
+	assert.Equal(t, int64(1), (<-out).Time.Seconds)
+}
+
+func TestSortByTimeErrors(t *testing.T) {
+	in := make(chan GetEventsResult, 10)
+	in <- eventAt("node-a", 1)
+	in <- GetEventsResult{Error: errors.New("stream failed")}
+	close(in)
+
+	out := collect(SortByTime(context.Background(), in, time.Minute, 0))
+	assert.Len(t, out, 2)
+	assert.Equal(t, int64(1), out[0].Time.Seconds)
+	assert.Error(t, out[1].Error)
+}
@@ -9,13 +9,14 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/cilium/ebpf"
-	"github.com/cilium/ebpf/perf"
 	"github.com/cilium/tetragon/pkg/api/readyapi"
 	"github.com/cilium/tetragon/pkg/bpf"
+	"github.com/cilium/tetragon/pkg/health"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/metrics/ringbufmetrics"
 	"github.com/cilium/tetragon/pkg/option"
@@ -88,22 +89,28 @@ func (k *Observer) RemoveListener(listener Listener) {
 	}
 }
 
-func (k *Observer) receiveEvent(data []byte, cpu int) {
+// decodeEvent decodes a raw sample with the handler of its op.
+func (k *Observer) decodeEvent(data []byte) []Event {
 	var op = data[0]
 
-	k.recvCntr++
 	r := bytes.NewReader(data)
 
 	// These ops handlers are registered by RegisterEventHandlerAtInit().
 	if h, ok := eventHandler[op]; ok {
 		if events, err := h(r); err == nil {
-			for _, event := range events {
-				k.observerListeners(event)
-			}
+			return events
 		}
 	} else {
 		k.log.Infof("unknown op ignored: %v", op)
 	}
+	return nil
+}
+
+func (k *Observer) receiveEvent(data []byte, cpu int) {
+	k.recvCntr++
+	for _, event := range k.decodeEvent(data) {
+		k.observerListeners(event)
+	}
 }
 
 func (k *Observer) __runEvents(stopCtx context.Context) (*bpf.PerCpuEvents, error) {
@@ -139,6 +146,7 @@ func (k *Observer) __loopEvents(stopCtx context.Context, e *bpf.PerCpuEvents) er
 
 	k.log.Info("Listening for events...")
 	k.observerListeners(&readyapi.MsgTetragonReady{})
+	health.SetStatus(health.ObserverReader, health.Serving, "")
 
 	for !isCtxDone(stopCtx) {
 		_, err := e.Poll(pollTimeoutMsec)
@@ -185,9 +193,19 @@ func (k *Observer) runEventsNew(stopCtx context.Context, ready func()) error {
 	}
 	defer perfMap.Close()
 
-	perfReader, err := perf.NewReader(perfMap, perCPUBufferBytes)
+	perfReader, err := newEventsReader(perfMap)
 	if err != nil {
-		return fmt.Errorf("creating perf array reader failed: %w", err)
+		return err
+	}
+	k.log.WithField("type", perfMap.Type()).Info("Reading events map")
+
+	receiveEvent := k.receiveEvent
+	if workers := option.Config.EventDecodeWorkers; workers > 0 {
+		p := newPipeline(k, workers)
+		p.start()
+		// Runs once the reader below is done.
+		defer p.stop()
+		receiveEvent = p.receiveEvent
 	}
 
 	// Inform caller that we're about to start processing events.
@@ -197,8 +215,9 @@ func (k *Observer) runEventsNew(stopCtx context.Context, ready func()) error {
 	// Listeners are ready and about to start reading from perf reader, tell
 	// user everything is ready.
 	k.log.Info("Listening for events...")
+	health.SetStatus(health.ObserverReader, health.Serving, "")
 
-	// Start reading records from the perf array. Reads until the reader is closed.
+	// Start reading records from the events map. Reads until the reader is closed.
 	var wg sync.WaitGroup
 	wg.Add(1)
 	defer wg.Wait()
@@ -215,7 +234,8 @@ func (k *Observer) runEventsNew(stopCtx context.Context, ready func()) error {
 				}
 			} else {
 				if len(record.RawSample) > 0 {
-					k.receiveEvent(record.RawSample, record.CPU)
+					k.recordSample(record.RawSample, record.CPU)
+					receiveEvent(record.RawSample, record.CPU)
 					ringbufmetrics.ReceivedSet(float64(k.recvCntr))
 				}
 
@@ -250,6 +270,10 @@ type Observer struct {
 	/* Filters */
 	log logrus.FieldLogger
 
+	/* Sample recording, see StartRecording */
+	recorder     atomic.Value // *sampleRecorder
+	recorderLock sync.Mutex
+
 	/* YAML Configuration File */
 	configFile string
 }
@@ -270,6 +294,7 @@ func (k *Observer) Start(ctx context.Context, sens []*sensors.Sensor) error {
 	}
 
 	k.perfConfig = bpf.DefaultPerfEventConfig()
+	health.SetStatus(health.ObserverReader, health.Starting, "")
 
 	var err error
 	if useCiliumEbpfReader {
@@ -278,6 +303,7 @@ func (k *Observer) Start(ctx context.Context, sens []*sensors.Sensor) error {
 		err = k.runEvents(ctx)
 	}
 	if err != nil {
+		health.SetStatus(health.ObserverReader, health.Failed, err.Error())
 		return fmt.Errorf("tetragon, aborting runtime error: %w", err)
 	}
 	return nil
@@ -287,7 +313,12 @@ func (k *Observer) Start(ctx context.Context, sens []*sensors.Sensor) error {
 func (k *Observer) InitSensorManager() error {
 	var err error
 	SensorManager, err = sensors.StartSensorManager(option.Config.BpfDir, option.Config.MapDir, option.Config.CiliumDir)
-	return err
+	if err != nil {
+		health.SetStatus(health.SensorManager, health.Failed, err.Error())
+		return err
+	}
+	health.SetStatus(health.SensorManager, health.Serving, "")
+	return nil
 }
 
 func NewObserver(configFile string) *Observer {
@@ -7,13 +7,20 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"sync"
 	"time"
 	"unsafe"
 
+	"github.com/cilium/ebpf"
 	"github.com/cilium/tetragon/pkg/bpf"
 	"github.com/cilium/tetragon/pkg/metrics/mapmetrics"
+	"github.com/cilium/tetragon/pkg/metrics/policymetrics"
+	"github.com/cilium/tetragon/pkg/metrics/ringbufmetrics"
 	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/selectors"
 	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 type statKey struct {
@@ -47,8 +54,104 @@ func (s *statValue) DeepCopyMapValue() bpf.MapValue {
 	return v
 }
 
+// updateEventsErrors sets the metric of the events that could not be reserved
+// in the ring buffer from the per-CPU counters of tcpmon_map_errors.
+func updateEventsErrors() {
+	m, err := ebpf.LoadPinnedMap(filepath.Join(option.Config.MapDir, program.EventsErrorsMapName), nil)
+	if err != nil {
+		return
+	}
+	defer m.Close()
+
+	var values []int64
+	if err := m.Lookup(uint32(0), &values); err != nil {
+		return
+	}
+	sum := int64(0)
+	for _, v := range values {
+		sum += v
+	}
+	ringbufmetrics.ReserveErrorsSet(float64(sum))
+}
+
+// collectPolicyStats reports the metrics of a tracing policy hook from the
+// per-CPU counters of its policy_stats map.
+func collectPolicyStats(s policymetrics.BPFStats, p *program.Program) {
+	pin, ok := p.PinMap[program.PolicyStatsMapName]
+	if !ok {
+		return
+	}
+	m, err := ebpf.LoadPinnedMap(filepath.Join(option.Config.MapDir, pin), nil)
+	if err != nil {
+		return
+	}
+	defer m.Close()
+
+	var values []uint64
+	sum := func(idx uint32) float64 {
+		if err := m.Lookup(idx, &values); err != nil {
+			return 0
+		}
+		total := uint64(0)
+		for _, v := range values {
+			total += v
+		}
+		return float64(total)
+	}
+	s.Filtered(p.Policy, p.Attach, sum(program.PolicyStatsFiltered))
+	s.Lost(p.Policy, p.Attach, sum(program.PolicyStatsLost))
+	for idx := uint32(program.PolicyStatsAction); idx < program.PolicyStatsMax; idx++ {
+		if v := sum(idx); v > 0 {
+			s.Actions(p.Policy, p.Attach, selectors.ActionTypeString(idx-program.PolicyStatsAction), v)
+		}
+	}
+}
+
+// collectProgStats reports the run count and run time metrics of a program
+// from its bpf_prog_info. The kernel only accounts them while BPF statistics
+// are enabled, see --enable-bpf-stats.
+func collectProgStats(s policymetrics.BPFStats, p *program.Program) {
+	prog, err := ebpf.LoadPinnedProgram(filepath.Join(option.Config.BpfDir, p.PinPath), nil)
+	if err != nil {
+		return
+	}
+	defer prog.Close()
+
+	info, err := prog.Info()
+	if err != nil {
+		return
+	}
+	if runs, ok := info.RunCount(); ok {
+		s.ProgRuns(p.Policy, p.Attach, p.PinPath, float64(runs))
+	}
+	if runTime, ok := info.Runtime(); ok {
+		s.ProgRunTime(p.Policy, p.Attach, p.PinPath, runTime.Seconds())
+	}
+}
+
+// collectProgramMetrics reports the metrics of the loaded programs when the
+// metrics are scraped, so that unloaded programs are not reported anymore.
+func collectProgramMetrics(s policymetrics.BPFStats) {
+	for _, p := range sensors.AllPrograms {
+		if !p.LoadState.IsLoaded() {
+			continue
+		}
+		collectProgStats(s, p)
+		// The retprobe shares the policy_stats map of its kprobe.
+		if p.Policy != "" && !p.RetProbe {
+			collectPolicyStats(s, p)
+		}
+	}
+}
+
+var registerProgramMetricsOnce sync.Once
+
 func (k *Observer) startUpdateMapMetrics() {
+	registerProgramMetricsOnce.Do(func() {
+		prometheus.MustRegister(policymetrics.NewBPFStatsCollector(collectProgramMetrics))
+	})
 	update := func() {
+		updateEventsErrors()
 		for _, m := range sensors.AllMaps {
 			pin := filepath.Join(option.Config.MapDir, m.Name)
 			pinStats := pin + "_stats"
@@ -115,7 +115,7 @@ func withNotestfail(notestfail bool) TestOption {
 func testDone(t *testing.T, obs *Observer) {
 	if t.Failed() {
 		bugtoolFname := "/tmp/tetragon-bugtool.tar.gz"
-		if err := bugtool.Bugtool(bugtoolFname); err == nil {
+		if err := bugtool.Bugtool(bugtoolFname, 0, nil); err == nil {
 			logger.GetLogger().WithField("test", t.Name()).
 				WithField("file", bugtoolFname).Info("Dumped bugtool info")
 		} else {
@@ -278,7 +278,7 @@ func getDefaultObserverSensors(t *testing.T, ctx context.Context, base *sensors.
 	cnf, _ := readConfig(o.observer.config)
 	if cnf != nil {
 		var err error
-		sens, err = sensors.GetSensorsFromParserPolicy(&cnf.Spec)
+		sens, err = sensors.GetSensorsFromParserPolicy(cnf.Metadata.Name, &cnf.Spec)
 		if err != nil {
 			return nil, ret, err
 		}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package observer
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/metrics/observermetrics"
+)
+
+const (
+	// decodeQueueSize is the number of raw samples queued for each
+	// decoding worker.
+	decodeQueueSize = 1024
+	// dispatchQueueSize is the number of decoded samples queued for the
+	// listeners.
+	dispatchQueueSize = 4096
+
+	queueMetricsInterval = time.Second
+)
+
+var (
+	msgCommonSize = binary.Size(processapi.MsgCommon{})
+
+	shardKeys = make(map[uint8]func(data []byte) uint32)
+)
+
+// RegisterShardKeyAtInit registers how to find the process of the raw samples
+// of op, for samples that do not have the processapi.MsgExecveKey of their
+// process right after their processapi.MsgCommon header. key returns the tgid
+// of the process: samples of a process are decoded in order by the same
+// worker.
+func RegisterShardKeyAtInit(op uint8, key func(data []byte) uint32) {
+	shardKeys[op] = key
+}
+
+// shardKey returns the tgid of the process of a raw sample, or 0 if there is
+// none.
+func shardKey(data []byte) uint32 {
+	if key, ok := shardKeys[data[0]]; ok {
+		return key(data)
+	}
+	if len(data) < msgCommonSize+4 {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(data[msgCommonSize:])
+}
+
+// pipeline processes the raw samples of the events map in stages:
+//
+//	reader -> decoders -> dispatcher -> listeners
+//
+// The reader queues the samples to the decoder of their process, so that the
+// events of a process keep their order. Decoders run the handlers registered
+// with RegisterEventHandlerAtInit and queue the events to the dispatcher,
+// which notifies the listeners. Listeners enrich the events from the process
+// cache and fan them out to the clients.
+type pipeline struct {
+	obs      *Observer
+	decoders []chan []byte
+	dispatch chan []Event
+
+	decodersWG sync.WaitGroup
+	dispatchWG sync.WaitGroup
+	done       chan struct{}
+}
+
+func newPipeline(k *Observer, workers int) *pipeline {
+	p := &pipeline{
+		obs:      k,
+		decoders: make([]chan []byte, workers),
+		dispatch: make(chan []Event, dispatchQueueSize),
+		done:     make(chan struct{}),
+	}
+	for i := range p.decoders {
+		p.decoders[i] = make(chan []byte, decodeQueueSize)
+	}
+	return p
+}
+
+func (p *pipeline) start() {
+	for _, queue := range p.decoders {
+		p.decodersWG.Add(1)
+		go func(queue chan []byte) {
+			defer p.decodersWG.Done()
+			for data := range queue {
+				if events := p.obs.decodeEvent(data); len(events) > 0 {
+					p.dispatch <- events
+				}
+			}
+		}(queue)
+	}
+
+	p.dispatchWG.Add(1)
+	go func() {
+		defer p.dispatchWG.Done()
+		for events := range p.dispatch {
+			for _, event := range events {
+				p.obs.observerListeners(event)
+			}
+		}
+	}()
+
+	observermetrics.QueueCapacitySet(observermetrics.StageDecode, float64(len(p.decoders)*decodeQueueSize))
+	observermetrics.QueueCapacitySet(observermetrics.StageDispatch, dispatchQueueSize)
+	go func() {
+		ticker := time.NewTicker(queueMetricsInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				p.updateMetrics()
+			case <-p.done:
+				p.updateMetrics()
+				return
+			}
+		}
+	}()
+}
+
+func (p *pipeline) updateMetrics() {
+	decode := 0
+	for _, queue := range p.decoders {
+		decode += len(queue)
+	}
+	observermetrics.QueueDepthSet(observermetrics.StageDecode, float64(decode))
+	observermetrics.QueueDepthSet(observermetrics.StageDispatch, float64(len(p.dispatch)))
+}
+
+// receiveEvent queues a raw sample to the decoder of its process. The sample
+// must not be reused by the caller.
+func (p *pipeline) receiveEvent(data []byte, cpu int) {
+	if len(data) == 0 {
+		return
+	}
+	p.obs.recvCntr++
+	p.decoders[shardKey(data)%uint32(len(p.decoders))] <- data
+}
+
+// stop waits for the queued samples to be processed. receiveEvent must not be
+// called anymore.
+func (p *pipeline) stop() {
+	for _, queue := range p.decoders {
+		close(queue)
+	}
+	p.decodersWG.Wait()
+	close(p.dispatch)
+	p.dispatchWG.Wait()
+	close(p.done)
+}
+
+// ReplaySamples processes samples as if they were read from the events map,
+// with workers decoding workers or synchronously if workers is 0, and returns
+// once the listeners were notified of all the events.
+func (k *Observer) ReplaySamples(samples []Sample, workers int) {
+	i := 0
+	k.replay(func() (Sample, error) {
+		if i == len(samples) {
+			return Sample{}, io.EOF
+		}
+		i++
+		return samples[i-1], nil
+	}, workers)
+}
+
+// ReplaySampleReader processes the samples of sr, see ReplaySamples, and
+// returns the number of samples processed.
+func (k *Observer) ReplaySampleReader(sr *SampleReader, workers int) (int, error) {
+	return k.replay(sr.Read, workers)
+}
+
+func (k *Observer) replay(next func() (Sample, error), workers int) (int, error) {
+	receiveEvent := k.receiveEvent
+	if workers > 0 {
+		p := newPipeline(k, workers)
+		p.start()
+		defer p.stop()
+		receiveEvent = p.receiveEvent
+	}
+	for n := 0; ; n++ {
+		s, err := next()
+		if errors.Is(err, io.EOF) {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+		receiveEvent(s.Data, s.CPU)
+	}
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package observer_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/api/testapi"
+	"github.com/cilium/tetragon/pkg/observer"
+	"github.com/cilium/tetragon/pkg/reader/notify"
+	"github.com/stretchr/testify/require"
+
+	// Register the handlers of the samples.
+	_ "github.com/cilium/tetragon/pkg/data"
+	_ "github.com/cilium/tetragon/pkg/sensors/cred"
+	_ "github.com/cilium/tetragon/pkg/sensors/exec"
+	_ "github.com/cilium/tetragon/pkg/sensors/integrity"
+	_ "github.com/cilium/tetragon/pkg/sensors/mount"
+	_ "github.com/cilium/tetragon/pkg/sensors/ns"
+	_ "github.com/cilium/tetragon/pkg/sensors/test"
+	_ "github.com/cilium/tetragon/pkg/sensors/tracing"
+)
+
+var samplesFile = flag.String("samples", "", "sample file to replay instead of synthetic test samples")
+
+type countListener struct {
+	count uint64
+}
+
+func (l *countListener) Notify(msg notify.Message) error {
+	atomic.AddUint64(&l.count, 1)
+	return nil
+}
+
+func (l *countListener) Close() error {
+	return nil
+}
+
+// benchmarkSamples returns the samples of -samples, or test events of 100
+// processes.
+func benchmarkSamples(b *testing.B) []observer.Sample {
+	if *samplesFile != "" {
+		f, err := os.Open(*samplesFile)
+		require.NoError(b, err)
+		defer f.Close()
+		samples, err := observer.ReadSamples(f)
+		require.NoError(b, err)
+		return samples
+	}
+
+	var samples []observer.Sample
+	for i := uint64(0); i < 100000; i++ {
+		m := testapi.MsgTestEvent{
+			Common: processapi.MsgCommon{Op: ops.MSG_OP_TEST},
+			Arg0:   i%100 + 1,
+			Arg1:   i,
+		}
+		var buf bytes.Buffer
+		require.NoError(b, binary.Write(&buf, binary.LittleEndian, &m))
+		samples = append(samples, observer.Sample{CPU: int(i % 4), Data: buf.Bytes()})
+	}
+	return samples
+}
+
+// BenchmarkReplaySamples replays samples through the observer with different
+// numbers of decoding workers. Samples recorded from a running agent can be
+// replayed with:
+//
+//	go test ./pkg/observer -run '^$' -bench ReplaySamples -args -samples <file>
+func BenchmarkReplaySamples(b *testing.B) {
+	samples := benchmarkSamples(b)
+	for _, workers := range []int{0, 1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			k := observer.NewObserver("")
+			l := &countListener{}
+			k.AddListener(l)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				k.ReplaySamples(samples, workers)
+			}
+			b.StopTimer()
+			b.ReportMetric(float64(atomic.LoadUint64(&l.count))/b.Elapsed().Seconds(), "events/s")
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package observer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+	"testing"
+
+	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/reader/notify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pipelineEvent is decoded from the MSG_OP_TEST samples built by testSample.
+type pipelineEvent struct {
+	notify.Message
+	pid uint32
+	seq uint32
+}
+
+type pipelineListener struct {
+	mu     sync.Mutex
+	events []pipelineEvent
+}
+
+func (l *pipelineListener) Notify(msg notify.Message) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if ev, ok := msg.(*pipelineEvent); ok {
+		l.events = append(l.events, *ev)
+	}
+	return nil
+}
+
+func (l *pipelineListener) Close() error {
+	return nil
+}
+
+func testSample(pid, seq uint32) Sample {
+	data := make([]byte, msgCommonSize+8)
+	data[0] = ops.MSG_OP_TEST
+	binary.LittleEndian.PutUint32(data[msgCommonSize:], pid)
+	binary.LittleEndian.PutUint32(data[msgCommonSize+4:], seq)
+	return Sample{CPU: int(pid % 4), Data: data}
+}
+
+func handlePipelineTest(r *bytes.Reader) ([]Event, error) {
+	var m struct {
+		Common processapi.MsgCommon
+		Pid    uint32
+		Seq    uint32
+	}
+	if err := binary.Read(r, binary.LittleEndian, &m); err != nil {
+		return nil, err
+	}
+	return []Event{&pipelineEvent{pid: m.Pid, seq: m.Seq}}, nil
+}
+
+func newPipelineObserver(t *testing.T) (*Observer, *pipelineListener) {
+	prev, ok := eventHandler[ops.MSG_OP_TEST]
+	eventHandler[ops.MSG_OP_TEST] = handlePipelineTest
+	t.Cleanup(func() {
+		if ok {
+			eventHandler[ops.MSG_OP_TEST] = prev
+		} else {
+			delete(eventHandler, ops.MSG_OP_TEST)
+		}
+	})
+
+	k := NewObserver("")
+	l := &pipelineListener{}
+	k.AddListener(l)
+	return k, l
+}
+
+func TestShardKey(t *testing.T) {
+	s := testSample(1234, 0)
+	assert.Equal(t, uint32(1234), shardKey(s.Data))
+	assert.Equal(t, uint32(0), shardKey([]byte{ops.MSG_OP_TEST}))
+
+	const op = 200
+	RegisterShardKeyAtInit(op, func(data []byte) uint32 { return 42 })
+	defer delete(shardKeys, op)
+	s.Data[0] = op
+	assert.Equal(t, uint32(42), shardKey(s.Data))
+}
+
+func TestPipelineOrder(t *testing.T) {
+	const pids, perPid = 32, 200
+
+	var samples []Sample
+	for seq := uint32(0); seq < perPid; seq++ {
+		for pid := uint32(1); pid <= pids; pid++ {
+			samples = append(samples, testSample(pid, seq))
+		}
+	}
+
+	for _, workers := range []int{0, 1, 4, 7} {
+		k, l := newPipelineObserver(t)
+		k.ReplaySamples(samples, workers)
+
+		require.Len(t, l.events, len(samples), "workers %d", workers)
+		next := map[uint32]uint32{}
+		for _, ev := range l.events {
+			require.Equal(t, next[ev.pid], ev.seq, "workers %d pid %d", workers, ev.pid)
+			next[ev.pid]++
+		}
+		assert.Equal(t, len(samples), k.recvCntr, "workers %d", workers)
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package observer
+
+import (
+	"fmt"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/perf"
+	"github.com/cilium/ebpf/ringbuf"
+)
+
+// eventsRecord is a sample read from the events map.
+type eventsRecord struct {
+	RawSample []byte
+	// CPU is the CPU the sample was written from, -1 for ring buffers.
+	CPU int
+	// LostSamples is the number of samples lost because the perf buffer
+	// of CPU was full. Ring buffers count them in tcpmon_map_errors.
+	LostSamples uint64
+}
+
+// eventsReader reads the events map, a BPF ring buffer or a perf event array
+// depending on the kernel.
+type eventsReader interface {
+	Read() (eventsRecord, error)
+	Close() error
+}
+
+type perfEventsReader struct {
+	*perf.Reader
+}
+
+func (r perfEventsReader) Read() (eventsRecord, error) {
+	record, err := r.Reader.Read()
+	if err != nil {
+		return eventsRecord{}, err
+	}
+	return eventsRecord{
+		RawSample:   record.RawSample,
+		CPU:         record.CPU,
+		LostSamples: record.LostSamples,
+	}, nil
+}
+
+type ringBufEventsReader struct {
+	*ringbuf.Reader
+}
+
+func (r ringBufEventsReader) Read() (eventsRecord, error) {
+	record, err := r.Reader.Read()
+	if err != nil {
+		return eventsRecord{}, err
+	}
+	return eventsRecord{RawSample: record.RawSample, CPU: -1}, nil
+}
+
+func newEventsReader(m *ebpf.Map) (eventsReader, error) {
+	if m.Type() == ebpf.RingBuf {
+		r, err := ringbuf.NewReader(m)
+		if err != nil {
+			return nil, fmt.Errorf("creating ring buffer reader failed: %w", err)
+		}
+		return ringBufEventsReader{r}, nil
+	}
+	r, err := perf.NewReader(m, perCPUBufferBytes)
+	if err != nil {
+		return nil, fmt.Errorf("creating perf array reader failed: %w", err)
+	}
+	return perfEventsReader{r}, nil
+}
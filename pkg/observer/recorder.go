@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package observer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cilium/tetragon/pkg/ktime"
+	"golang.org/x/sys/unix"
+)
+
+// sampleRecorder writes the raw samples read by the observer to a sample
+// file.
+type sampleRecorder struct {
+	mu       sync.Mutex
+	w        *SampleWriter
+	max      int
+	maxBytes int
+	count    int
+	bytes    int
+	err      error
+	// full is closed once max samples or maxBytes were recorded.
+	full     chan struct{}
+	fullDone bool
+}
+
+func (r *sampleRecorder) setFull() {
+	if !r.fullDone {
+		r.fullDone = true
+		close(r.full)
+	}
+}
+
+func (r *sampleRecorder) record(data []byte, cpu int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil || r.fullDone {
+		return
+	}
+	size := sampleHeaderSize + len(data)
+	if r.maxBytes > 0 && r.bytes+size > r.maxBytes {
+		r.setFull()
+		return
+	}
+	now := unix.Timespec{}
+	unix.ClockGettime(unix.CLOCK_MONOTONIC, &now)
+	r.err = r.w.Write(Sample{CPU: cpu, Time: now.Nano(), Data: data})
+	r.count++
+	r.bytes += size
+	if r.count == r.max {
+		r.setFull()
+	}
+}
+
+// StartRecording records the raw samples read from the events map, and the
+// ones passed to RecordSample, to w until StopRecording is called. At most
+// maxSamples samples are recorded, 0 meaning no limit. The recording can be
+// replayed with ReplaySampleReader.
+func (k *Observer) StartRecording(w io.Writer, maxSamples int) error {
+	return k.startRecording(w, maxSamples, 0)
+}
+
+func (k *Observer) startRecording(w io.Writer, maxSamples, maxBytes int) error {
+	k.recorderLock.Lock()
+	defer k.recorderLock.Unlock()
+	if r, _ := k.recorder.Load().(*sampleRecorder); r != nil {
+		return errors.New("already recording")
+	}
+	ref, err := ktime.NewClockReference()
+	if err != nil {
+		return err
+	}
+	sw, err := NewSampleWriter(w, ref)
+	if err != nil {
+		return err
+	}
+	k.recorder.Store(&sampleRecorder{
+		w:        sw,
+		max:      maxSamples,
+		maxBytes: maxBytes,
+		full:     make(chan struct{}),
+	})
+	return nil
+}
+
+// StopRecording stops the recording started by StartRecording and returns the
+// number of samples recorded.
+func (k *Observer) StopRecording() (int, error) {
+	k.recorderLock.Lock()
+	defer k.recorderLock.Unlock()
+	r, _ := k.recorder.Load().(*sampleRecorder)
+	if r == nil {
+		return 0, errors.New("not recording")
+	}
+	k.recorder.Store((*sampleRecorder)(nil))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.count, r.err
+	}
+	return r.count, r.w.Flush()
+}
+
+// RecordSamples records samples to w, see StartRecording, for duration or
+// until maxSamples samples or maxBytes bytes of samples were recorded or ctx
+// is done. A limit of 0 means no limit.
+func (k *Observer) RecordSamples(ctx context.Context, w io.Writer, duration time.Duration, maxSamples, maxBytes int) (int, error) {
+	if err := k.startRecording(w, maxSamples, maxBytes); err != nil {
+		return 0, err
+	}
+	r := k.recorder.Load().(*sampleRecorder)
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-r.full:
+	case <-ctx.Done():
+	}
+	return k.StopRecording()
+}
+
+// recordSample records a sample if recording.
+func (k *Observer) recordSample(data []byte, cpu int) {
+	if r, _ := k.recorder.Load().(*sampleRecorder); r != nil {
+		r.record(data, cpu)
+	}
+}
+
+// Recording returns true if an observer is recording samples.
+func Recording() bool {
+	for _, o := range observerList {
+		if r, _ := o.recorder.Load().(*sampleRecorder); r != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordSample records a sample not read from the events map, such as the
+// events generated from /proc at startup, for the observers recording
+// samples. The handler of its op decodes it on replay.
+func RecordSample(data []byte) {
+	for _, o := range observerList {
+		o.recordSample(data, -1)
+	}
+}
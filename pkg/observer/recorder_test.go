@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package observer
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	k := NewObserver("")
+	defer k.Remove()
+
+	var buf bytes.Buffer
+	require.NoError(t, k.StartRecording(&buf, 0))
+	assert.True(t, Recording())
+	assert.Error(t, k.StartRecording(&buf, 0))
+
+	var recorded []Sample
+	for seq := uint32(0); seq < 10; seq++ {
+		s := testSample(seq%3+1, seq)
+		k.recordSample(s.Data, s.CPU)
+		recorded = append(recorded, s)
+	}
+	s := testSample(4, 0)
+	RecordSample(s.Data)
+	recorded = append(recorded, Sample{CPU: -1, Data: s.Data})
+
+	n, err := k.StopRecording()
+	require.NoError(t, err)
+	assert.Equal(t, len(recorded), n)
+	assert.False(t, Recording())
+	_, err = k.StopRecording()
+	assert.Error(t, err)
+
+	sr, err := NewSampleReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	samples, err := ReadSamples(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Len(t, samples, len(recorded))
+	for i := range samples {
+		assert.Equal(t, recorded[i].CPU, samples[i].CPU)
+		assert.Equal(t, recorded[i].Data, samples[i].Data)
+		assert.GreaterOrEqual(t, samples[i].Time, sr.Clocks.Monotonic)
+	}
+
+	replay, l := newPipelineObserver(t)
+	defer replay.Remove()
+	n, err = replay.ReplaySampleReader(sr, 2)
+	require.NoError(t, err)
+	assert.Equal(t, len(recorded), n)
+	assert.Len(t, l.events, len(recorded))
+}
+
+func TestRecordSamplesMax(t *testing.T) {
+	k := NewObserver("")
+	defer k.Remove()
+
+	var buf bytes.Buffer
+	done := make(chan int)
+	go func() {
+		n, err := k.RecordSamples(context.Background(), &buf, time.Minute, 3, 0)
+		assert.NoError(t, err)
+		done <- n
+	}()
+	require.Eventually(t, Recording, time.Second, time.Millisecond)
+	for seq := uint32(0); seq < 5; seq++ {
+		s := testSample(1, seq)
+		k.recordSample(s.Data, s.CPU)
+	}
+
+	select {
+	case n := <-done:
+		assert.Equal(t, 3, n)
+	case <-time.After(10 * time.Second):
+		t.Fatal("recording did not stop after max samples")
+	}
+	samples, err := ReadSamples(&buf)
+	require.NoError(t, err)
+	assert.Len(t, samples, 3)
+}
+
+func TestRecordSamplesMaxBytes(t *testing.T) {
+	k := NewObserver("")
+	defer k.Remove()
+
+	s := testSample(1, 0)
+	var buf bytes.Buffer
+	done := make(chan int)
+	go func() {
+		n, err := k.RecordSamples(context.Background(), &buf, time.Minute, 0, 2*(sampleHeaderSize+len(s.Data))+1)
+		assert.NoError(t, err)
+		done <- n
+	}()
+	require.Eventually(t, Recording, time.Second, time.Millisecond)
+	for seq := uint32(0); seq < 5; seq++ {
+		s := testSample(1, seq)
+		k.recordSample(s.Data, s.CPU)
+	}
+
+	select {
+	case n := <-done:
+		assert.Equal(t, 2, n)
+	case <-time.After(10 * time.Second):
+		t.Fatal("recording did not stop after max bytes")
+	}
+	samples, err := ReadSamples(&buf)
+	require.NoError(t, err)
+	assert.Len(t, samples, 2)
+}
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package observer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cilium/tetragon/pkg/ktime"
+)
+
+// Sample files hold raw samples of the events map, so that they can be
+// replayed through the observer. They start with sampleFileMagic and the
+// clocks when the recording started: the wall clock (int64, nanoseconds since
+// the epoch), CLOCK_MONOTONIC and CLOCK_BOOTTIME (int64, nanoseconds). The
+// samples follow, each one being the CPU it was read from (int32, -1 for ring
+// buffers), its length (uint32), CLOCK_MONOTONIC when it was read (int64,
+// nanoseconds) and its bytes. Integers are little endian.
+const (
+	sampleFileMagic = "TGSMPL01"
+
+	// sampleHeaderSize is the size of the header preceding the bytes of
+	// samples.
+	sampleHeaderSize = 16
+
+	// maxSampleSize bounds the samples read, against corrupted files.
+	maxSampleSize = 1 << 20
+)
+
+// Sample is a raw sample of the events map.
+type Sample struct {
+	CPU int
+	// Time is CLOCK_MONOTONIC when the sample was read, in nanoseconds.
+	Time int64
+	Data []byte
+}
+
+// SampleWriter writes samples to a sample file.
+type SampleWriter struct {
+	w *bufio.Writer
+}
+
+// NewSampleWriter writes the sample file header, with the clocks of ref, to w
+// and returns a SampleWriter writing samples to it.
+func NewSampleWriter(w io.Writer, ref *ktime.ClockReference) (*SampleWriter, error) {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(sampleFileMagic); err != nil {
+		return nil, err
+	}
+	var clocks [24]byte
+	binary.LittleEndian.PutUint64(clocks[0:], uint64(ref.Wall.UnixNano()))
+	binary.LittleEndian.PutUint64(clocks[8:], uint64(ref.Monotonic))
+	binary.LittleEndian.PutUint64(clocks[16:], uint64(ref.Boot))
+	if _, err := bw.Write(clocks[:]); err != nil {
+		return nil, err
+	}
+	return &SampleWriter{w: bw}, nil
+}
+
+// Write writes a sample. Samples are buffered until Flush.
+func (sw *SampleWriter) Write(s Sample) error {
+	var hdr [sampleHeaderSize]byte
+	binary.LittleEndian.PutUint32(hdr[0:], uint32(int32(s.CPU)))
+	binary.LittleEndian.PutUint32(hdr[4:], uint32(len(s.Data)))
+	binary.LittleEndian.PutUint64(hdr[8:], uint64(s.Time))
+	if _, err := sw.w.Write(hdr[:]); err != nil {
+		return err
+	}
+	_, err := sw.w.Write(s.Data)
+	return err
+}
+
+// Flush writes the buffered samples.
+func (sw *SampleWriter) Flush() error {
+	return sw.w.Flush()
+}
+
+// SampleReader reads samples from a sample file.
+type SampleReader struct {
+	r *bufio.Reader
+	// Clocks are the clocks when the recording started.
+	Clocks ktime.ClockReference
+}
+
+// NewSampleReader reads the sample file header of r and returns a
+// SampleReader reading samples from it.
+func NewSampleReader(r io.Reader) (*SampleReader, error) {
+	br := bufio.NewReader(r)
+	hdr := make([]byte, len(sampleFileMagic)+24)
+	if _, err := io.ReadFull(br, hdr); err != nil {
+		return nil, fmt.Errorf("failed to read sample file header: %w", err)
+	}
+	if string(hdr[:len(sampleFileMagic)]) != sampleFileMagic {
+		return nil, errors.New("not a sample file")
+	}
+	clocks := hdr[len(sampleFileMagic):]
+	return &SampleReader{
+		r: br,
+		Clocks: ktime.ClockReference{
+			Wall:      time.Unix(0, int64(binary.LittleEndian.Uint64(clocks[0:]))),
+			Monotonic: int64(binary.LittleEndian.Uint64(clocks[8:])),
+			Boot:      int64(binary.LittleEndian.Uint64(clocks[16:])),
+		},
+	}, nil
+}
+
+// Read returns the next sample, or io.EOF at the end of the file.
+func (sr *SampleReader) Read() (Sample, error) {
+	var hdr [sampleHeaderSize]byte
+	if _, err := io.ReadFull(sr.r, hdr[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return Sample{}, fmt.Errorf("truncated sample header: %w", err)
+		}
+		return Sample{}, err
+	}
+	size := binary.LittleEndian.Uint32(hdr[4:])
+	if size > maxSampleSize {
+		return Sample{}, fmt.Errorf("sample size %d too large", size)
+	}
+	s := Sample{
+		CPU:  int(int32(binary.LittleEndian.Uint32(hdr[0:]))),
+		Time: int64(binary.LittleEndian.Uint64(hdr[8:])),
+		Data: make([]byte, size),
+	}
+	if _, err := io.ReadFull(sr.r, s.Data); err != nil {
+		return Sample{}, fmt.Errorf("truncated sample: %w", err)
+	}
+	return s, nil
+}
+
+// ReadSamples reads all the samples of a sample file.
+func ReadSamples(r io.Reader) ([]Sample, error) {
+	sr, err := NewSampleReader(r)
+	if err != nil {
+		return nil, err
+	}
+	var ret []Sample
+	for {
+		s, err := sr.Read()
+		if errors.Is(err, io.EOF) {
+			return ret, nil
+		}
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, s)
+	}
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package observer
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cilium/tetragon/pkg/ktime"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testClocks = &ktime.ClockReference{
+	Wall:      time.Unix(1660000000, 123456789),
+	Monotonic: 1000000000,
+	Boot:      2000000000,
+}
+
+func TestSamplesRoundTrip(t *testing.T) {
+	samples := []Sample{
+		{CPU: 0, Time: 1000000001, Data: []byte{1, 2, 3}},
+		{CPU: 7, Time: 1000000002, Data: []byte{}},
+		{CPU: -1, Time: 1000000003, Data: bytes.Repeat([]byte{0xaa}, 4096)},
+	}
+
+	var buf bytes.Buffer
+	sw, err := NewSampleWriter(&buf, testClocks)
+	require.NoError(t, err)
+	for _, s := range samples {
+		require.NoError(t, sw.Write(s))
+	}
+	require.NoError(t, sw.Flush())
+
+	sr, err := NewSampleReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.True(t, testClocks.Wall.Equal(sr.Clocks.Wall))
+	assert.Equal(t, testClocks.Monotonic, sr.Clocks.Monotonic)
+	assert.Equal(t, testClocks.Boot, sr.Clocks.Boot)
+
+	read, err := ReadSamples(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, samples, read)
+}
+
+func TestSamplesBadHeader(t *testing.T) {
+	_, err := ReadSamples(bytes.NewReader(append([]byte("TGSMPL99"), make([]byte, 24)...)))
+	assert.Error(t, err)
+
+	_, err = ReadSamples(bytes.NewReader([]byte("TGSMPL01")))
+	assert.Error(t, err)
+}
+
+func TestSamplesTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	sw, err := NewSampleWriter(&buf, testClocks)
+	require.NoError(t, err)
+	require.NoError(t, sw.Write(Sample{CPU: 1, Time: 1, Data: []byte{1, 2, 3, 4}}))
+	require.NoError(t, sw.Write(Sample{CPU: 2, Time: 2, Data: []byte{5, 6, 7, 8}}))
+	require.NoError(t, sw.Flush())
+
+	data := buf.Bytes()
+	read, err := ReadSamples(bytes.NewReader(data[:len(data)-2]))
+	assert.Error(t, err)
+	assert.Equal(t, []Sample{{CPU: 1, Time: 1, Data: []byte{1, 2, 3, 4}}}, read)
+}
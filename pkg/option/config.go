@@ -22,10 +22,32 @@ type config struct {
 	IgnoreMissingProgs bool
 	ForceSmallProgs    bool
 
-	EnableCilium      bool
-	EnableProcessNs   bool
-	EnableProcessCred bool
-	EnableK8s         bool
+	EnableCilium           bool
+	EnableProcessNs        bool
+	EnableProcessNsChanges bool
+	EnableProcessCred      bool
+	EnableK8s              bool
+
+	EnableProcessBinaryInfo bool
+	BinaryHashWorkers       int
+	BinaryHashCacheSize     int
+
+	EnableProcessCredChanges bool
+
+	EnableKernelIntegrity bool
+
+	EnableProcessMount bool
+
+	EnableStackTraceTrees bool
+
+	EventQueueSize           int
+	EventQueueOverflowPolicy string
+
+	EventRingSize int
+	EventRingFile string
+
+	BPFRingBufSize     int
+	EventDecodeWorkers int
 
 	CiliumDir string
 	MapDir    string
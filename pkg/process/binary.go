@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package process
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	tetragonAPI "github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/binaryinfo"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/metrics/processexecmetrics"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+var binHasher *binaryinfo.Hasher
+
+// InitBinaryInfo sets up hashing of executed binaries. If workers is zero
+// only the binary file metadata is collected.
+func InitBinaryInfo(workers, cacheSize int) error {
+	if binHasher != nil {
+		binHasher.Stop()
+		binHasher = nil
+	}
+	if workers <= 0 {
+		return nil
+	}
+	h, err := binaryinfo.NewHasher(workers, cacheSize)
+	if err != nil {
+		return err
+	}
+	binHasher = h
+	return nil
+}
+
+// addBinaryInfo populates the binary properties of a newly executed
+// process. If the binary hash is not cached, it is computed asynchronously
+// and BinaryHashPending() reports true until it completes.
+//
+// exe identifies the executed file, binary properties are only added if
+// the file found through procfs is that one.
+func (pi *ProcessInternal) addBinaryInfo(procFS string, exe tetragonAPI.MsgExe) {
+	pid := pi.process.Pid.GetValue()
+	f, props, key, err := binaryinfo.Open(procFS, pid, exe.Ino, exe.Dev)
+	if errors.Is(err, binaryinfo.ErrExeMismatch) {
+		logger.GetLogger().WithField("pid", pid).Debug("binary of the exec event is gone, skipping binary info")
+		processexecmetrics.BinaryHashInc(processexecmetrics.BinaryHashExeMismatch)
+		return
+	}
+	if err != nil {
+		// The process may have already exited.
+		logger.GetLogger().WithError(err).WithField("pid", pid).Debug("failed to read binary info")
+		return
+	}
+	pi.process.BinaryProperties = props
+
+	if binHasher == nil {
+		f.Close()
+		return
+	}
+	if sum, ok := binHasher.Lookup(key); ok {
+		f.Close()
+		props.Sha256 = sum
+		processexecmetrics.BinaryHashInc(processexecmetrics.BinaryHashCacheHit)
+		return
+	}
+	atomic.StoreUint32(&pi.binaryHashPending, 1)
+	if !binHasher.Submit(f, key, pi.setBinaryHash) {
+		atomic.StoreUint32(&pi.binaryHashPending, 0)
+		f.Close()
+		processexecmetrics.BinaryHashInc(processexecmetrics.BinaryHashDropped)
+	}
+}
+
+func (pi *ProcessInternal) setBinaryHash(sum string, err error) {
+	defer atomic.StoreUint32(&pi.binaryHashPending, 0)
+	if err != nil {
+		logger.GetLogger().WithError(err).WithFields(logrus.Fields{
+			"exec_id": pi.process.ExecId,
+			"binary":  pi.process.Binary,
+		}).Debug("failed to hash binary")
+		processexecmetrics.BinaryHashInc(processexecmetrics.BinaryHashError)
+		return
+	}
+
+	pi.mu.Lock()
+	// Copies of the process may already share the old properties, so
+	// replace them instead of updating them in place.
+	if props := pi.process.BinaryProperties; props != nil {
+		props = proto.Clone(props).(*tetragon.BinaryProperties)
+		props.Sha256 = sum
+		pi.process.BinaryProperties = props
+	}
+	pi.mu.Unlock()
+	processexecmetrics.BinaryHashInc(processexecmetrics.BinaryHashComputed)
+}
+
+// BinaryHashPending returns true while the hash of the process binary is
+// being computed.
+func (pi *ProcessInternal) BinaryHashPending() bool {
+	return atomic.LoadUint32(&pi.binaryHashPending) != 0
+}
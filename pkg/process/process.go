@@ -21,6 +21,7 @@ import (
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/metrics/errormetrics"
 	"github.com/cilium/tetragon/pkg/metrics/processexecmetrics"
+	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/reader/caps"
 	"github.com/cilium/tetragon/pkg/reader/exec"
 	"github.com/cilium/tetragon/pkg/reader/namespace"
@@ -46,6 +47,8 @@ type ProcessInternal struct {
 	// additional internal fields below
 	capabilities *tetragon.Capabilities
 	namespaces   *tetragon.Namespaces
+	// binaryHashPending is set while the binary hash is being computed
+	binaryHashPending uint32
 	// garbage collector metadata
 	color  int
 	refcnt uint32
@@ -235,6 +238,9 @@ func GetParentProcessInternal(pid uint32, ktime uint64) (*ProcessInternal, *Proc
 // AddExecEvent constructs a new ProcessInternal structure from an Execve event, adds it to the cache, and also returns it
 func AddExecEvent(event *tetragonAPI.MsgExecveEventUnix) *ProcessInternal {
 	proc, _ := GetProcess(event.Process, event.Kube.Docker, event.Parent, event.Capabilities, event.Namespaces)
+	if option.Config.EnableProcessBinaryInfo {
+		proc.addBinaryInfo(option.Config.ProcFS, event.Exe)
+	}
 	procCache.Add(proc)
 
 	var parentExecID string
@@ -49,3 +49,101 @@ func GetProgType(t uint32) string {
 	}
 	return fmt.Sprintf("%d", t)
 }
+
+/* uapi/linux/bpf.h */
+var attachTypeString = map[uint32]string{
+	0:  "BPF_CGROUP_INET_INGRESS",
+	1:  "BPF_CGROUP_INET_EGRESS",
+	2:  "BPF_CGROUP_INET_SOCK_CREATE",
+	3:  "BPF_CGROUP_SOCK_OPS",
+	4:  "BPF_SK_SKB_STREAM_PARSER",
+	5:  "BPF_SK_SKB_STREAM_VERDICT",
+	6:  "BPF_CGROUP_DEVICE",
+	7:  "BPF_SK_MSG_VERDICT",
+	8:  "BPF_CGROUP_INET4_BIND",
+	9:  "BPF_CGROUP_INET6_BIND",
+	10: "BPF_CGROUP_INET4_CONNECT",
+	11: "BPF_CGROUP_INET6_CONNECT",
+	12: "BPF_CGROUP_INET4_POST_BIND",
+	13: "BPF_CGROUP_INET6_POST_BIND",
+	14: "BPF_CGROUP_UDP4_SENDMSG",
+	15: "BPF_CGROUP_UDP6_SENDMSG",
+	16: "BPF_LIRC_MODE2",
+	17: "BPF_FLOW_DISSECTOR",
+	18: "BPF_CGROUP_SYSCTL",
+	19: "BPF_CGROUP_UDP4_RECVMSG",
+	20: "BPF_CGROUP_UDP6_RECVMSG",
+	21: "BPF_CGROUP_GETSOCKOPT",
+	22: "BPF_CGROUP_SETSOCKOPT",
+	23: "BPF_TRACE_RAW_TP",
+	24: "BPF_TRACE_FENTRY",
+	25: "BPF_TRACE_FEXIT",
+	26: "BPF_MODIFY_RETURN",
+	27: "BPF_LSM_MAC",
+	28: "BPF_TRACE_ITER",
+	29: "BPF_CGROUP_INET4_GETPEERNAME",
+	30: "BPF_CGROUP_INET6_GETPEERNAME",
+	31: "BPF_CGROUP_INET4_GETSOCKNAME",
+	32: "BPF_CGROUP_INET6_GETSOCKNAME",
+	33: "BPF_XDP_DEVMAP",
+	34: "BPF_CGROUP_INET_SOCK_RELEASE",
+	35: "BPF_XDP_CPUMAP",
+	36: "BPF_SK_LOOKUP",
+	37: "BPF_XDP",
+	38: "BPF_SK_SKB_VERDICT",
+	39: "BPF_SK_REUSEPORT_SELECT",
+	40: "BPF_SK_REUSEPORT_SELECT_OR_MIGRATE",
+	41: "BPF_PERF_EVENT",
+	42: "BPF_TRACE_KPROBE_MULTI",
+}
+
+// GetAttachType returns the name of a BPF expected attach type.
+func GetAttachType(t uint32) string {
+	if t, ok := attachTypeString[t]; ok {
+		return t
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+/* uapi/linux/bpf.h */
+var mapTypeString = map[uint32]string{
+	0:  "BPF_MAP_TYPE_UNSPEC",
+	1:  "BPF_MAP_TYPE_HASH",
+	2:  "BPF_MAP_TYPE_ARRAY",
+	3:  "BPF_MAP_TYPE_PROG_ARRAY",
+	4:  "BPF_MAP_TYPE_PERF_EVENT_ARRAY",
+	5:  "BPF_MAP_TYPE_PERCPU_HASH",
+	6:  "BPF_MAP_TYPE_PERCPU_ARRAY",
+	7:  "BPF_MAP_TYPE_STACK_TRACE",
+	8:  "BPF_MAP_TYPE_CGROUP_ARRAY",
+	9:  "BPF_MAP_TYPE_LRU_HASH",
+	10: "BPF_MAP_TYPE_LRU_PERCPU_HASH",
+	11: "BPF_MAP_TYPE_LPM_TRIE",
+	12: "BPF_MAP_TYPE_ARRAY_OF_MAPS",
+	13: "BPF_MAP_TYPE_HASH_OF_MAPS",
+	14: "BPF_MAP_TYPE_DEVMAP",
+	15: "BPF_MAP_TYPE_SOCKMAP",
+	16: "BPF_MAP_TYPE_CPUMAP",
+	17: "BPF_MAP_TYPE_XSKMAP",
+	18: "BPF_MAP_TYPE_SOCKHASH",
+	19: "BPF_MAP_TYPE_CGROUP_STORAGE",
+	20: "BPF_MAP_TYPE_REUSEPORT_SOCKARRAY",
+	21: "BPF_MAP_TYPE_PERCPU_CGROUP_STORAGE",
+	22: "BPF_MAP_TYPE_QUEUE",
+	23: "BPF_MAP_TYPE_STACK",
+	24: "BPF_MAP_TYPE_SK_STORAGE",
+	25: "BPF_MAP_TYPE_DEVMAP_HASH",
+	26: "BPF_MAP_TYPE_STRUCT_OPS",
+	27: "BPF_MAP_TYPE_RINGBUF",
+	28: "BPF_MAP_TYPE_INODE_STORAGE",
+	29: "BPF_MAP_TYPE_TASK_STORAGE",
+	30: "BPF_MAP_TYPE_BLOOM_FILTER",
+}
+
+// GetMapType returns the name of a BPF map type.
+func GetMapType(t uint32) string {
+	if t, ok := mapTypeString[t]; ok {
+		return t
+	}
+	return fmt.Sprintf("%d", t)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	"github.com/cilium/tetragon/pkg/kernels"
 	"github.com/cilium/tetragon/pkg/reader/namespace"
+	"github.com/cilium/tetragon/pkg/syscallinfo"
 )
 
 const (
@@ -42,6 +43,15 @@ var actionTypeStringTable = map[uint32]string{
 	actionTypeCopyFd:     "copyfd",
 }
 
+// ActionTypeString returns the name of the action type act as used in
+// matchActions, or "unknown".
+func ActionTypeString(act uint32) string {
+	if s, ok := actionTypeStringTable[act]; ok {
+		return s
+	}
+	return "unknown"
+}
+
 func MatchActionSigKill(spec *v1alpha1.KProbeSpec) bool {
 	sels := spec.Selectors
 	for _, s := range sels {
@@ -108,6 +118,8 @@ const (
 
 	argTypeFile = 16
 	argTypeFd   = 17
+
+	argTypeSyscallId = 21
 )
 
 var argTypeTable = map[string]uint32{
@@ -124,6 +136,7 @@ var argTypeTable = map[string]uint32{
 	"fd":         argTypeFd,
 	"file":       argTypeFile,
 	"sock":       argTypeSock,
+	"syscall_id": argTypeSyscallId,
 }
 
 var argTypeStringTable = map[uint32]string{
@@ -140,6 +153,7 @@ var argTypeStringTable = map[uint32]string{
 	argTypeFd:        "fd",
 	argTypeFile:      "file",
 	argTypeSock:      "sock",
+	argTypeSyscallId: "syscall_id",
 }
 
 const (
@@ -293,6 +307,27 @@ func parseMatchValues(k *KernelSelectorState, values []string, ty uint32) error
 	return nil
 }
 
+// parseMatchSyscalls writes a bitmap of the ids of the syscalls of values,
+// which are syscall names or syscall sets (e.g., @mount).
+func parseMatchSyscalls(k *KernelSelectorState, values []string, op uint32) error {
+	if op != selectorOpIn && op != selectorOpNotIn {
+		return fmt.Errorf("syscall matchArgs only support In and NotIn operators")
+	}
+	ids, err := syscallinfo.ResolveSyscalls(values)
+	if err != nil {
+		return err
+	}
+	var bitmap [syscallinfo.MaxSyscalls / 8]byte
+	for _, id := range ids {
+		if id >= syscallinfo.MaxSyscalls {
+			return fmt.Errorf("syscall id %d out of range", id)
+		}
+		bitmap[id/8] |= 1 << (id % 8)
+	}
+	WriteSelectorByteArray(k, bitmap[:], uint32(len(bitmap)))
+	return nil
+}
+
 func parseMatchArg(k *KernelSelectorState, arg *v1alpha1.ArgSelector, sig []v1alpha1.KProbeArg) error {
 	WriteSelectorUint32(k, arg.Index)
 
@@ -307,7 +342,11 @@ func parseMatchArg(k *KernelSelectorState, arg *v1alpha1.ArgSelector, sig []v1al
 		return fmt.Errorf("argSelector error: %w", err)
 	}
 	WriteSelectorUint32(k, ty)
-	err = parseMatchValues(k, arg.Values, ty)
+	if ty == argTypeSyscallId {
+		err = parseMatchSyscalls(k, arg.Values, op)
+	} else {
+		err = parseMatchValues(k, arg.Values, ty)
+	}
 	if err != nil {
 		return fmt.Errorf("parseMatchValues error: %w", err)
 	}
@@ -467,27 +506,28 @@ func parseMatchNamespaceChanges(k *KernelSelectorState, actions []v1alpha1.Names
 	return nil
 }
 
-func parseMatchCaps(k *KernelSelectorState, action *v1alpha1.CapabilitiesSelector) error {
+// ParseCapabilitiesSelector returns the values the BPF side uses to
+// evaluate a matchCapabilities or matchCapabilityChanges selector: the
+// capabilities type, the operator, the host user namespace inode (or 0 if
+// IsNamespaceCapability is not set) and the capabilities bitmask.
+func ParseCapabilitiesSelector(action *v1alpha1.CapabilitiesSelector) (ty, op, isns uint32, caps uint64, err error) {
 	// type
 	tystr := strings.ToLower(action.Type)
 	ty, ok := capabilitiesTypeTable[tystr]
 	if !ok {
-		return fmt.Errorf("parseMatchCapability: actionType %s unknown", action.Type)
+		return 0, 0, 0, 0, fmt.Errorf("parseMatchCapability: actionType %s unknown", action.Type)
 	}
-	WriteSelectorUint32(k, ty)
 
 	// operator
-	op, err := selectorOp(action.Operator)
+	op, err = selectorOp(action.Operator)
 	if err != nil {
-		return fmt.Errorf("matchCapabilities error: %w", err)
+		return 0, 0, 0, 0, fmt.Errorf("matchCapabilities error: %w", err)
 	}
 	if (op != selectorOpIn) && (op != selectorOpNotIn) {
-		return fmt.Errorf("matchCapabilities supports only In and NotIn operators")
+		return 0, 0, 0, 0, fmt.Errorf("matchCapabilities supports only In and NotIn operators")
 	}
-	WriteSelectorUint32(k, op)
 
 	// isnamespacecapability
-	isns := uint32(0) // false by default
 	if action.IsNamespaceCapability {
 		// If IsNamespaceCapability == true will try to match the capabilities
 		//     only when current_user_namespace != host_user_namespace.
@@ -497,20 +537,29 @@ func parseMatchCaps(k *KernelSelectorState, action *v1alpha1.CapabilitiesSelecto
 		// user namespace to compare with that inside the kernel.
 		isns = namespace.GetPidNsInode(1, "user")
 	}
-	WriteSelectorUint32(k, isns)
 
 	// values
-	caps := uint64(0)
 	for _, v := range action.Values {
 		valstr := strings.ToUpper(v)
 		c, ok := tetragon.CapabilitiesType_value[valstr]
 		if !ok {
-			return fmt.Errorf("parseMatchCapability: value %s unknown", valstr)
+			return 0, 0, 0, 0, fmt.Errorf("parseMatchCapability: value %s unknown", valstr)
 		}
 		caps |= (1 << c)
 	}
-	WriteSelectorUint64(k, caps)
 
+	return ty, op, isns, caps, nil
+}
+
+func parseMatchCaps(k *KernelSelectorState, action *v1alpha1.CapabilitiesSelector) error {
+	ty, op, isns, caps, err := ParseCapabilitiesSelector(action)
+	if err != nil {
+		return err
+	}
+	WriteSelectorUint32(k, ty)
+	WriteSelectorUint32(k, op)
+	WriteSelectorUint32(k, isns)
+	WriteSelectorUint64(k, caps)
 	return nil
 }
 
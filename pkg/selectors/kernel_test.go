@@ -10,6 +10,7 @@ import (
 
 	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	"github.com/cilium/tetragon/pkg/kernels"
+	"github.com/cilium/tetragon/pkg/syscallinfo"
 )
 
 func TestWriteSelectorUint32(t *testing.T) {
@@ -210,6 +211,49 @@ func TestParseMatchArg(t *testing.T) {
 	}
 }
 
+func TestParseMatchSyscalls(t *testing.T) {
+	sig := []v1alpha1.KProbeArg{
+		v1alpha1.KProbeArg{Index: 0, Type: "syscall_id"},
+	}
+
+	arg := &v1alpha1.ArgSelector{Index: 0, Operator: "In", Values: []string{"mount", "sys_umount2"}}
+	k := &KernelSelectorState{off: 0}
+	if err := parseMatchArg(k, arg, sig); err != nil {
+		t.Fatalf("parseMatchArg: error %v parsing %v\n", err, arg)
+	}
+
+	var bitmap [syscallinfo.MaxSyscalls / 8]byte
+	for _, name := range arg.Values {
+		id, ok := syscallinfo.GetSyscallID(name)
+		if !ok {
+			t.Fatalf("unknown syscall %s", name)
+		}
+		bitmap[id/8] |= 1 << (id % 8)
+	}
+	expected := []byte{
+		0x00, 0x00, 0x00, 0x00, // Index == 0
+		0x05, 0x00, 0x00, 0x00, // operator == In
+		72, 0x00, 0x00, 0x00, // length == 72
+		21, 0x00, 0x00, 0x00, // value type == syscall_id
+	}
+	expected = append(expected, bitmap[:]...)
+	if !bytes.Equal(expected, k.e[0:k.off]) {
+		t.Errorf("parseMatchArg: expected %v bytes %v parsing %v\n", expected, k.e[0:k.off], arg)
+	}
+
+	arg = &v1alpha1.ArgSelector{Index: 0, Operator: "Equal", Values: []string{"mount"}}
+	k = &KernelSelectorState{off: 0}
+	if err := parseMatchArg(k, arg, sig); err == nil {
+		t.Errorf("parseMatchArg: expected error for operator %s", arg.Operator)
+	}
+
+	arg = &v1alpha1.ArgSelector{Index: 0, Operator: "NotIn", Values: []string{"@nonexistent"}}
+	k = &KernelSelectorState{off: 0}
+	if err := parseMatchArg(k, arg, sig); err == nil {
+		t.Errorf("parseMatchArg: expected error for unknown set %v", arg.Values)
+	}
+}
+
 func TestParseMatchPid(t *testing.T) {
 	pid1 := &v1alpha1.PIDSelector{Operator: "In", Values: []uint32{1, 2, 3}, IsNamespacePID: true, FollowForks: true}
 	k := &KernelSelectorState{off: 0}
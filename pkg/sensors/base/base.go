@@ -49,6 +49,10 @@ var (
 	TCPMonMap    = program.MapBuilder("tcpmon_map", Execve)
 	TCPMonMapV53 = program.MapBuilder("tcpmon_map", ExecveV53)
 
+	/* Events that did not fit in the ring buffer */
+	TCPMonErrorsMap    = program.MapBuilder("tcpmon_map_errors", Execve)
+	TCPMonErrorsMapV53 = program.MapBuilder("tcpmon_map_errors", ExecveV53)
+
 	/* Networking and Process Monitoring maps */
 	ExecveMap    = program.MapBuilder("execve_map", Execve)
 	ExecveMapV53 = program.MapBuilder("execve_map", ExecveV53)
@@ -91,6 +95,7 @@ func GetDefaultMaps() []*program.Map {
 			ExecveStatsV53,
 			NamesMapV53,
 			TCPMonMapV53,
+			TCPMonErrorsMapV53,
 		)
 	} else {
 		maps = append(maps,
@@ -98,6 +103,7 @@ func GetDefaultMaps() []*program.Map {
 			ExecveStats,
 			NamesMap,
 			TCPMonMap,
+			TCPMonErrorsMap,
 		)
 	}
 	return maps
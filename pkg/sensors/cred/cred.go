@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package cred
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/grpc/cred"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/observer"
+	"github.com/cilium/tetragon/pkg/selectors"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+)
+
+// CredFilter is the userspace copy of struct cred_filter in bpf_cred.c. It
+// restricts which capability-only changes are reported, using the
+// matchCapabilityChanges selector semantics.
+type CredFilter struct {
+	Enabled uint32
+	Ty      uint32
+	Op      uint32
+	Ns      uint32
+	Val     uint64
+}
+
+// GetCredFilter converts a matchCapabilityChanges-style list of selectors to
+// the filter loaded in cred_filter_map. Like matchCapabilityChanges in
+// tracing policies, at most one selector is supported.
+func GetCredFilter(filter []v1alpha1.CapabilitiesSelector) (CredFilter, error) {
+	if len(filter) == 0 {
+		return CredFilter{}, nil
+	}
+	if len(filter) > 1 {
+		return CredFilter{}, fmt.Errorf("only one capabilities selector is supported, got %d", len(filter))
+	}
+	ty, op, ns, caps, err := selectors.ParseCapabilitiesSelector(&filter[0])
+	if err != nil {
+		return CredFilter{}, err
+	}
+	return CredFilter{
+		Enabled: 1,
+		Ty:      ty,
+		Op:      op,
+		Ns:      ns,
+		Val:     caps,
+	}, nil
+}
+
+// GetCredSensor returns the sensor that reports process credentials changes.
+// Changes of uids, gids and securebits are always reported while changes
+// that only affect capabilities are filtered by filter.
+func GetCredSensor(filter []v1alpha1.CapabilitiesSelector) (*sensors.Sensor, error) {
+	credFilter, err := GetCredFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	load := program.Builder(
+		"bpf_cred.o",
+		"commit_creds",
+		"kprobe/commit_creds",
+		"event_commit_creds",
+		"kprobe",
+	)
+
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, credFilter)
+	load.MapLoad = append(load.MapLoad, &program.MapLoad{Name: "cred_filter_map", Data: buf.Bytes()})
+
+	filterMap := program.MapBuilder("cred_filter_map", load)
+
+	return &sensors.Sensor{
+		Name:  "__cred_sensor__",
+		Progs: []*program.Program{load},
+		Maps:  []*program.Map{filterMap},
+	}, nil
+}
+
+func handleCredChange(r *bytes.Reader) ([]observer.Event, error) {
+	m := processapi.MsgCredChangeEvent{}
+	err := binary.Read(r, binary.LittleEndian, &m)
+	if err != nil {
+		return nil, err
+	}
+	msgUnix := &cred.MsgCredChangeEventUnix{MsgCredChangeEvent: m}
+	return []observer.Event{msgUnix}, nil
+}
+
+func init() {
+	observer.RegisterEventHandlerAtInit(ops.MSG_OP_CRED_CHANGE, handleCredChange)
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package cred
+
+import (
+	"testing"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCredFilter(t *testing.T) {
+	// no selectors: report everything
+	f, err := GetCredFilter(nil)
+	require.NoError(t, err)
+	assert.Equal(t, CredFilter{}, f)
+
+	f, err = GetCredFilter([]v1alpha1.CapabilitiesSelector{{
+		Type:     "Effective",
+		Operator: "In",
+		Values:   []string{"CAP_SYS_ADMIN", "CAP_NET_RAW"},
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), f.Enabled)
+	assert.Equal(t, uint32(1), f.Ty) // caps_effective
+	assert.Equal(t, uint32(5), f.Op) // op_filter_in
+	assert.Equal(t, uint32(0), f.Ns)
+	assert.Equal(t, uint64(1<<tetragon.CapabilitiesType_CAP_SYS_ADMIN|1<<tetragon.CapabilitiesType_CAP_NET_RAW), f.Val)
+
+	// only In and NotIn are supported
+	_, err = GetCredFilter([]v1alpha1.CapabilitiesSelector{{
+		Type:     "Effective",
+		Operator: "Equal",
+		Values:   []string{"CAP_SYS_ADMIN"},
+	}})
+	assert.Error(t, err)
+
+	// only one selector is supported
+	sel := v1alpha1.CapabilitiesSelector{Type: "Effective", Operator: "NotIn", Values: []string{"CAP_CHOWN"}}
+	_, err = GetCredFilter([]v1alpha1.CapabilitiesSelector{sel, sel})
+	assert.Error(t, err)
+}
@@ -50,6 +50,8 @@ func msgToExecveUnix(m *processapi.MsgExecveEvent) *exec.MsgExecveEventUnix {
 	unix.Namespaces.CgroupInum = m.Namespaces.CgroupInum
 	unix.Namespaces.UserInum = m.Namespaces.UserInum
 
+	unix.Exe = m.Exe
+
 	return unix
 }
 
@@ -233,6 +235,31 @@ func handleClone(r *bytes.Reader) ([]observer.Event, error) {
 	return []observer.Event{msgUnix}, nil
 }
 
+var (
+	execveProcessOffset = binary.Size(processapi.MsgExecveEvent{})
+	cloneParentOffset   = binary.Size(processapi.MsgCloneEvent{}.Common)
+)
+
+// execveShardKey returns the pid of the MsgProcess following MsgExecveEvent.
+func execveShardKey(b []byte) uint32 {
+	// MsgProcess starts with its size
+	off := execveProcessOffset + 4
+	if len(b) < off+4 {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b[off:])
+}
+
+// cloneShardKey returns the pid of the parent, for the clone event to be
+// decoded after the exec of the parent. Events of the child decoded before
+// the clone event go through the event cache until the child is known.
+func cloneShardKey(b []byte) uint32 {
+	if len(b) < cloneParentOffset+4 {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b[cloneParentOffset:])
+}
+
 type execSensor struct {
 	name string
 }
@@ -245,7 +272,7 @@ func (e *execSensor) LoadProbe(args sensors.LoadProbeArgs) error {
 	return err
 }
 
-func (e *execSensor) SpecHandler(spec interface{}) (*sensors.Sensor, error) {
+func (e *execSensor) SpecHandler(policyName string, spec interface{}) (*sensors.Sensor, error) {
 	return nil, nil
 }
 
@@ -262,4 +289,6 @@ func AddExec() {
 	observer.RegisterEventHandlerAtInit(ops.MSG_OP_EXECVE, handleExecve)
 	observer.RegisterEventHandlerAtInit(ops.MSG_OP_EXIT, handleExit)
 	observer.RegisterEventHandlerAtInit(ops.MSG_OP_CLONE, handleClone)
+	observer.RegisterShardKeyAtInit(ops.MSG_OP_EXECVE, execveShardKey)
+	observer.RegisterShardKeyAtInit(ops.MSG_OP_CLONE, cloneShardKey)
 }
@@ -428,6 +428,7 @@ func TestLoadInitialSensor(t *testing.T) {
 		tus.SensorMap{Name: "execve_map", Progs: []uint{0, 1, 2}},
 		tus.SensorMap{Name: "execve_map_stats", Progs: []uint{0, 1, 2}},
 		tus.SensorMap{Name: "tcpmon_map", Progs: []uint{0, 1, 2}},
+		tus.SensorMap{Name: "tcpmon_map_errors", Progs: []uint{0, 1, 2}},
 
 		// event_execve
 		tus.SensorMap{Name: "names_map", Progs: []uint{0}},
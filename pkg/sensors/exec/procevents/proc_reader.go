@@ -10,12 +10,14 @@ import (
 	"path/filepath"
 	"sort"
 	"strconv"
+	"syscall"
 	"time"
 	"unicode/utf8"
 
 	"github.com/cilium/tetragon/pkg/api"
 	"github.com/cilium/tetragon/pkg/api/ops"
 	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/binaryinfo"
 	"github.com/cilium/tetragon/pkg/bpf"
 	"github.com/cilium/tetragon/pkg/grpc/exec"
 	"github.com/cilium/tetragon/pkg/kernels"
@@ -76,6 +78,7 @@ type Procs struct {
 	time_for_children_ns uint32
 	cgroup_ns            uint32
 	user_ns              uint32
+	exe                  processapi.MsgExe
 }
 
 func procKernel() Procs {
@@ -161,6 +164,8 @@ func pushExecveEvents(p Procs) {
 	m.Namespaces.CgroupInum = p.cgroup_ns
 	m.Namespaces.UserInum = p.user_ns
 
+	m.Exe = p.exe
+
 	m.Process.Size = p.size
 	m.Process.PID = p.pid
 	m.Process.NSPID = p.nspid
@@ -172,6 +177,7 @@ func pushExecveEvents(p Procs) {
 	m.Process.Filename = filename
 	m.Process.Args = args
 
+	recordExecveEvent(&m)
 	observer.AllListeners(&m)
 }
 
@@ -364,6 +370,12 @@ func GetRunningProcs() []Procs {
 		if err == nil {
 			cmdline = proc.PrependPath(execPath, cmdline)
 		}
+		var exe processapi.MsgExe
+		var st syscall.Stat_t
+		if err := syscall.Stat(filepath.Join(option.Config.ProcFS, d.Name(), "exe"), &st); err == nil {
+			exe.Ino = st.Ino
+			exe.Dev = binaryinfo.StatDev(uint64(st.Dev))
+		}
 
 		if _ppid != 0 {
 			pexecPath, err = os.Readlink(filepath.Join(option.Config.ProcFS, ppid, "exe"))
@@ -397,6 +409,7 @@ func GetRunningProcs() []Procs {
 			time_for_children_ns: time_for_children_ns,
 			cgroup_ns:            cgroup_ns,
 			user_ns:              user_ns,
+			exe:                  exe,
 		}
 
 		p.size = uint32(processapi.MSG_SIZEOF_EXECVE + len(p.args) + processapi.MSG_SIZEOF_CWD)
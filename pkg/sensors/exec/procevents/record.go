@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package procevents
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/grpc/exec"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/observer"
+)
+
+// recordExecveEvent records the execve event of a process found in /proc in
+// the samples being recorded, as a MSG_OP_EXECVE_PROCFS sample holding the
+// JSON encoded event, so that replaying the samples starts with the same
+// processes.
+func recordExecveEvent(m *exec.MsgExecveEventUnix) {
+	if !observer.Recording() {
+		return
+	}
+	data, err := encodeExecveProcfs(m)
+	if err != nil {
+		logger.GetLogger().WithError(err).Warn("Failed to record procfs execve event")
+		return
+	}
+	observer.RecordSample(data)
+}
+
+var execveProcfsPidOffset = binary.Size(processapi.MsgCommon{})
+
+// encodeExecveProcfs encodes a MSG_OP_EXECVE_PROCFS sample: the common
+// header, the pid of the process for execveProcfsShardKey and the JSON
+// encoded event.
+func encodeExecveProcfs(m *exec.MsgExecveEventUnix) ([]byte, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	common := processapi.MsgCommon{
+		Op:    ops.MSG_OP_EXECVE_PROCFS,
+		Size:  uint32(execveProcfsPidOffset + 4 + len(data)),
+		Ktime: m.Common.Ktime,
+	}
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, &common)
+	binary.Write(&buf, binary.LittleEndian, m.Process.PID)
+	buf.Write(data)
+	return buf.Bytes(), nil
+}
+
+// execveProcfsShardKey returns the pid of the process, like the shard key of
+// MSG_OP_EXECVE, so that its events are decoded after its procfs event.
+func execveProcfsShardKey(b []byte) uint32 {
+	if len(b) < execveProcfsPidOffset+4 {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b[execveProcfsPidOffset:])
+}
+
+func handleExecveProcfs(r *bytes.Reader) ([]observer.Event, error) {
+	common := processapi.MsgCommon{}
+	if err := binary.Read(r, binary.LittleEndian, &common); err != nil {
+		return nil, err
+	}
+	var pid uint32
+	if err := binary.Read(r, binary.LittleEndian, &pid); err != nil {
+		return nil, err
+	}
+	m := &exec.MsgExecveEventUnix{}
+	if err := json.NewDecoder(r).Decode(m); err != nil {
+		return nil, err
+	}
+	return []observer.Event{m}, nil
+}
+
+func init() {
+	observer.RegisterEventHandlerAtInit(ops.MSG_OP_EXECVE_PROCFS, handleExecveProcfs)
+	observer.RegisterShardKeyAtInit(ops.MSG_OP_EXECVE_PROCFS, execveProcfsShardKey)
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package procevents
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/grpc/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecveProcfsSample(t *testing.T) {
+	m := &exec.MsgExecveEventUnix{}
+	m.Common.Ktime = 1234
+	m.Process = processapi.MsgProcess{PID: 42, Ktime: 1000, Filename: "/bin/sh"}
+
+	data, err := encodeExecveProcfs(m)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(42), execveProcfsShardKey(data))
+	assert.Equal(t, uint32(0), execveProcfsShardKey(data[:4]))
+
+	events, err := handleExecveProcfs(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, m, events[0])
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package integrity
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/grpc/integrity"
+	"github.com/cilium/tetragon/pkg/observer"
+	"github.com/cilium/tetragon/pkg/reader/namespace"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+)
+
+// IntegrityConfig is the userspace copy of struct integrity_config in
+// bpf_integrity.c.
+type IntegrityConfig struct {
+	ExcludePid uint32
+	Pad        uint32
+}
+
+// GetIntegritySensor returns the kernel integrity sensor. It reports kernel
+// module loads, BPF program loads and BPF map creation from all processes
+// but tetragon itself.
+func GetIntegritySensor() *sensors.Sensor {
+	var buf bytes.Buffer
+	config := IntegrityConfig{ExcludePid: namespace.GetMyPidG()}
+	binary.Write(&buf, binary.LittleEndian, config)
+
+	kmod := program.Builder(
+		"bpf_integrity.o",
+		"do_init_module",
+		"kprobe/do_init_module",
+		"event_kmod_load",
+		"kprobe",
+	)
+	bpfProg := program.Builder(
+		"bpf_integrity.o",
+		"perf_event_bpf_event",
+		"kprobe/perf_event_bpf_event",
+		"event_bpf_prog_load",
+		"kprobe",
+	)
+	bpfMap := program.Builder(
+		"bpf_integrity.o",
+		"bpf_map_init_from_attr",
+		"kprobe/bpf_map_init_from_attr",
+		"event_bpf_map_create",
+		"kprobe",
+	)
+
+	progs := []*program.Program{kmod, bpfProg, bpfMap}
+	for _, p := range progs {
+		p.MapLoad = append(p.MapLoad, &program.MapLoad{Name: "integrity_config_map", Data: buf.Bytes()})
+		// The hooked functions are kernel internals, a missing one should
+		// not prevent the others from loading.
+		p.ErrorFatal = false
+	}
+
+	return &sensors.Sensor{
+		Name:  "__integrity_sensor__",
+		Progs: progs,
+		Maps:  []*program.Map{program.MapBuilder("integrity_config_map", kmod)},
+	}
+}
+
+func handleKmodLoad(r *bytes.Reader) ([]observer.Event, error) {
+	m := processapi.MsgKmodLoadEvent{}
+	err := binary.Read(r, binary.LittleEndian, &m)
+	if err != nil {
+		return nil, err
+	}
+	return []observer.Event{&integrity.MsgKmodLoadEventUnix{MsgKmodLoadEvent: m}}, nil
+}
+
+func handleBpfProgLoad(r *bytes.Reader) ([]observer.Event, error) {
+	m := processapi.MsgBpfProgLoadEvent{}
+	err := binary.Read(r, binary.LittleEndian, &m)
+	if err != nil {
+		return nil, err
+	}
+	return []observer.Event{&integrity.MsgBpfProgLoadEventUnix{MsgBpfProgLoadEvent: m}}, nil
+}
+
+func handleBpfMapCreate(r *bytes.Reader) ([]observer.Event, error) {
+	m := processapi.MsgBpfMapCreateEvent{}
+	err := binary.Read(r, binary.LittleEndian, &m)
+	if err != nil {
+		return nil, err
+	}
+	return []observer.Event{&integrity.MsgBpfMapCreateEventUnix{MsgBpfMapCreateEvent: m}}, nil
+}
+
+func init() {
+	observer.RegisterEventHandlerAtInit(ops.MSG_OP_KMOD_LOAD, handleKmodLoad)
+	observer.RegisterEventHandlerAtInit(ops.MSG_OP_BPF_PROG_LOAD, handleBpfProgLoad)
+	observer.RegisterEventHandlerAtInit(ops.MSG_OP_BPF_MAP_CREATE, handleBpfMapCreate)
+}
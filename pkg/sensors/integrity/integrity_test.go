@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package integrity
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/grpc/integrity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encode(t *testing.T, msg interface{}) *bytes.Reader {
+	var buf bytes.Buffer
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, msg))
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestHandleKmodLoad(t *testing.T) {
+	m := processapi.MsgKmodLoadEvent{
+		Common:     processapi.MsgCommon{Op: ops.MSG_OP_KMOD_LOAD, Ktime: 1234},
+		ProcessKey: processapi.MsgExecveKey{Pid: 42, Ktime: 1000},
+		Taints:     1 << 12,
+		SigKnown:   1,
+		Syscall:    -1,
+	}
+	copy(m.Name[:], "nf_tables")
+
+	events, err := handleKmodLoad(encode(t, &m))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	ev, ok := events[0].(*integrity.MsgKmodLoadEventUnix)
+	require.True(t, ok)
+	assert.Equal(t, m, ev.MsgKmodLoadEvent)
+
+	module := integrity.GetKernelModule(&ev.MsgKmodLoadEvent)
+	assert.Equal(t, "nf_tables", module.Name)
+	assert.Equal(t, uint64(1<<12), module.Taints)
+	assert.Equal(t, tetragon.KernelModuleSignature_KERNEL_MODULE_SIGNATURE_UNVERIFIED, module.Signature)
+
+	// truncated events are rejected
+	_, err = handleKmodLoad(bytes.NewReader(make([]byte, 8)))
+	assert.Error(t, err)
+}
+
+func TestHandleBpfProgLoad(t *testing.T) {
+	m := processapi.MsgBpfProgLoadEvent{
+		Common:     processapi.MsgCommon{Op: ops.MSG_OP_BPF_PROG_LOAD, Ktime: 1234},
+		ProcessKey: processapi.MsgExecveKey{Pid: 42, Ktime: 1000},
+		ProgType:   2,
+		InsnCnt:    64,
+		Id:         7,
+	}
+	copy(m.Tag[:], []byte{0xde, 0xad, 0xbe, 0xef})
+	copy(m.Name[:], "prog")
+
+	events, err := handleBpfProgLoad(encode(t, &m))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	ev, ok := events[0].(*integrity.MsgBpfProgLoadEventUnix)
+	require.True(t, ok)
+	assert.Equal(t, m, ev.MsgBpfProgLoadEvent)
+}
+
+func TestHandleBpfMapCreate(t *testing.T) {
+	m := processapi.MsgBpfMapCreateEvent{
+		Common:     processapi.MsgCommon{Op: ops.MSG_OP_BPF_MAP_CREATE, Ktime: 1234},
+		ProcessKey: processapi.MsgExecveKey{Pid: 42, Ktime: 1000},
+		MapType:    1,
+		KeySize:    4,
+		ValueSize:  8,
+		MaxEntries: 1024,
+	}
+	copy(m.Name[:], "map")
+
+	events, err := handleBpfMapCreate(encode(t, &m))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	ev, ok := events[0].(*integrity.MsgBpfMapCreateEventUnix)
+	require.True(t, ok)
+	assert.Equal(t, m, ev.MsgBpfMapCreateEvent)
+}
@@ -208,6 +208,7 @@ func (s *Sensor) LoadMaps(stopCtx context.Context, mapDir string) error {
 			if err != nil {
 				return fmt.Errorf("failed to open collection '%s': %w", m.Prog.Name, err)
 			}
+			program.RewriteEvents(spec)
 			mapSpec, ok := spec.Maps[m.Name]
 			if !ok {
 				return fmt.Errorf("map '%s' not found from '%s'", m.Name, m.Prog.Name)
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package mount
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+
+	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/grpc/mount"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/observer"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+)
+
+const (
+	// MountFilterMax is the maximum number of target prefixes.
+	MountFilterMax = 4
+	// MountFilterPrefixLen is the maximum length of a target prefix.
+	MountFilterPrefixLen = 64
+)
+
+// MountFilter is the userspace copy of struct mount_filter in bpf_mount.c.
+type MountFilter struct {
+	Count  uint32
+	Len    [MountFilterMax]uint32
+	Prefix [MountFilterMax][MountFilterPrefixLen]byte
+}
+
+// GetMountFilter converts a list of target path prefixes to the filter
+// loaded in mount_filter_map. An empty list reports all events.
+func GetMountFilter(prefixes []string) (MountFilter, error) {
+	filter := MountFilter{}
+	if len(prefixes) > MountFilterMax {
+		return filter, fmt.Errorf("at most %d mount target prefixes are supported, got %d", MountFilterMax, len(prefixes))
+	}
+	for i, prefix := range prefixes {
+		if len(prefix) == 0 {
+			return filter, fmt.Errorf("empty mount target prefix")
+		}
+		if len(prefix) > MountFilterPrefixLen {
+			return filter, fmt.Errorf("mount target prefix %q is longer than %d bytes", prefix, MountFilterPrefixLen)
+		}
+		filter.Len[i] = uint32(len(prefix))
+		copy(filter.Prefix[i][:], prefix)
+	}
+	filter.Count = uint32(len(prefixes))
+	return filter, nil
+}
+
+// GetMountPrefixes returns the target prefixes of the mount section of a
+// tracing policy. Only the Prefix operator on the target (index 0) is
+// supported.
+func GetMountPrefixes(spec *v1alpha1.MountSpec) ([]string, error) {
+	var prefixes []string
+	for _, arg := range spec.MatchArgs {
+		if arg.Index != 0 {
+			return nil, fmt.Errorf("mount matchArgs: only the target (index 0) is supported, got index %d", arg.Index)
+		}
+		if arg.Operator != "Prefix" {
+			return nil, fmt.Errorf("mount matchArgs: only the Prefix operator is supported, got %q", arg.Operator)
+		}
+		prefixes = append(prefixes, arg.Values...)
+	}
+	return prefixes, nil
+}
+
+// GetMountSensor returns the sensor that reports mount table changes. Only
+// events with a target starting with one of prefixes are reported, or all
+// events if prefixes is empty. The programs and the filter map of the sensor
+// of a tracing policy are pinned in a directory of the policy, so that
+// policies do not share their filters.
+func GetMountSensor(policyName string, prefixes []string) (*sensors.Sensor, error) {
+	mountFilter, err := GetMountFilter(prefixes)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, mountFilter)
+
+	hooks := []struct {
+		attach string
+		label  string
+		pin    string
+	}{
+		{"path_mount", "kprobe/path_mount", "event_path_mount"},
+		{"path_umount", "kprobe/path_umount", "event_path_umount"},
+		{"security_sb_pivotroot", "kprobe/security_sb_pivotroot", "event_pivot_root"},
+		{"security_move_mount", "kprobe/security_move_mount", "event_move_mount"},
+		{"fs_context_for_mount", "kprobe/fs_context_for_mount", "event_fsopen"},
+		{"vfs_create_mount", "kprobe/vfs_create_mount", "event_fsmount"},
+	}
+
+	pinDir := ""
+	if policyName != "" {
+		pinDir = fmt.Sprintf("mount-%s", policyName)
+	}
+
+	progs := []*program.Program{}
+	for _, h := range hooks {
+		p := program.Builder("bpf_mount.o", h.attach, h.label, filepath.Join(pinDir, h.pin), "kprobe")
+		p.MapLoad = append(p.MapLoad, &program.MapLoad{Name: "mount_filter_map", Data: buf.Bytes()})
+		// path_mount() and path_umount() only exist since 5.9 and
+		// move_mount() since 5.2, a missing hook should not prevent the
+		// others from loading.
+		p.ErrorFatal = false
+		progs = append(progs, p)
+	}
+
+	return &sensors.Sensor{
+		Name:  "__mount_sensor__",
+		Progs: progs,
+		Maps:  []*program.Map{program.MapBuilderPin("mount_filter_map", filepath.Join(pinDir, "mount_filter_map"), progs[0])},
+	}, nil
+}
+
+type observerMountSensor struct{}
+
+func (k *observerMountSensor) SpecHandler(policyName string, raw interface{}) (*sensors.Sensor, error) {
+	spec, ok := raw.(*v1alpha1.TracingPolicySpec)
+	if !ok {
+		s, ok := reflect.Indirect(reflect.ValueOf(raw)).FieldByName("TracingPolicySpec").Interface().(v1alpha1.TracingPolicySpec)
+		if !ok {
+			return nil, nil
+		}
+		spec = &s
+	}
+
+	if spec.Mount == nil {
+		return nil, nil
+	}
+	if option.Config.EnableProcessMount {
+		return nil, errors.New("process_mount events are already enabled for all targets")
+	}
+	prefixes, err := GetMountPrefixes(spec.Mount)
+	if err != nil {
+		return nil, err
+	}
+	return GetMountSensor(policyName, prefixes)
+}
+
+func (k *observerMountSensor) LoadProbe(args sensors.LoadProbeArgs) error {
+	return program.LoadKprobeProgram(args.BPFDir, args.MapDir, args.Load, args.Verbose)
+}
+
+func handleMount(r *bytes.Reader) ([]observer.Event, error) {
+	m := processapi.MsgMountEvent{}
+	err := binary.Read(r, binary.LittleEndian, &m)
+	if err != nil {
+		return nil, err
+	}
+	return []observer.Event{&mount.MsgMountEventUnix{MsgMountEvent: m}}, nil
+}
+
+func init() {
+	sensors.RegisterTracingSensorsAtInit("mount", &observerMountSensor{})
+	observer.RegisterEventHandlerAtInit(ops.MSG_OP_MOUNT, handleMount)
+}
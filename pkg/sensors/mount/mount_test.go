@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package mount
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMountFilter(t *testing.T) {
+	// no prefixes: report everything
+	f, err := GetMountFilter(nil)
+	require.NoError(t, err)
+	assert.Equal(t, MountFilter{}, f)
+
+	f, err = GetMountFilter([]string{"/var/lib/kubelet", "/run"})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), f.Count)
+	assert.Equal(t, uint32(16), f.Len[0])
+	assert.Equal(t, uint32(4), f.Len[1])
+	assert.Equal(t, "/var/lib/kubelet", string(f.Prefix[0][:f.Len[0]]))
+	assert.Equal(t, "/run", string(f.Prefix[1][:f.Len[1]]))
+	assert.Equal(t, uint32(0), f.Len[2])
+
+	_, err = GetMountFilter([]string{"/a", "/b", "/c", "/d", "/e"})
+	assert.Error(t, err)
+
+	_, err = GetMountFilter([]string{"/" + strings.Repeat("a", MountFilterPrefixLen)})
+	assert.Error(t, err)
+
+	_, err = GetMountFilter([]string{""})
+	assert.Error(t, err)
+}
+
+func TestGetMountPrefixes(t *testing.T) {
+	prefixes, err := GetMountPrefixes(&v1alpha1.MountSpec{})
+	require.NoError(t, err)
+	assert.Empty(t, prefixes)
+
+	prefixes, err = GetMountPrefixes(&v1alpha1.MountSpec{MatchArgs: []v1alpha1.ArgSelector{
+		{Index: 0, Operator: "Prefix", Values: []string{"/var/lib/kubelet"}},
+		{Index: 0, Operator: "Prefix", Values: []string{"/run", "/mnt"}},
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/var/lib/kubelet", "/run", "/mnt"}, prefixes)
+
+	_, err = GetMountPrefixes(&v1alpha1.MountSpec{MatchArgs: []v1alpha1.ArgSelector{
+		{Index: 1, Operator: "Prefix", Values: []string{"/run"}},
+	}})
+	assert.Error(t, err)
+
+	_, err = GetMountPrefixes(&v1alpha1.MountSpec{MatchArgs: []v1alpha1.ArgSelector{
+		{Index: 0, Operator: "Equal", Values: []string{"/run"}},
+	}})
+	assert.Error(t, err)
+}
+
+func TestMountSpecHandler(t *testing.T) {
+	h := &observerMountSensor{}
+
+	// policies without a mount section do not load the sensor
+	s, err := h.SpecHandler("policy", &v1alpha1.TracingPolicySpec{})
+	require.NoError(t, err)
+	assert.Nil(t, s)
+
+	s, err = h.SpecHandler("policy", &v1alpha1.TracingPolicySpec{
+		Mount: &v1alpha1.MountSpec{MatchArgs: []v1alpha1.ArgSelector{
+			{Index: 0, Operator: "Prefix", Values: []string{"/run"}},
+		}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, s)
+	assert.Equal(t, "__mount_sensor__", s.Name)
+
+	// the BPF filter holds at most MountFilterMax prefixes
+	_, err = h.SpecHandler("policy", &v1alpha1.TracingPolicySpec{
+		Mount: &v1alpha1.MountSpec{MatchArgs: []v1alpha1.ArgSelector{
+			{Index: 0, Operator: "Prefix", Values: []string{"/a", "/b", "/c", "/d", "/e"}},
+		}},
+	})
+	assert.Error(t, err)
+}
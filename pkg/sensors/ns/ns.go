@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package ns
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/grpc/ns"
+	"github.com/cilium/tetragon/pkg/observer"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+)
+
+var (
+	SwitchNs = program.Builder(
+		"bpf_ns.o",
+		"switch_task_namespaces",
+		"kprobe/switch_task_namespaces",
+		"event_switch_task_namespaces",
+		"kprobe",
+	)
+
+	CloneNs = program.Builder(
+		"bpf_ns.o",
+		"wake_up_new_task",
+		"kprobe/wake_up_new_task",
+		"event_ns_wake_up_new_task",
+		"kprobe",
+	)
+
+	CommitCredsNs = program.Builder(
+		"bpf_ns.o",
+		"commit_creds",
+		"kprobe/commit_creds",
+		"event_ns_commit_creds",
+		"kprobe",
+	)
+)
+
+// GetNsSensor returns the sensor that reports namespace changes done by
+// setns(), unshare() and clone(). User namespace changes of setns() and
+// unshare() are reported when the new credentials are committed.
+func GetNsSensor() *sensors.Sensor {
+	return &sensors.Sensor{
+		Name:  "__ns_sensor__",
+		Progs: []*program.Program{SwitchNs, CloneNs, CommitCredsNs},
+		Maps:  []*program.Map{},
+	}
+}
+
+func handleNsChange(r *bytes.Reader) ([]observer.Event, error) {
+	m := processapi.MsgNsChangeEvent{}
+	err := binary.Read(r, binary.LittleEndian, &m)
+	if err != nil {
+		return nil, err
+	}
+	msgUnix := &ns.MsgNsChangeEventUnix{MsgNsChangeEvent: m}
+	return []observer.Event{msgUnix}, nil
+}
+
+func init() {
+	observer.RegisterEventHandlerAtInit(ops.MSG_OP_NS_CHANGE, handleNsChange)
+}
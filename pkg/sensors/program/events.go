@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package program
+
+import (
+	"os"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/tetragon/pkg/bpf"
+	"github.com/cilium/tetragon/pkg/defaults"
+	"github.com/cilium/tetragon/pkg/option"
+)
+
+const (
+	// EventsMapName is the map the BPF programs send events to.
+	EventsMapName = "tcpmon_map"
+	// EventsErrorsMapName is the per-CPU counter of the events that could
+	// not be reserved in the ring buffer.
+	EventsErrorsMapName = "tcpmon_map_errors"
+)
+
+// EventsRingBuf returns true if events are sent through a BPF ring buffer,
+// and false if they are sent through per-CPU perf buffers on kernels without
+// BPF_MAP_TYPE_RINGBUF.
+func EventsRingBuf() bool {
+	return bpf.HasRingBuf()
+}
+
+// EventsRingBufSize returns the size of the events ring buffer: the configured
+// size rounded up to a power of 2 number of pages.
+func EventsRingBufSize() uint32 {
+	want := option.Config.BPFRingBufSize
+	if want <= 0 {
+		want = defaults.DefaultBPFRingBufSize
+	}
+	size := os.Getpagesize()
+	for size < want {
+		size <<= 1
+	}
+	return uint32(size)
+}
+
+// RewriteEvents sets up the events transport of spec. On kernels supporting
+// it, the events map becomes a BPF ring buffer and the programs are told to
+// use it through g_events_ringbuf, see bpf/lib/hubble_msg.h.
+func RewriteEvents(spec *ebpf.CollectionSpec) {
+	ringbuf := uint64(0)
+	if EventsRingBuf() {
+		ringbuf = 1
+		if m, ok := spec.Maps[EventsMapName]; ok {
+			m.Type = ebpf.RingBuf
+			m.KeySize = 0
+			m.ValueSize = 0
+			m.MaxEntries = EventsRingBufSize()
+		}
+	}
+	rewriteGlobals(spec, map[string]uint64{
+		"g_events_ringbuf": ringbuf,
+	})
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package program
+
+import (
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/asm"
+	"github.com/cilium/ebpf/btf"
+)
+
+const rodataSection = ".rodata"
+
+// globalVars returns the offsets in .rodata of the global variables declared
+// with the GLOBAL_XXX macros of bpf/lib/globals.h, i.e. unions with a __val
+// member.
+func globalVars(spec *ebpf.MapSpec) map[uint32]string {
+	ret := map[uint32]string{}
+	ds, ok := spec.Value.(*btf.Datasec)
+	if !ok {
+		return ret
+	}
+	for _, vs := range ds.Vars {
+		v, ok := vs.Type.(*btf.Var)
+		if !ok {
+			continue
+		}
+		u, ok := btf.UnderlyingType(v.Type).(*btf.Union)
+		if !ok {
+			continue
+		}
+		for _, m := range u.Members {
+			if m.Name == "__val" {
+				ret[vs.Offset] = v.Name
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// rewriteGlobals rewrites the loads of the global variables declared with the
+// GLOBAL_XXX macros of bpf/lib/globals.h into constant loads of their values.
+// Variables missing from values are set to 0. The .rodata map is dropped if
+// nothing else references it, so that the programs also load on kernels
+// without global data support.
+func rewriteGlobals(spec *ebpf.CollectionSpec, values map[string]uint64) {
+	rodata, ok := spec.Maps[rodataSection]
+	if !ok {
+		return
+	}
+	vars := globalVars(rodata)
+
+	referenced := false
+	for _, prog := range spec.Programs {
+		for i := range prog.Instructions {
+			ins := &prog.Instructions[i]
+			if ins.Reference() != rodataSection || !ins.IsLoadFromMap() || ins.Src != asm.PseudoMapValue {
+				continue
+			}
+			name, ok := vars[uint32(uint64(ins.Constant)>>32)]
+			if !ok {
+				referenced = true
+				continue
+			}
+			ins.Src = asm.R0
+			ins.Constant = int64(values[name])
+			*ins = ins.WithReference("")
+		}
+	}
+
+	if !referenced {
+		delete(spec.Maps, rodataSection)
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package program
+
+import (
+	"testing"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/asm"
+	"github.com/cilium/ebpf/btf"
+	"github.com/stretchr/testify/assert"
+)
+
+// rodataLoad returns the load of the address of .rodata+offset, as emitted by
+// READ_GLOBAL.
+func rodataLoad(offset uint32) asm.Instruction {
+	ins := asm.LoadMapValue(asm.R1, 0, offset)
+	return ins.WithReference(rodataSection)
+}
+
+func globalSpec() *ebpf.CollectionSpec {
+	u64 := &btf.Int{Name: "uint64_t", Size: 8}
+	global := &btf.Volatile{Type: &btf.Const{Type: &btf.Union{
+		Size: 8,
+		Members: []btf.Member{
+			{Name: "__typ", Type: &btf.Int{Name: "uint32_t", Size: 4}},
+			{Name: "__val", Type: u64},
+		},
+	}}}
+	return &ebpf.CollectionSpec{
+		Maps: map[string]*ebpf.MapSpec{
+			rodataSection: {
+				Name: rodataSection,
+				Type: ebpf.Array,
+				Key:  &btf.Void{},
+				Value: &btf.Datasec{
+					Name: rodataSection,
+					Size: 16,
+					Vars: []btf.VarSecinfo{
+						{Type: &btf.Var{Name: "g_foo", Type: global}, Offset: 0, Size: 8},
+						{Type: &btf.Var{Name: "plain", Type: u64}, Offset: 8, Size: 8},
+					},
+				},
+			},
+		},
+		Programs: map[string]*ebpf.ProgramSpec{
+			"prog": {
+				Instructions: asm.Instructions{
+					rodataLoad(0),
+					asm.Return(),
+				},
+			},
+		},
+	}
+}
+
+func TestRewriteGlobals(t *testing.T) {
+	spec := globalSpec()
+	rewriteGlobals(spec, map[string]uint64{"g_foo": 42, "g_missing": 1})
+
+	ins := spec.Programs["prog"].Instructions[0]
+	assert.True(t, ins.IsConstantLoad(asm.DWord))
+	assert.Equal(t, int64(42), ins.Constant)
+	assert.Equal(t, "", ins.Reference())
+	// Nothing references .rodata anymore.
+	assert.NotContains(t, spec.Maps, rodataSection)
+
+	// Missing values are set to 0.
+	spec = globalSpec()
+	rewriteGlobals(spec, nil)
+	assert.Equal(t, int64(0), spec.Programs["prog"].Instructions[0].Constant)
+
+	// Loads of other constants are left alone.
+	spec = globalSpec()
+	prog := spec.Programs["prog"]
+	prog.Instructions = append(asm.Instructions{rodataLoad(8)}, prog.Instructions...)
+	rewriteGlobals(spec, map[string]uint64{"g_foo": 42})
+	assert.Equal(t, rodataSection, prog.Instructions[0].Reference())
+	assert.Equal(t, int64(42), prog.Instructions[1].Constant)
+	assert.Contains(t, spec.Maps, rodataSection)
+}
@@ -243,6 +243,7 @@ func doLoadProgram(
 	if err != nil {
 		return nil, fmt.Errorf("loading collection spec failed: %w", err)
 	}
+	RewriteEvents(spec)
 
 	// Find all the maps referenced by the program, so we'll rewrite only
 	// the ones used.
@@ -373,6 +374,14 @@ func doLoadProgram(
 
 	pinPath := filepath.Join(bpfDir, load.PinPath)
 
+	// create the directory of the pin file, if any, as LoadMaps does for
+	// maps
+	if dir := filepath.Dir(load.PinPath); dir != "." {
+		if err := os.MkdirAll(filepath.Join(bpfDir, dir), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create directory for '%s': %w", load.Label, err)
+		}
+	}
+
 	if _, err := os.Stat(pinPath); err == nil {
 		logger.GetLogger().Warnf("Pin file '%s' already exists, repinning", load.PinPath)
 		if err := os.Remove(pinPath); err != nil {
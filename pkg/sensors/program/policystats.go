@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package program
+
+const (
+	// PolicyStatsMapName is the per-CPU array of the counters of a tracing
+	// policy hook, see bpf/process/policy_stats.h.
+	PolicyStatsMapName = "policy_stats"
+
+	// Indexes of the counters in PolicyStatsMapName. Actions are counted at
+	// PolicyStatsAction + action id.
+	PolicyStatsFiltered = 0
+	PolicyStatsLost     = 1
+	PolicyStatsAction   = 2
+	PolicyStatsMax      = 8
+)
@@ -69,6 +69,10 @@ type Program struct {
 	// LoaderData represents per-type specific fields.
 	LoaderData interface{}
 
+	// Policy is the name of the tracing policy the program was created
+	// for, empty for the programs of the base sensors.
+	Policy string
+
 	MapLoad []*MapLoad
 
 	// unloader for the program. nil if not loaded.
@@ -91,6 +95,11 @@ func (p *Program) SetLoaderData(d interface{}) *Program {
 	return p
 }
 
+func (p *Program) SetPolicy(name string) *Program {
+	p.Policy = name
+	return p
+}
+
 func (p *Program) Unload() error {
 	if p.unloader == nil {
 		return nil
@@ -87,6 +87,8 @@ var (
 	registeredTracingSensors = map[string]tracingSensor{}
 	// list of registers loaders, see registerProbeType()
 	registeredProbeLoad = map[string]tracingSensor{}
+	// list of registered policy handlers, see RegisterPolicyHandlerAtInit()
+	registeredPolicyHandlers = map[string]policyHandler{}
 
 	manager *Manager
 )
@@ -103,6 +105,17 @@ func RegisterTracingSensorsAtInit(name string, s tracingSensor) {
 	registeredTracingSensors[name] = s
 }
 
+// RegisterPolicyHandlerAtInit registers a handler for the parts of tracing
+// policies that are not implemented by sensors.
+//
+// This function is meant to be called in an init().
+func RegisterPolicyHandlerAtInit(name string, h policyHandler) {
+	if _, exists := registeredPolicyHandlers[name]; exists {
+		panic(fmt.Sprintf("RegisterPolicyHandlerAtInit called, but %s is already registered", name))
+	}
+	registeredPolicyHandlers[name] = h
+}
+
 // RegisterProbeType registers a handler for a probe type string
 //
 // This function is meant to be called in an init() by sensors that
@@ -138,7 +151,9 @@ func LogRegisteredSensorsAndProbes() {
 }
 
 type tracingSensor interface {
-	SpecHandler(interface{}) (*Sensor, error)
+	// SpecHandler returns the sensor for the tracing policy named
+	// policyName, or nil if the policy does not use this sensor.
+	SpecHandler(policyName string, spec interface{}) (*Sensor, error)
 	LoadProbe(args LoadProbeArgs) error
 }
 
@@ -163,11 +178,42 @@ func RegisterSensorAtInit(s *Sensor) {
 	availableSensors[s.Name] = []*Sensor{s}
 }
 
-func GetSensorsFromParserPolicy(spec interface{}) ([]*Sensor, error) {
+type policyHandler interface {
+	// PolicyAdded is called when the tracing policy named policyName is
+	// added, before its sensors are loaded. An error rejects the policy.
+	PolicyAdded(policyName string, spec interface{}) error
+	// PolicyDeleted is called when the tracing policy named policyName
+	// is deleted or could not be added.
+	PolicyDeleted(policyName string)
+}
+
+func policyAdded(policyName string, spec interface{}) error {
+	for name, h := range registeredPolicyHandlers {
+		if err := h.PolicyAdded(policyName, spec); err != nil {
+			policyDeleted(policyName)
+			return fmt.Errorf("policy handler %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func policyDeleted(policyName string) {
+	for _, h := range registeredPolicyHandlers {
+		h.PolicyDeleted(policyName)
+	}
+}
+
+// GetSensorsFromParserPolicy returns the sensors of the tracing policy named
+// policyName, and notifies the policy handlers of the policy.
+func GetSensorsFromParserPolicy(policyName string, spec interface{}) ([]*Sensor, error) {
+	if err := policyAdded(policyName, spec); err != nil {
+		return nil, err
+	}
 	var sensors []*Sensor
 	for _, s := range registeredTracingSensors {
-		sensor, err := s.SpecHandler(spec)
+		sensor, err := s.SpecHandler(policyName, spec)
 		if err != nil {
+			policyDeleted(policyName)
 			return nil, err
 		}
 		if sensor == nil {
@@ -48,9 +48,12 @@ func StartSensorManager(bpfDir, mapDir, ciliumDir string) (*Manager, error) {
 					err = fmt.Errorf("sensor %s already exists", op.sensorName)
 					break
 				}
+				if err = policyAdded(op.sensorName, op.spec); err != nil {
+					break
+				}
 				sensors := []*Sensor{}
 				for _, s := range registeredTracingSensors {
-					sensor, err = s.SpecHandler(op.spec)
+					sensor, err = s.SpecHandler(op.sensorName, op.spec)
 					if err != nil {
 						break
 					}
@@ -67,6 +70,9 @@ func StartSensorManager(bpfDir, mapDir, ciliumDir string) (*Manager, error) {
 					}
 					sensors = append(sensors, sensor)
 				}
+				if err != nil {
+					policyDeleted(op.sensorName)
+				}
 				availableSensors[op.sensorName] = sensors
 
 			case *tracingPolicyDel:
@@ -87,6 +93,7 @@ func StartSensorManager(bpfDir, mapDir, ciliumDir string) (*Manager, error) {
 				if len(errs) > 0 {
 					err = fmt.Errorf("errors unloading sensor %s: %s", op.sensorName, strings.Join(errs, ", "))
 				}
+				policyDeleted(op.sensorName)
 				delete(availableSensors, op.sensorName)
 
 			case *sensorAdd:
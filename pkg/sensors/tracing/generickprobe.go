@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strconv"
+	"sync"
 
 	"github.com/cilium/tetragon/pkg/api/ops"
 	api "github.com/cilium/tetragon/pkg/api/tracingapi"
@@ -86,6 +87,12 @@ type genericKprobe struct {
 	argReturnPrinters []argPrinters
 	funcName          string
 
+	// policyName is the name of the tracing policy of the kprobe, message
+	// and tags are copied from its spec into every event.
+	policyName string
+	message    string
+	tags       []string
+
 	// userReturnFilters are filter specs implemented in userspace after
 	// receiving events on the return value. We currently use this for return
 	// arg filtering.
@@ -95,7 +102,8 @@ type genericKprobe struct {
 	// the map, so that we can merge them when the return event is
 	// generated. The events are maintained in the map below, using
 	// ThreadId as the key.
-	pendingEvents map[uint64]pendingEvent
+	pendingEvents     map[uint64]pendingEvent
+	pendingEventsLock sync.Mutex
 
 	tableId idtable.EntryID
 }
@@ -182,7 +190,7 @@ func initBinaryNames(spec *v1alpha1.KProbeSpec) error {
 	return nil
 }
 
-func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec) (*sensors.Sensor, error) {
+func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec, policyName string) (*sensors.Sensor, error) {
 	var progs []*program.Program
 	var maps []*program.Map
 
@@ -214,8 +222,23 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec) (*sensors.Sensor, er
 			}
 		}
 
-		// Parse Arguments
-		for j, a := range f.Args {
+		// Parse Arguments. Resolved types are set on a copy of the args,
+		// the spec might be shared with the informer cache.
+		args := make([]v1alpha1.KProbeArg, len(f.Args))
+		copy(args, f.Args)
+		for j, a := range args {
+			var btfArg [api.MaxBTFArgDepth]api.ConfigBTFArg
+			if a.Resolve != "" {
+				if a.ReturnCopy {
+					return nil, fmt.Errorf("Arg(%d) resolve '%s' cannot be used with returnCopy", j, a.Resolve)
+				}
+				btfArg, a.Type, err = btf.ResolveBTFArg(btfobj, f, &a)
+				if err != nil {
+					return nil, fmt.Errorf("Arg(%d) resolve '%s' failed: %w", j, a.Resolve, err)
+				}
+				// selectors on this argument use the type of the resolved field
+				args[j].Type = a.Type
+			}
 			argType := gt.GenericTypeFromString(a.Type)
 			if argType == gt.GenericInvalidType {
 				return nil, fmt.Errorf("Arg(%d) type '%s' unsupported", j, a.Type)
@@ -225,7 +248,7 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec) (*sensors.Sensor, er
 				return nil, err
 			}
 			if argReturnCopy(argMValue) {
-				argRetprobe = &f.Args[j]
+				argRetprobe = &args[j]
 			}
 			if a.Index > 4 {
 				return nil,
@@ -234,6 +257,7 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec) (*sensors.Sensor, er
 			}
 			config.Arg[a.Index] = int32(argType)
 			config.ArgM[a.Index] = uint32(argMValue)
+			config.BTFArg[a.Index] = btfArg
 
 			argsBTFSet[a.Index] = true
 			argP := argPrinters{index: j, ty: argType}
@@ -249,6 +273,11 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec) (*sensors.Sensor, er
 		// without context from the kprobe hook. The BTF argument 'argreturn'
 		// instructs the BPF kretprobe program which type of copy to use. And
 		// argReturnPrinters tell golang printer piece how to print the event.
+		// Resolving fields of the return value is not supported, the
+		// kretprobe program only reads the raw value.
+		if f.ReturnArg.Resolve != "" {
+			return nil, fmt.Errorf("ReturnArg resolve '%s' unsupported, resolve can only be used with args", f.ReturnArg.Resolve)
+		}
 		if f.Return {
 			argType := gt.GenericTypeFromString(f.ReturnArg.Type)
 			if argType == gt.GenericInvalidType {
@@ -289,8 +318,10 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec) (*sensors.Sensor, er
 			}
 		}
 
-		// Parse Filters into kernel filter logic
-		kernelSelectors, err := selectors.InitKernelSelectors(f)
+		// Parse Filters into kernel filter logic, with the resolved args
+		selectorsSpec := *f
+		selectorsSpec.Args = args
+		kernelSelectors, err := selectors.InitKernelSelectors(&selectorsSpec)
 		if err != nil {
 			return nil, err
 		}
@@ -336,6 +367,8 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec) (*sensors.Sensor, er
 			config.Sigkill = 0
 		}
 
+		config.Stack = getStackFlags(f.KernelStack, stackKernel) | getStackFlags(f.UserStack, stackUser)
+
 		// create a new entry on the table, and pass its id to BPF-side
 		// so that we can do the matching at event-generation time
 		kprobeEntry := genericKprobe{
@@ -349,6 +382,9 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec) (*sensors.Sensor, er
 			argReturnPrinters: argReturnPrinters,
 			userReturnFilters: userReturnFilters,
 			funcName:          funcName,
+			policyName:        policyName,
+			message:           f.Message,
+			tags:              f.Tags,
 			pendingEvents:     map[uint64]pendingEvent{},
 			tableId:           idtable.UninitializedEntryID,
 		}
@@ -371,7 +407,8 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec) (*sensors.Sensor, er
 			"kprobe/generic_kprobe",
 			pinFile,
 			"generic_kprobe").
-			SetLoaderData(kprobeEntry.tableId)
+			SetLoaderData(kprobeEntry.tableId).
+			SetPolicy(policyName)
 		load.Override = hasOverride
 		progs = append(progs, load)
 
@@ -384,6 +421,9 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec) (*sensors.Sensor, er
 		retProbe := program.MapBuilderPin("retprobe_map", fmt.Sprintf("%s/retprobe_map", kprobeEntry.getMapDir()), load)
 		maps = append(maps, retProbe)
 
+		policyStats := program.MapBuilderPin(program.PolicyStatsMapName, fmt.Sprintf("%s/%s", kprobeEntry.getMapDir(), program.PolicyStatsMapName), load)
+		maps = append(maps, policyStats)
+
 		if setRetprobe {
 			loadret := program.Builder(
 				path.Join(option.Config.HubbleLib, loadProgRetName),
@@ -392,11 +432,15 @@ func addGenericKprobeSensors(kprobes []v1alpha1.KProbeSpec) (*sensors.Sensor, er
 				"kretprobe"+"_"+funcName,
 				"generic_kprobe").
 				SetRetProbe(true).
-				SetLoaderData(kprobeEntry.tableId)
+				SetLoaderData(kprobeEntry.tableId).
+				SetPolicy(policyName)
 			progs = append(progs, loadret)
 
 			retProbe := program.MapBuilderPin("retprobe_map", fmt.Sprintf("%s/retprobe_map", kprobeEntry.getMapDir()), loadret)
 			maps = append(maps, retProbe)
+
+			policyStats := program.MapBuilderPin(program.PolicyStatsMapName, fmt.Sprintf("%s/%s", kprobeEntry.getMapDir(), program.PolicyStatsMapName), loadret)
+			maps = append(maps, policyStats)
 		}
 
 		logger.GetLogger().Infof("Added generic kprobe sensor: %s -> %s", load.Name, load.Attach)
@@ -531,11 +575,21 @@ func handleGenericKprobe(r *bytes.Reader) ([]observer.Event, error) {
 	unix.Id = m.Id
 	unix.Action = m.ActionId
 	unix.FuncName = gk.funcName
+	unix.PolicyName = gk.policyName
+	unix.Message = gk.message
+	unix.Tags = gk.tags
+	unix.Selector = m.Selector
 	unix.Namespaces = m.Namespaces
 	unix.Capabilities = m.Capabilities
 
 	returnEvent := m.Common.Flags > 0
 
+	if !returnEvent {
+		kernelStack, userStack := gk.readStacks(r, &m)
+		unix.KernelStackTrace = kernelStackTrace(kernelStack)
+		unix.UserStackTrace = userStackTrace(m.ProcessKey.Pid, m.ProcessKey.Ktime, userStack)
+	}
+
 	var printers []argPrinters
 	if returnEvent {
 		printers = gk.argReturnPrinters
@@ -553,6 +607,54 @@ func handleGenericKprobe(r *bytes.Reader) ([]observer.Event, error) {
 				logger.GetLogger().WithError(err).Warnf("Int type error")
 			}
 
+			arg.Index = uint64(a.index)
+			arg.Value = output
+			unix.Args = append(unix.Args, arg)
+		case gt.GenericS32Type:
+			var output int32
+			var arg api.MsgGenericKprobeArgInt
+
+			err := binary.Read(r, binary.LittleEndian, &output)
+			if err != nil {
+				logger.GetLogger().WithError(err).Warnf("S32 type error")
+			}
+
+			arg.Index = uint64(a.index)
+			arg.Value = output
+			unix.Args = append(unix.Args, arg)
+		case gt.GenericU32Type:
+			var output uint32
+			var arg api.MsgGenericKprobeArgSize
+
+			err := binary.Read(r, binary.LittleEndian, &output)
+			if err != nil {
+				logger.GetLogger().WithError(err).Warnf("U32 type error")
+			}
+
+			arg.Index = uint64(a.index)
+			arg.Value = uint64(output)
+			unix.Args = append(unix.Args, arg)
+		case gt.GenericS64Type:
+			var output int64
+			var arg api.MsgGenericKprobeArgLong
+
+			err := binary.Read(r, binary.LittleEndian, &output)
+			if err != nil {
+				logger.GetLogger().WithError(err).Warnf("S64 type error")
+			}
+
+			arg.Index = uint64(a.index)
+			arg.Value = output
+			unix.Args = append(unix.Args, arg)
+		case gt.GenericU64Type:
+			var output uint64
+			var arg api.MsgGenericKprobeArgSize
+
+			err := binary.Read(r, binary.LittleEndian, &output)
+			if err != nil {
+				logger.GetLogger().WithError(err).Warnf("U64 type error")
+			}
+
 			arg.Index = uint64(a.index)
 			arg.Value = output
 			unix.Args = append(unix.Args, arg)
@@ -730,6 +832,7 @@ func handleGenericKprobe(r *bytes.Reader) ([]observer.Event, error) {
 		// if an event exist already, try to merge them. Otherwise, add
 		// the one we have in the map.
 		curr := pendingEvent{ev: unix, returnEvent: returnEvent}
+		gk.pendingEventsLock.Lock()
 		if prev, exists := gk.pendingEvents[m.ThreadId]; exists {
 			delete(gk.pendingEvents, m.ThreadId)
 			unix, retArg = retprobeMerge(prev, curr)
@@ -738,6 +841,7 @@ func handleGenericKprobe(r *bytes.Reader) ([]observer.Event, error) {
 			unix = nil
 			err = fmt.Errorf("pendingEvents")
 		}
+		gk.pendingEventsLock.Unlock()
 	}
 	if unix == nil {
 		return []observer.Event{}, err
@@ -867,7 +971,7 @@ func retprobeMerge(prev pendingEvent, curr pendingEvent) (*tracing.MsgGenericKpr
 	return enterEv, ret
 }
 
-func (k *observerKprobeSensor) SpecHandler(raw interface{}) (*sensors.Sensor, error) {
+func (k *observerKprobeSensor) SpecHandler(policyName string, raw interface{}) (*sensors.Sensor, error) {
 	spec, ok := raw.(*v1alpha1.TracingPolicySpec)
 	if !ok {
 		s, ok := reflect.Indirect(reflect.ValueOf(raw)).FieldByName("TracingPolicySpec").Interface().(v1alpha1.TracingPolicySpec)
@@ -881,7 +985,7 @@ func (k *observerKprobeSensor) SpecHandler(raw interface{}) (*sensors.Sensor, er
 		return nil, errors.New("tracing policies with both kprobes and tracepoints are not currently supported")
 	}
 	if len(spec.KProbes) > 0 {
-		return addGenericKprobeSensors(spec.KProbes)
+		return addGenericKprobeSensors(spec.KProbes, policyName)
 	}
 	return nil, nil
 }
@@ -8,6 +8,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"path"
 	"reflect"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/cilium/tetragon/pkg/selectors"
 	"github.com/cilium/tetragon/pkg/sensors"
 	"github.com/cilium/tetragon/pkg/sensors/program"
+	"github.com/cilium/tetragon/pkg/syscallinfo"
 	"github.com/cilium/tetragon/pkg/tracepoint"
 	"github.com/sirupsen/logrus"
 
@@ -35,6 +37,9 @@ const (
 	genericTP_OutputSize = 9000
 	// maximum arguments that bpf-side supports
 	genericTP_MaxArgs = 5
+	// syscallCompat marks the ids of compat syscalls in syscall audit
+	// mode, see SYSCALL_COMPAT in bpf/process/types/syscall.h.
+	syscallCompat = 1 << 32
 )
 
 var (
@@ -66,6 +71,9 @@ type genericTracepoint struct {
 
 	Selectors *v1alpha1.TracepointSpec
 
+	// name of the tracing policy of the tracepoint
+	policyName string
+
 	// index to access this on genericTracepointTable
 	tableIdx int
 }
@@ -95,6 +103,9 @@ type genericTracepointArg struct {
 	// format of the field
 	format *tracepoint.FieldFormat
 
+	// true if the tracepoint is in syscall audit mode
+	syscallAudit bool
+
 	// bpf generic type
 	genericTypeId int
 }
@@ -126,8 +137,7 @@ type GenericTracepointConf = v1alpha1.TracepointSpec
 
 // GenericTracepointConfArg represents an argument of a generic tracepoint
 //
-// This points to the index of the argument.
-// (Another option might be to specify this by name)
+// This points to the index of the argument, or to its name.
 type GenericTracepointConfArg v1alpha1.KProbeArg
 
 // getTracepointMetaArg is a temporary helper to find meta values while tracepoint
@@ -145,6 +155,16 @@ func getTracepointMetaValue(arg *GenericTracepointConfArg) int {
 // NB: making this a method of GenericTracepointConfArg means that we can have
 // this as an interface (e.g,. for implementing output by name)
 func (conf *GenericTracepointConfArg) configureTracepointArg(tp *genericTracepoint) error {
+	if conf.Resolve != "" {
+		return fmt.Errorf("tracepoint %s/%s: resolve is only supported for kprobes", tp.Info.Subsys, tp.Info.Event)
+	}
+	if conf.Name != "" {
+		idx, err := tp.Info.Format.FieldIndex(conf.Name)
+		if err != nil {
+			return fmt.Errorf("tracepoint %s/%s: %w", tp.Info.Subsys, tp.Info.Event, err)
+		}
+		conf.Index = uint32(idx)
+	}
 	if conf.Index >= uint32(len(tp.Info.Format.Fields)) {
 		return fmt.Errorf("tracepoint %s/%s has %d fields but field %d was requested",
 			tp.Info.Subsys, tp.Info.Event, len(tp.Info.Format.Fields), conf.Index)
@@ -184,6 +204,15 @@ func (out *genericTracepointArg) getGenericTypeId() (int, error) {
 		return gt.GenericInvalidType, errors.New("format is nil")
 	}
 
+	if out.syscallAudit {
+		switch out.format.Name() {
+		case "id":
+			return gt.GenericSyscallId, nil
+		case "args":
+			return gt.GenericSyscallArgs, nil
+		}
+	}
+
 	if out.format.Field == nil {
 		err := out.format.ParseField()
 		if err != nil {
@@ -234,6 +263,17 @@ func (out *genericTracepointArg) getGenericTypeId() (int, error) {
 
 	case tracepoint.SizeTy:
 		return gt.GenericSizeType, nil
+
+	case tracepoint.PidTy:
+		return gt.GenericS32Type, nil
+
+	// __data_loc char[] fields are strings within the tracepoint data.
+	case tracepoint.DataLocTy:
+		intTy, ok := ty.Ty.(tracepoint.IntTy)
+		if !ok || intTy.Base != tracepoint.IntTyChar {
+			return gt.GenericInvalidType, fmt.Errorf("cannot handle __data_loc type to %T", ty.Ty)
+		}
+		return gt.GenericStringType, nil
 	}
 
 	return gt.GenericInvalidType, fmt.Errorf("Unknown type: %T", out.format.Field.Type)
@@ -241,7 +281,7 @@ func (out *genericTracepointArg) getGenericTypeId() (int, error) {
 
 // createGenericTracepoint creates the genericTracepoint information based on
 // the user-provided configuration
-func createGenericTracepoint(conf *GenericTracepointConf) (*genericTracepoint, error) {
+func createGenericTracepoint(conf *GenericTracepointConf, policyName string) (*genericTracepoint, error) {
 	tp := tracepoint.Tracepoint{
 		Subsys: conf.Subsystem,
 		Event:  conf.Event,
@@ -252,13 +292,21 @@ func createGenericTracepoint(conf *GenericTracepointConf) (*genericTracepoint, e
 	}
 
 	ret := &genericTracepoint{
-		Info:      &tp,
-		Selectors: conf,
+		Info:       &tp,
+		Selectors:  conf,
+		policyName: policyName,
+	}
+
+	if conf.SyscallAudit {
+		if err := configureSyscallAudit(conf); err != nil {
+			return nil, err
+		}
 	}
 
 	for i := range conf.Args {
 		arg := GenericTracepointConfArg{
 			Index:        conf.Args[i].Index,
+			Name:         conf.Args[i].Name,
 			SizeArgIndex: conf.Args[i].SizeArgIndex,
 			ReturnCopy:   conf.Args[i].ReturnCopy,
 		}
@@ -295,6 +343,18 @@ func createGenericTracepoint(conf *GenericTracepointConf) (*genericTracepoint, e
 		return &ret.args[argIdx], nil
 	}
 
+	if conf.SyscallAudit {
+		// matchArgs of index 0 match the id field
+		for i := range conf.Selectors {
+			for j := range conf.Selectors[i].MatchArgs {
+				conf.Selectors[i].MatchArgs[j].Index = uint32(ret.args[0].TpIdx)
+			}
+		}
+		for i := range ret.args {
+			ret.args[i].syscallAudit = true
+		}
+	}
+
 	for idx := 0; idx < len(ret.args); idx++ {
 		meta := ret.args[idx].MetaTp
 		if meta == 0 || meta == -1 {
@@ -312,12 +372,39 @@ func createGenericTracepoint(conf *GenericTracepointConf) (*genericTracepoint, e
 	return ret, nil
 }
 
+// configureSyscallAudit configures the arguments of a raw_syscalls/sys_enter
+// tracepoint in syscall audit mode: the id and args fields. It also checks that
+// selectors only match the syscall (index 0). Selectors are copied since their
+// indexes are rewritten.
+func configureSyscallAudit(conf *GenericTracepointConf) error {
+	if conf.Subsystem != "raw_syscalls" || conf.Event != "sys_enter" {
+		return fmt.Errorf("syscall audit mode is only supported for raw_syscalls/sys_enter, not %s/%s",
+			conf.Subsystem, conf.Event)
+	}
+	if len(conf.Args) > 0 {
+		return errors.New("syscall audit mode does not support args")
+	}
+	conf.Args = []v1alpha1.KProbeArg{{Name: "id"}, {Name: "args"}}
+
+	selectors := make([]v1alpha1.KProbeSelector, len(conf.Selectors))
+	for i := range conf.Selectors {
+		conf.Selectors[i].DeepCopyInto(&selectors[i])
+		for _, arg := range selectors[i].MatchArgs {
+			if arg.Index != 0 {
+				return fmt.Errorf("syscall audit mode: matchArgs index %d unsupported, only the syscall (index 0) can be matched", arg.Index)
+			}
+		}
+	}
+	conf.Selectors = selectors
+	return nil
+}
+
 // createGenericTracepointSensor will create a sensor that can be loaded based on a generic tracepoint configuration
-func createGenericTracepointSensor(confs []GenericTracepointConf) (*sensors.Sensor, error) {
+func createGenericTracepointSensor(confs []GenericTracepointConf, policyName string) (*sensors.Sensor, error) {
 
 	tracepoints := make([]*genericTracepoint, 0, len(confs))
 	for _, conf := range confs {
-		tp, err := createGenericTracepoint(&conf)
+		tp, err := createGenericTracepoint(&conf, policyName)
 		if err != nil {
 			return nil, err
 		}
@@ -340,7 +427,7 @@ func createGenericTracepointSensor(confs []GenericTracepointConf) (*sensors.Sens
 			"tracepoint/generic_tracepoint",
 			pinFile,
 			"generic_tracepoint",
-		)
+		).SetPolicy(policyName)
 
 		prog0.LoaderData = tp.tableIdx
 		progs = append(progs, prog0)
@@ -350,6 +437,9 @@ func createGenericTracepointSensor(confs []GenericTracepointConf) (*sensors.Sens
 
 		tailCalls := program.MapBuilderPin("tp_calls", fmt.Sprintf("%s-tp-calls", pinFile), prog0)
 		maps = append(maps, tailCalls)
+
+		policyStats := program.MapBuilderPin(program.PolicyStatsMapName, fmt.Sprintf("%s-policy-stats", pinFile), prog0)
+		maps = append(maps, policyStats)
 	}
 
 	return &sensors.Sensor{
@@ -399,6 +489,13 @@ func LoadGenericTracepointSensor(bpfDir, mapDir string, load *program.Program, v
 		config.ArgM[i] = uint32(0)
 	}
 
+	// Selectors are built from a copy of the spec, since arg indexes are
+	// rewritten from tracepoint fields to tp.args. The first args of
+	// tp.args are the args of the spec, including the ones referenced by
+	// name.
+	spec := tp.Selectors.DeepCopy()
+	argIdx := map[uint32]uint32{}
+
 	// rewrite arg index
 	for i := range tp.args {
 		tpArg := &tp.args[i]
@@ -408,25 +505,34 @@ func LoadGenericTracepointSensor(bpfDir, mapDir string, load *program.Program, v
 			return fmt.Errorf("output argument %v unsupported: %w", tpArg, err)
 		}
 
-		if len(tp.Selectors.Args) > i && tp.Selectors.Args[i].Type == "" {
-			tp.Selectors.Args[i].Type = selectors.ArgTypeToString(uint32(ty))
-		}
-
-		for j, arg := range tp.Selectors.Args {
-			if arg.Index == uint32(tpArg.TpIdx) {
-				tp.Selectors.Args[j].Index = tpArg.ArgIdx
+		if len(spec.Args) > i {
+			if spec.Args[i].Type == "" {
+				spec.Args[i].Type = selectors.ArgTypeToString(uint32(ty))
 			}
+			spec.Args[i].Index = tpArg.ArgIdx
 		}
-		for j, s := range tp.Selectors.Selectors {
-			for k, match := range s.MatchArgs {
-				if match.Index == uint32(tpArg.TpIdx) {
-					tp.Selectors.Selectors[j].MatchArgs[k].Index = uint32(tpArg.ArgIdx)
-				}
+		if _, ok := argIdx[uint32(tpArg.TpIdx)]; !ok {
+			argIdx[uint32(tpArg.TpIdx)] = tpArg.ArgIdx
+		}
+	}
+	for i := range spec.Selectors {
+		for j, match := range spec.Selectors[i].MatchArgs {
+			if idx, ok := argIdx[match.Index]; ok {
+				spec.Selectors[i].MatchArgs[j].Index = idx
 			}
 		}
 	}
 
-	kernelSelectors, err := selectors.InitTracepointSelectors(tp.Selectors)
+	if spec.SyscallAudit {
+		var stringArgs [syscallinfo.MaxSyscalls]byte
+		for id := range stringArgs {
+			stringArgs[id] = syscallinfo.StringArgs(id)
+		}
+		syscallStrings := &program.MapLoad{Name: "syscall_strings", Data: stringArgs[:]}
+		load.MapLoad = append(load.MapLoad, syscallStrings)
+	}
+
+	kernelSelectors, err := selectors.InitTracepointSelectors(spec)
 	if err != nil {
 		return err
 	}
@@ -466,13 +572,19 @@ func handleGenericTracepoint(r *bytes.Reader) ([]observer.Event, error) {
 
 	unix.Subsys = tp.Info.Subsys
 	unix.Event = tp.Info.Event
+	unix.PolicyName = tp.policyName
+	unix.Message = tp.Selectors.Message
+	unix.Tags = tp.Selectors.Tags
+	unix.Selector = m.Selector
 
+	sysID := int64(-1)
 	for idx, out := range tp.args {
 
 		if out.nopTy {
 			continue
 		}
 
+		name := out.format.Name()
 		switch out.genericTypeId {
 		case gt.GenericU64Type:
 			var val uint64
@@ -490,6 +602,22 @@ func handleGenericTracepoint(r *bytes.Reader) ([]observer.Event, error) {
 			}
 			unix.Args = append(unix.Args, val)
 
+		case gt.GenericU32Type:
+			var val uint32
+			err := binary.Read(r, binary.LittleEndian, &val)
+			if err != nil {
+				logger.GetLogger().WithError(err).Warnf("Size type error sizeof %d", m.Common.Size)
+			}
+			unix.Args = append(unix.Args, uint64(val))
+
+		case gt.GenericS32Type:
+			var val int32
+			err := binary.Read(r, binary.LittleEndian, &val)
+			if err != nil {
+				logger.GetLogger().WithError(err).Warnf("Size type error sizeof %d", m.Common.Size)
+			}
+			unix.Args = append(unix.Args, int64(val))
+
 		case gt.GenericSizeType:
 			var val uint64
 
@@ -499,6 +627,34 @@ func handleGenericTracepoint(r *bytes.Reader) ([]observer.Event, error) {
 			}
 			unix.Args = append(unix.Args, val)
 
+		case gt.GenericStringType:
+			unix.Args = append(unix.Args, handleGenericKprobeString(r))
+
+		case gt.GenericSyscallId:
+			err := binary.Read(r, binary.LittleEndian, &sysID)
+			if err != nil {
+				logger.GetLogger().WithError(err).Warnf("Size type error sizeof %d", m.Common.Size)
+			}
+			// Compat syscalls (e.g. int 0x80 on x86_64) have their
+			// own numbering and argument layout, which only the
+			// native syscall table describes, so they are dropped.
+			if sysID&syscallCompat != 0 {
+				logger.GetLogger().WithField("id", sysID&^syscallCompat).Debug("Dropping compat syscall in syscall audit mode")
+				return nil, nil
+			}
+			if name := syscallinfo.GetSyscallName(int(sysID)); name != "" {
+				unix.Args = append(unix.Args, name)
+			} else {
+				unix.Args = append(unix.Args, sysID)
+			}
+			unix.ArgLabels = append(unix.ArgLabels, "syscall")
+
+		case gt.GenericSyscallArgs:
+			if err := readSyscallArgs(r, sysID, unix); err != nil {
+				logger.GetLogger().WithError(err).Warn("failed to read syscall arguments")
+				return nil, err
+			}
+
 		case gt.GenericCharBuffer, gt.GenericCharIovec:
 			if arg, err := ReadArgBytes(r, idx); err == nil {
 				unix.Args = append(unix.Args, arg.Value)
@@ -514,30 +670,97 @@ func handleGenericTracepoint(r *bytes.Reader) ([]observer.Event, error) {
 					break
 				}
 
-				switch intTy.Base {
-				case tracepoint.IntTyLong:
-					var val uint64
-					for i := 0; i < int(arrTy.Size); i++ {
-						err := binary.Read(r, binary.LittleEndian, &val)
-						if err != nil {
-							logger.GetLogger().WithError(err).Warnf("failed to read element %d from array", i)
-							return nil, err
-						}
-						unix.Args = append(unix.Args, val)
+				// char arrays are strings (e.g., comm)
+				if intTy.Base == tracepoint.IntTyChar {
+					val := make([]byte, arrTy.Size)
+					if err := binary.Read(r, binary.LittleEndian, val); err != nil {
+						logger.GetLogger().WithError(err).Warn("failed to read char array")
+						return nil, err
 					}
-				default:
-					logger.GetLogger().Warnf("failed to read array argument: unexpected base type: %w", intTy.Base)
+					if i := bytes.IndexByte(val, 0); i != -1 {
+						val = val[:i]
+					}
+					unix.Args = append(unix.Args, string(val))
+					break
+				}
+
+				nbytes, err := intTy.NBytes()
+				if err != nil {
+					logger.GetLogger().WithError(err).Warn("failed to read array argument")
+					break
+				}
+				for i := 0; i < int(arrTy.Size); i++ {
+					val, err := readTracepointInt(r, nbytes, !intTy.Unsigned)
+					if err != nil {
+						logger.GetLogger().WithError(err).Warnf("failed to read element %d from array", i)
+						return nil, err
+					}
+					unix.Args = append(unix.Args, val)
+					unix.ArgLabels = append(unix.ArgLabels, fmt.Sprintf("%s[%d]", name, i))
 				}
 			}
 
 		default:
 			logger.GetLogger().Warnf("handleGenericTracepoint: ignoring:  %+v", out)
 		}
+
+		// arguments are labelled with the name of their field
+		for len(unix.ArgLabels) < len(unix.Args) {
+			unix.ArgLabels = append(unix.ArgLabels, name)
+		}
 	}
 	return []observer.Event{unix}, nil
 }
 
-func (t *observerTracepointSensor) SpecHandler(raw interface{}) (*sensors.Sensor, error) {
+// readSyscallArgs reads the arguments of a syscall in syscall audit mode,
+// decoded according to its signature. See copy_syscall_args() in
+// bpf/process/types/basic.h.
+func readSyscallArgs(r *bytes.Reader, sysID int64, msg *tracing.MsgGenericTracepointUnix) error {
+	var vals [syscallinfo.MaxArgs]uint64
+	if err := binary.Read(r, binary.LittleEndian, &vals); err != nil {
+		return err
+	}
+
+	name := syscallinfo.GetSyscallName(int(sysID))
+	args, ok := syscallinfo.GetSyscallArgs(name)
+	if !ok {
+		for i, val := range vals {
+			msg.Args = append(msg.Args, val)
+			msg.ArgLabels = append(msg.ArgLabels, fmt.Sprintf("args[%d]", i))
+		}
+		return nil
+	}
+
+	for i := range args {
+		if i >= syscallinfo.MaxArgs {
+			break
+		}
+		if args[i].IsString() {
+			msg.Args = append(msg.Args, handleGenericKprobeString(r))
+		} else {
+			msg.Args = append(msg.Args, syscallinfo.DecodeArg(name, &args[i], vals[i]))
+		}
+		msg.ArgLabels = append(msg.ArgLabels, args[i].Name)
+	}
+	return nil
+}
+
+// readTracepointInt reads an array element of nbytes bytes. Unsigned values
+// are returned as uint64 and signed values as int64.
+func readTracepointInt(r *bytes.Reader, nbytes int, signed bool) (interface{}, error) {
+	var buf [8]byte
+	if _, err := io.ReadFull(r, buf[:nbytes]); err != nil {
+		return nil, err
+	}
+	val := binary.LittleEndian.Uint64(buf[:])
+	if !signed {
+		return val, nil
+	}
+	shift := uint(64 - 8*nbytes)
+	return int64(val<<shift) >> shift, nil
+}
+
+func (t *observerTracepointSensor) SpecHandler(policyName string, raw interface{}) (*sensors.Sensor, error) {
 	spec, ok := raw.(*v1alpha1.TracingPolicySpec)
 	if !ok {
 		s, ok := reflect.Indirect(reflect.ValueOf(raw)).FieldByName("TracingPolicySpec").Interface().(v1alpha1.TracingPolicySpec)
@@ -551,7 +774,7 @@ func (t *observerTracepointSensor) SpecHandler(raw interface{}) (*sensors.Sensor
 		return nil, errors.New("tracing policies with both kprobes and tracepoints are not currently supported")
 	}
 	if len(spec.Tracepoints) > 0 {
-		return createGenericTracepointSensor(spec.Tracepoints)
+		return createGenericTracepointSensor(spec.Tracepoints, policyName)
 	}
 	return nil, nil
 }
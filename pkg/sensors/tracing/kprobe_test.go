@@ -2329,7 +2329,7 @@ spec:
 	cnf, _ := yaml.ReadConfigYaml(testHook)
 	if cnf != nil {
 		var err error
-		sens, err = sensors.GetSensorsFromParserPolicy(&cnf.Spec)
+		sens, err = sensors.GetSensorsFromParserPolicy(cnf.Metadata.Name, &cnf.Spec)
 		if err != nil {
 			return err
 		}
@@ -2402,6 +2402,73 @@ spec:
 	assert.NoError(t, err)
 }
 
+func TestKprobeResolveBTFArg(t *testing.T) {
+	var doneWG, readyWG sync.WaitGroup
+	defer doneWG.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), tus.Conf().CmdWaitTime)
+	defer cancel()
+
+	// a mode that we are unlikely to see from other files
+	mode := uint32(unix.S_IFREG | 0607)
+	hook := fmt.Sprintf(`apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+ name: "fd-install-mode"
+spec:
+ kprobes:
+ - call: "fd_install"
+   syscall: false
+   args:
+   - index: 0
+     type: "int"
+   - index: 1
+     resolve: "f_inode.i_mode"
+   selectors:
+   - matchArgs:
+     - index: 1
+       operator: "Equal"
+       values:
+       - "%d"
+`, mode)
+	createCrdFile(t, hook)
+
+	obs, err := observer.GetDefaultObserverWithFile(t, ctx, testConfigFile, tus.Conf().TetragonLib)
+	if err != nil {
+		t.Fatalf("GetDefaultObserverWithFile error: %s", err)
+	}
+	observer.LoopEvents(ctx, t, &doneWG, &readyWG, obs)
+	readyWG.Wait()
+
+	dir := t.TempDir()
+	fname := filepath.Join(dir, "resolve")
+	if err := ioutil.WriteFile(fname, []byte("data"), 0600); err != nil {
+		t.Fatalf("WriteFile(%s) failed: %s", fname, err)
+	}
+	if err := os.Chmod(fname, os.FileMode(mode&0777)); err != nil {
+		t.Fatalf("Chmod(%s) failed: %s", fname, err)
+	}
+	fd, err := syscall.Open(fname, syscall.O_RDONLY, 0)
+	if err != nil {
+		t.Fatalf("Open(%s) failed: %s", fname, err)
+	}
+	syscall.Close(fd)
+
+	kpChecker := ec.NewProcessKprobeChecker().
+		WithFunctionName(sm.Full("fd_install")).
+		WithArgs(ec.NewKprobeArgumentListMatcher().
+			WithOperator(lc.Ordered).
+			WithValues(
+				ec.NewKprobeArgumentChecker().WithIntArg(int32(fd)),
+				ec.NewKprobeArgumentChecker().WithSizeArg(uint64(mode)),
+			))
+
+	checker := ec.NewUnorderedEventChecker(kpChecker)
+
+	err = jsonchecker.JsonTestCheck(t, checker)
+	assert.NoError(t, err)
+}
+
 func TestLoadKprobeSensor(t *testing.T) {
 	var sensorProgs = []tus.SensorProg{
 		// kprobe
@@ -2442,6 +2509,7 @@ func TestLoadKprobeSensor(t *testing.T) {
 
 		// generic_kprobe_filter_arg*,generic_retkprobe_event,base
 		tus.SensorMap{Name: "tcpmon_map", Progs: []uint{6, 7, 8, 9, 10, 12, 13, 14, 15}},
+		tus.SensorMap{Name: "tcpmon_map_errors", Progs: []uint{6, 7, 8, 9, 10, 12, 13, 14, 15}},
 
 		// only retkprobe
 		tus.SensorMap{Name: "config_map", Progs: []uint{12}},
@@ -2452,6 +2520,9 @@ func TestLoadKprobeSensor(t *testing.T) {
 
 		// generic_kprobe_process_event*,generic_kprobe_filter_arg*,retkprobe
 		tus.SensorMap{Name: "fdinstall_map", Progs: []uint{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 12}},
+
+		// generic_kprobe_filter_arg*,generic_kprobe_process_filter,retkprobe
+		tus.SensorMap{Name: "policy_stats", Progs: []uint{6, 7, 8, 9, 10, 11, 12}},
 	}
 
 	if kernels.EnableLargeProgs() {
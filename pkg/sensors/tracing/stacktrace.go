@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	api "github.com/cilium/tetragon/pkg/api/tracingapi"
+	"github.com/cilium/tetragon/pkg/ksyms"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/usyms"
+)
+
+const (
+	// event_config stack flags
+	stackKernel = 1 << 0
+	stackUser   = 1 << 1
+
+	// perfMaxStackDepth is the maximum number of addresses of the stack
+	// traces.
+	perfMaxStackDepth = 127
+)
+
+var (
+	kernelSymbolsOnce sync.Once
+	kernelSymbols     *ksyms.Ksyms
+
+	userSymbolsOnce sync.Once
+	userSymbols     *usyms.Usyms
+)
+
+func getKernelSymbols() *ksyms.Ksyms {
+	kernelSymbolsOnce.Do(func() {
+		var err error
+		kernelSymbols, err = ksyms.NewKsyms(option.Config.ProcFS)
+		if err != nil {
+			logger.GetLogger().WithError(err).Warn("Failed to read kernel symbols, kernel stack traces will not be symbolized")
+		}
+	})
+	return kernelSymbols
+}
+
+func getUserSymbols() *usyms.Usyms {
+	userSymbolsOnce.Do(func() {
+		var err error
+		userSymbols, err = usyms.NewUsyms(option.Config.ProcFS)
+		if err != nil {
+			logger.GetLogger().WithError(err).Warn("Failed to initialize user symbols, user stack traces will not be symbolized")
+		}
+	})
+	return userSymbols
+}
+
+func getStackFlags(spec bool, flag uint32) uint32 {
+	if spec {
+		return flag
+	}
+	return 0
+}
+
+// readStacks returns the addresses of the kernel and user stack traces that
+// the BPF side copies after the args of the event (see copy_stack in
+// bpf/process/types/basic.h).
+func (g *genericKprobe) readStacks(r *bytes.Reader, m *api.MsgGenericKprobe) (kernel, user []uint64) {
+	kernelSize := g.stackSize(m.KernelStackSize)
+	userSize := g.stackSize(m.UserStackSize)
+	off := int64(binary.Size(m)) + int64(m.Common.Size) - kernelSize - userSize
+	kernel = g.readStack(r, off, kernelSize)
+	user = g.readStack(r, off+kernelSize, userSize)
+	return kernel, user
+}
+
+// stackSize returns the size of a stack trace of the event, logging the
+// stack traces that could not be captured.
+func (g *genericKprobe) stackSize(size int64) int64 {
+	if size < 0 {
+		logger.GetLogger().WithField("function", g.funcName).WithField("error", size).Debug("Failed to capture stack trace")
+		return 0
+	}
+	return size
+}
+
+// readStack reads the addresses of the stack trace of size bytes at offset off
+// of the event.
+func (g *genericKprobe) readStack(r *bytes.Reader, off, size int64) []uint64 {
+	if size == 0 {
+		return nil
+	}
+	if off < 0 || size > perfMaxStackDepth*8 || size%8 != 0 {
+		logger.GetLogger().WithField("function", g.funcName).WithField("size", size).Debug("Invalid stack trace size")
+		return nil
+	}
+	buf := make([]byte, size)
+	if _, err := r.ReadAt(buf, off); err != nil {
+		logger.GetLogger().WithError(err).WithField("function", g.funcName).Debug("Failed to read stack trace")
+		return nil
+	}
+
+	var addrs []uint64
+	for i := 0; i < len(buf); i += 8 {
+		addr := binary.LittleEndian.Uint64(buf[i:])
+		if addr == 0 {
+			break
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
+// kernelStackTrace symbolizes the addresses of a kernel stack trace.
+func kernelStackTrace(addrs []uint64) []*tetragon.StackAddress {
+	if len(addrs) == 0 {
+		return nil
+	}
+	ks := getKernelSymbols()
+	ret := make([]*tetragon.StackAddress, 0, len(addrs))
+	for _, addr := range addrs {
+		sa := &tetragon.StackAddress{Address: addr}
+		if ks != nil {
+			if fo, err := ks.GetFnOffset(addr); err == nil {
+				sa.Symbol = fo.ToString()
+			}
+		}
+		ret = append(ret, sa)
+	}
+	return ret
+}
+
+// userStackTrace symbolizes the addresses of a user stack trace of the
+// process pid whose exec happened at ktime.
+func userStackTrace(pid uint32, ktime uint64, addrs []uint64) []*tetragon.StackAddress {
+	if len(addrs) == 0 {
+		return nil
+	}
+	var syms []usyms.Symbol
+	if us := getUserSymbols(); us != nil {
+		var err error
+		// The process might have exited, addresses are reported
+		// without symbols then.
+		if syms, err = us.Symbolize(pid, ktime, addrs); err != nil {
+			logger.GetLogger().WithError(err).WithField("pid", pid).Debug("Failed to symbolize user stack trace")
+		}
+	}
+	ret := make([]*tetragon.StackAddress, 0, len(addrs))
+	for i, addr := range addrs {
+		sa := &tetragon.StackAddress{Address: addr}
+		if i < len(syms) {
+			sa.Symbol = syms[i].String()
+			sa.Module = syms[i].Module
+		}
+		ret = append(ret, sa)
+	}
+	return ret
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	api "github.com/cilium/tetragon/pkg/api/tracingapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadStacks(t *testing.T) {
+	args := []byte{1, 2, 3, 4}
+	kernel := []uint64{0xffffffff81000010, 0xffffffff81000020}
+	user := []uint64{0x401000}
+
+	m := api.MsgGenericKprobe{
+		KernelStackSize: int64(8 * len(kernel)),
+		UserStackSize:   int64(8 * len(user)),
+	}
+	m.Common.Size = uint32(len(args)) + uint32(m.KernelStackSize+m.UserStackSize)
+
+	var buf bytes.Buffer
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, &m))
+	buf.Write(args)
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, kernel))
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, user))
+	// perf records might be padded
+	buf.Write(make([]byte, 4))
+
+	g := &genericKprobe{funcName: "test"}
+	gotKernel, gotUser := g.readStacks(bytes.NewReader(buf.Bytes()), &m)
+	assert.Equal(t, kernel, gotKernel)
+	assert.Equal(t, user, gotUser)
+
+	// A stack trace that could not be captured is skipped.
+	m.KernelStackSize = -7
+	m.Common.Size = uint32(len(args)) + uint32(m.UserStackSize)
+	buf.Reset()
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, &m))
+	buf.Write(args)
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, user))
+	gotKernel, gotUser = g.readStacks(bytes.NewReader(buf.Bytes()), &m)
+	assert.Nil(t, gotKernel)
+	assert.Equal(t, user, gotUser)
+}
@@ -67,7 +67,7 @@ func TestGenericTracepointSimple(t *testing.T) {
 
 	sm := tus.StartTestSensorManager(ctx, t)
 	// create and add sensor
-	sensor, err := createGenericTracepointSensor([]GenericTracepointConf{lseekConf})
+	sensor, err := createGenericTracepointSensor([]GenericTracepointConf{lseekConf}, "policyName")
 	if err != nil {
 		t.Fatalf("failed to create generic tracepoint sensor: %s", err)
 	}
@@ -126,7 +126,7 @@ func doTestGenericTracepointPidFilter(t *testing.T, conf GenericTracepointConf,
 
 	sm := tus.StartTestSensorManager(ctx, t)
 	// create and add sensor
-	sensor, err := createGenericTracepointSensor([]GenericTracepointConf{conf})
+	sensor, err := createGenericTracepointSensor([]GenericTracepointConf{conf}, "policyName")
 	if err != nil {
 		t.Fatalf("failed to create generic tracepoint sensor: %s", err)
 	}
@@ -318,6 +318,48 @@ func TestGenericTracepointMeta(t *testing.T) {
 	doTestGenericTracepointPidFilter(t, tracepointConf, op, check)
 }
 
+// TestGenericTracepointNamedArgs checks tracepoint arguments referenced by the
+// name of their field
+func TestGenericTracepointNamedArgs(t *testing.T) {
+	tracepointConf := GenericTracepointConf{
+		Subsystem: "syscalls",
+		Event:     "sys_enter_lseek",
+		Args: []v1alpha1.KProbeArg{
+			{Name: "whence"},
+			{Name: "fd"},
+		},
+	}
+
+	op := func() {
+		t.Logf("Calling lseek...\n")
+		unix.Seek(-1, 0, whenceBogusValue)
+	}
+
+	check := func(event *tetragon.ProcessTracepoint) error {
+		expected := []struct {
+			label string
+			val   uint64
+		}{
+			{"whence", uint64(whenceBogusValue)},
+			{"fd", fdBogusValue},
+		}
+		if len(event.Args) != len(expected) {
+			return fmt.Errorf("unexpected number of args: %d", len(event.Args))
+		}
+		for i, e := range expected {
+			if event.Args[i].Label != e.label {
+				return fmt.Errorf("unexpected label of args[%d]: got:%s expecting:%s", i, event.Args[i].Label, e.label)
+			}
+			if val := event.Args[i].GetSizeArg(); val != e.val {
+				return fmt.Errorf("unexpected value of args[%d]: got:%d expecting:%d", i, val, e.val)
+			}
+		}
+		return nil
+	}
+
+	doTestGenericTracepointPidFilter(t, tracepointConf, op, check)
+}
+
 // TestRawSyscall checks raw_syscall tracepoints
 //name: sys_enter
 //ID: 346
@@ -424,8 +466,12 @@ func TestLoadTracepointSensor(t *testing.T) {
 		// all but generic_tracepoint_event,generic_tracepoint_filter
 		tus.SensorMap{Name: "retprobe_map", Progs: []uint{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
 
+		// generic_tracepoint_arg*,generic_tracepoint_filter
+		tus.SensorMap{Name: "policy_stats", Progs: []uint{1, 2, 3, 4, 5, 11}},
+
 		// generic_tracepoint_arg**,base
 		tus.SensorMap{Name: "tcpmon_map", Progs: []uint{1, 2, 3, 4, 5, 12, 13, 14}},
+		tus.SensorMap{Name: "tcpmon_map_errors", Progs: []uint{1, 2, 3, 4, 5, 12, 13, 14}},
 
 		// shared with base sensor
 		tus.SensorMap{Name: "execve_map", Progs: []uint{12, 13, 14, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}},
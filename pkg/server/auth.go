@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const serviceName = "/tetragon.FineGuidanceSensors/"
+
+// readOnlyMethods are the RPCs that do not change the state of the agent.
+// Every other RPC, including the ones added to the service later, is
+// considered mutating.
+var readOnlyMethods = map[string]bool{
+	serviceName + "GetEvents":         true,
+	serviceName + "GetHealth":         true,
+	serviceName + "ListSensors":       true,
+	serviceName + "GetSensorConfig":   true,
+	serviceName + "GetStackTraceTree": true,
+	serviceName + "GetVersion":        true,
+
+	serviceName + "ExportStackTraceTree": true,
+
+	"/grpc.health.v1.Health/Check": true,
+	"/grpc.health.v1.Health/Watch": true,
+
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": true,
+}
+
+// IsReadOnlyMethod returns true if the full gRPC method name is one of the
+// read-only RPCs.
+func IsReadOnlyMethod(method string) bool {
+	return readOnlyMethods[method]
+}
+
+// IsReadOnlyRequest returns true if req does not change the state of the
+// agent: it is a request of a read-only RPC that does not reset what it reads.
+func IsReadOnlyRequest(method string, req interface{}) bool {
+	if r, ok := req.(interface{ GetResetOnRead() bool }); ok && r.GetResetOnRead() {
+		return false
+	}
+	return IsReadOnlyMethod(method)
+}
+
+// PeerIdentity returns the identity of the caller of an RPC: the common name
+// of its verified TLS client certificate, or uid:UID for unix socket peers.
+// It returns an empty string for unauthenticated callers.
+func PeerIdentity(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	switch info := p.AuthInfo.(type) {
+	case credentials.TLSInfo:
+		chains := info.State.VerifiedChains
+		if len(chains) > 0 && len(chains[0]) > 0 {
+			return chains[0][0].Subject.CommonName
+		}
+	case UnixPeerInfo:
+		return fmt.Sprintf("uid:%d", info.Uid)
+	}
+	return ""
+}
+
+// Authorizer allows read-only RPCs to every caller that passed the transport
+// checks, and mutating RPCs to the configured admin clients only.
+type Authorizer struct {
+	admins map[string]bool
+}
+
+// NewAuthorizer returns an Authorizer for the given admin identities, as
+// returned by PeerIdentity. Without admins, it returns an Authorizer denying
+// all mutating RPCs if denyByDefault is set, and nil otherwise. A nil
+// Authorizer allows all RPCs.
+func NewAuthorizer(admins []string, denyByDefault bool) *Authorizer {
+	if len(admins) == 0 && !denyByDefault {
+		return nil
+	}
+	a := &Authorizer{admins: map[string]bool{}}
+	for _, admin := range admins {
+		a.admins[strings.TrimSpace(admin)] = true
+	}
+	return a
+}
+
+// Authorize returns a PermissionDenied error if the caller in ctx is not
+// allowed to call method.
+func (a *Authorizer) Authorize(ctx context.Context, method string) error {
+	return a.authorize(ctx, method, IsReadOnlyMethod(method))
+}
+
+func (a *Authorizer) authorize(ctx context.Context, method string, readOnly bool) error {
+	if a == nil || readOnly {
+		return nil
+	}
+	identity := PeerIdentity(ctx)
+	if a.admins[identity] {
+		return nil
+	}
+	logger.GetLogger().WithFields(logrus.Fields{
+		"method":   method,
+		"identity": identity,
+	}).Warn("Denied mutating RPC")
+	if identity == "" {
+		return status.Errorf(codes.PermissionDenied, "%s requires an authenticated admin client", method)
+	}
+	return status.Errorf(codes.PermissionDenied, "client %q is not allowed to call %s", identity, method)
+}
+
+// UnaryInterceptor returns a gRPC interceptor authorizing unary RPCs.
+func (a *Authorizer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := a.authorize(ctx, info.FullMethod, IsReadOnlyRequest(info.FullMethod, req)); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns a gRPC interceptor authorizing streaming RPCs.
+func (a *Authorizer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.Authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func tlsPeerContext(commonName string) context.Context {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	})
+}
+
+func TestPeerIdentity(t *testing.T) {
+	assert.Equal(t, "", PeerIdentity(context.Background()))
+	assert.Equal(t, "admin", PeerIdentity(tlsPeerContext("admin")))
+	assert.Equal(t, "uid:1000", PeerIdentity(peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: UnixPeerInfo{Uid: 1000},
+	})))
+
+	// Certificates that were not verified do not identify the client.
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "admin"}}}},
+		},
+	})
+	assert.Equal(t, "", PeerIdentity(ctx))
+}
+
+func TestAuthorizer(t *testing.T) {
+	getEvents := serviceName + "GetEvents"
+	addPolicy := serviceName + "AddTracingPolicy"
+
+	var nilAuthorizer *Authorizer
+	assert.NoError(t, nilAuthorizer.Authorize(context.Background(), addPolicy))
+	assert.Nil(t, NewAuthorizer(nil, false))
+
+	// Without admins, mutating RPCs are denied to everyone.
+	deny := NewAuthorizer(nil, true)
+	assert.NoError(t, deny.Authorize(tlsPeerContext("admin"), getEvents))
+	err := deny.Authorize(tlsPeerContext("admin"), addPolicy)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	a := NewAuthorizer([]string{"admin", "uid:0"}, true)
+	assert.NoError(t, a.Authorize(context.Background(), getEvents))
+	assert.NoError(t, a.Authorize(tlsPeerContext("reader"), getEvents))
+	assert.NoError(t, a.Authorize(tlsPeerContext("admin"), addPolicy))
+
+	err = a.Authorize(tlsPeerContext("reader"), addPolicy)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = a.Authorize(context.Background(), addPolicy)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// RPCs that are not known to be read-only are mutating.
+	err = a.Authorize(tlsPeerContext("reader"), serviceName+"SomeNewRPC")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestIsReadOnlyRequest(t *testing.T) {
+	getTree := serviceName + "GetStackTraceTree"
+	assert.True(t, IsReadOnlyRequest(getTree, &tetragon.GetStackTraceTreeRequest{Name: "tree"}))
+	// Resetting the tree changes the state of the agent.
+	assert.False(t, IsReadOnlyRequest(getTree, &tetragon.GetStackTraceTreeRequest{Name: "tree", ResetOnRead: true}))
+	assert.False(t, IsReadOnlyRequest(serviceName+"AddTracingPolicy", &tetragon.AddTracingPolicyRequest{}))
+}
+
+func TestUnixSocketAuthorization(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tetragon.sock")
+	listener, err := ListenUnix(path, 0600, -1)
+	require.NoError(t, err)
+
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+	// The private directory the socket was created in is removed.
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// Allow another uid than ours to call mutating RPCs.
+	authorizer := NewAuthorizer([]string{fmt.Sprintf("uid:%d", os.Getuid()+1)}, true)
+	grpcServer := grpc.NewServer(
+		grpc.Creds(NewUnixPeerCredentials()),
+		grpc.UnaryInterceptor(authorizer.UnaryInterceptor()),
+		grpc.StreamInterceptor(authorizer.StreamInterceptor()))
+	tetragon.RegisterFineGuidanceSensorsServer(grpcServer, &tetragon.UnimplementedFineGuidanceSensorsServer{})
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial("unix://"+path, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := tetragon.NewFineGuidanceSensorsClient(conn)
+
+	// Authorized calls reach the unimplemented server.
+	_, err = client.GetVersion(context.Background(), &tetragon.GetVersionRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	stream, err := client.GetEvents(context.Background(), &tetragon.GetEventsRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	_, err = client.AddTracingPolicy(context.Background(), &tetragon.AddTracingPolicyRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// A stale socket is replaced, other files are not.
+	grpcServer.Stop()
+	listener, err = ListenUnix(path, 0660, -1)
+	require.NoError(t, err)
+	listener.Close()
+	regular := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(regular, nil, 0600))
+	_, err = ListenUnix(regular, 0660, -1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldTree is a set of field paths. A nil subtree selects the whole field.
+type fieldTree map[protoreflect.Name]fieldTree
+
+func (t fieldTree) add(path []protoreflect.Name) {
+	name := path[0]
+	sub, ok := t[name]
+	if ok && sub == nil {
+		// The whole field is already selected.
+		return
+	}
+	if len(path) == 1 {
+		t[name] = nil
+		return
+	}
+	if sub == nil {
+		sub = fieldTree{}
+		t[name] = sub
+	}
+	sub.add(path[1:])
+}
+
+// newFieldTree checks paths against the GetEventsResponse message and
+// returns them as a tree.
+func newFieldTree(paths []string) (fieldTree, error) {
+	root := (&tetragon.GetEventsResponse{}).ProtoReflect().Descriptor()
+	t := fieldTree{}
+	for _, path := range paths {
+		var names []protoreflect.Name
+		md := root
+		for _, name := range strings.Split(path, ".") {
+			if md == nil {
+				return nil, fmt.Errorf("invalid field mask path %q: cannot select fields of %s", path, names[len(names)-1])
+			}
+			fd := md.Fields().ByName(protoreflect.Name(name))
+			if fd == nil {
+				return nil, fmt.Errorf("invalid field mask path %q: %s has no field %q", path, md.FullName(), name)
+			}
+			names = append(names, fd.Name())
+			md = nil
+			if !fd.IsMap() {
+				md = fd.Message()
+			}
+		}
+		t.add(names)
+	}
+	return t, nil
+}
+
+// forEachMessage calls fn with the message value of fd in m, or with each of
+// its elements if fd is a repeated message field.
+func forEachMessage(m protoreflect.Message, fd protoreflect.FieldDescriptor, fn func(protoreflect.Message)) {
+	switch {
+	case fd.IsList() && fd.Message() != nil:
+		l := m.Get(fd).List()
+		for i := 0; i < l.Len(); i++ {
+			fn(l.Get(i).Message())
+		}
+	case fd.Message() != nil && !fd.IsMap():
+		fn(m.Mutable(fd).Message())
+	}
+}
+
+// keepFields clears the fields of m that are not in t.
+func keepFields(m protoreflect.Message, t fieldTree, root bool) {
+	var unset []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		sub, ok := t[fd.Name()]
+		switch {
+		case !ok && root && (fd.ContainingOneof() != nil || fd.Name() == "sequence" || fd.Name() == "epoch"):
+			// The event field of an event whose type no path selects, and
+			// the sequence number and its epoch, are kept.
+		case !ok:
+			unset = append(unset, fd)
+		case sub != nil:
+			forEachMessage(m, fd, func(child protoreflect.Message) {
+				keepFields(child, sub, false)
+			})
+		}
+		return true
+	})
+	for _, fd := range unset {
+		m.Clear(fd)
+	}
+}
+
+// dropFields clears the fields of m that are in t.
+func dropFields(m protoreflect.Message, t fieldTree) {
+	fields := m.Descriptor().Fields()
+	for name, sub := range t {
+		fd := fields.ByName(name)
+		if !m.Has(fd) {
+			continue
+		}
+		if sub == nil {
+			m.Clear(fd)
+			continue
+		}
+		forEachMessage(m, fd, func(child protoreflect.Message) {
+			dropFields(child, sub)
+		})
+	}
+}
+
+// FieldMask trims the fields of the events sent to a GetEvents client.
+type FieldMask struct {
+	include fieldTree
+	exclude fieldTree
+}
+
+// NewFieldMask validates mask and returns the corresponding FieldMask, or nil
+// if mask does not select any field.
+func NewFieldMask(mask *tetragon.EventFieldMask) (*FieldMask, error) {
+	include := mask.GetInclude().GetPaths()
+	exclude := mask.GetExclude().GetPaths()
+	if len(include) == 0 && len(exclude) == 0 {
+		return nil, nil
+	}
+	fm := &FieldMask{}
+	var err error
+	if len(include) > 0 {
+		if fm.include, err = newFieldTree(include); err != nil {
+			return nil, err
+		}
+	}
+	if len(exclude) > 0 {
+		if fm.exclude, err = newFieldTree(exclude); err != nil {
+			return nil, err
+		}
+	}
+	return fm, nil
+}
+
+// Apply returns a copy of res trimmed by the mask. Events are shared between
+// clients, so res itself is not modified. A nil FieldMask returns res.
+func (fm *FieldMask) Apply(res *tetragon.GetEventsResponse) *tetragon.GetEventsResponse {
+	if fm == nil {
+		return res
+	}
+	res = proto.Clone(res).(*tetragon.GetEventsResponse)
+	m := res.ProtoReflect()
+	if fm.include != nil {
+		keepFields(m, fm.include, true)
+	}
+	if fm.exclude != nil {
+		dropFields(m, fm.exclude)
+	}
+	return res
+}
+
+// fieldMaskServer applies a FieldMask to the events sent on a GetEvents
+// stream.
+type fieldMaskServer struct {
+	tetragon.FineGuidanceSensors_GetEventsServer
+	mask *FieldMask
+}
+
+func (s *fieldMaskServer) Send(res *tetragon.GetEventsResponse) error {
+	return s.FineGuidanceSensors_GetEventsServer.Send(s.mask.Apply(res))
+}
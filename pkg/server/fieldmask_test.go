@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"testing"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func maskTestEvents() (*tetragon.GetEventsResponse, *tetragon.GetEventsResponse) {
+	process := &tetragon.Process{
+		Binary: "/usr/bin/curl",
+		Pod: &tetragon.Pod{
+			Name:      "web",
+			Labels:    []string{"k8s:app=web"},
+			PodLabels: map[string]string{"app": "web"},
+		},
+	}
+	kprobe := &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{
+			ProcessKprobe: &tetragon.ProcessKprobe{
+				Process:      proto.Clone(process).(*tetragon.Process),
+				Parent:       &tetragon.Process{Binary: "/bin/bash"},
+				FunctionName: "tcp_connect",
+				Args: []*tetragon.KprobeArgument{
+					{Arg: &tetragon.KprobeArgument_StringArg{StringArg: "foo"}},
+				},
+			},
+		},
+		NodeName: "node-1",
+		Time:     timestamppb.Now(),
+		Sequence: 7,
+	}
+	exec := &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{
+			ProcessExec: &tetragon.ProcessExec{
+				Process: proto.Clone(process).(*tetragon.Process),
+			},
+		},
+		NodeName: "node-1",
+		Sequence: 8,
+	}
+	return kprobe, exec
+}
+
+func TestFieldMaskInclude(t *testing.T) {
+	fm, err := NewFieldMask(&tetragon.EventFieldMask{
+		Include: &fieldmaskpb.FieldMask{Paths: []string{
+			"node_name",
+			"process_kprobe.function_name",
+			"process_kprobe.process.pod.name",
+			"process_kprobe.process.pod",
+		}},
+	})
+	require.NoError(t, err)
+
+	kprobe, exec := maskTestEvents()
+	orig := proto.Clone(kprobe)
+	res := fm.Apply(kprobe)
+	assert.True(t, proto.Equal(orig, kprobe), "Apply must not modify its argument")
+	assert.True(t, proto.Equal(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{
+			ProcessKprobe: &tetragon.ProcessKprobe{
+				Process: &tetragon.Process{
+					Pod: kprobe.GetProcessKprobe().Process.Pod,
+				},
+				FunctionName: "tcp_connect",
+			},
+		},
+		NodeName: "node-1",
+		Sequence: 7,
+	}, res), "got %v", res)
+
+	// Events of other types keep their event field whole.
+	res = fm.Apply(exec)
+	assert.True(t, proto.Equal(exec, res), "got %v", res)
+}
+
+func TestFieldMaskExclude(t *testing.T) {
+	fm, err := NewFieldMask(&tetragon.EventFieldMask{
+		Exclude: &fieldmaskpb.FieldMask{Paths: []string{
+			"time",
+			"process_kprobe.process.pod.labels",
+			"process_kprobe.process.pod.pod_labels",
+			"process_kprobe.args.string_arg",
+		}},
+	})
+	require.NoError(t, err)
+
+	kprobe, exec := maskTestEvents()
+	res := fm.Apply(kprobe)
+	assert.Nil(t, res.Time)
+	assert.Equal(t, "node-1", res.NodeName)
+	pod := res.GetProcessKprobe().Process.Pod
+	assert.Equal(t, "web", pod.Name)
+	assert.Empty(t, pod.Labels)
+	assert.Empty(t, pod.PodLabels)
+	assert.Len(t, res.GetProcessKprobe().Args, 1)
+	assert.Nil(t, res.GetProcessKprobe().Args[0].Arg)
+	assert.Equal(t, "/bin/bash", res.GetProcessKprobe().Parent.Binary)
+	assert.NotEmpty(t, kprobe.GetProcessKprobe().Process.Pod.Labels)
+
+	res = fm.Apply(exec)
+	assert.True(t, proto.Equal(exec, res), "got %v", res)
+}
+
+func TestFieldMaskIncludeExclude(t *testing.T) {
+	fm, err := NewFieldMask(&tetragon.EventFieldMask{
+		Include: &fieldmaskpb.FieldMask{Paths: []string{"process_kprobe.process"}},
+		Exclude: &fieldmaskpb.FieldMask{Paths: []string{"process_kprobe.process.pod"}},
+	})
+	require.NoError(t, err)
+
+	kprobe, _ := maskTestEvents()
+	res := fm.Apply(kprobe)
+	assert.True(t, proto.Equal(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{
+			ProcessKprobe: &tetragon.ProcessKprobe{
+				Process: &tetragon.Process{Binary: "/usr/bin/curl"},
+			},
+		},
+		Sequence: 7,
+	}, res), "got %v", res)
+}
+
+func TestFieldMaskInvalid(t *testing.T) {
+	fm, err := NewFieldMask(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, fm)
+
+	for _, path := range []string{
+		"",
+		"nosuchfield",
+		"process_kprobe.nosuchfield",
+		"process_kprobe.function_name.foo",
+		"process_kprobe.process.pod.pod_labels.app",
+		"process_kprobe..process",
+	} {
+		_, err := NewFieldMask(&tetragon.EventFieldMask{
+			Include: &fieldmaskpb.FieldMask{Paths: []string{path}},
+		})
+		assert.Error(t, err, path)
+	}
+}
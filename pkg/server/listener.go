@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/metrics/listenermetrics"
+	"github.com/cilium/tetragon/pkg/reader/node"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultEventQueueSize is the number of events buffered for each GetEvents
+// client when option.Config.EventQueueSize is not set.
+const DefaultEventQueueSize = 10000
+
+// eventsLostInterval is how often a GetEvents client that does not catch up
+// with its queue is told about the events dropped in the meantime.
+const eventsLostInterval = time.Second
+
+// OverflowPolicy defines what happens to the events of a GetEvents client
+// whose queue is full.
+type OverflowPolicy int
+
+const (
+	// DropNewest drops the event that does not fit in the queue.
+	DropNewest OverflowPolicy = iota
+	// DropOldest drops the oldest queued event to make room for the new one.
+	DropOldest
+	// Disconnect drops the event and closes the stream of the client.
+	Disconnect
+)
+
+var overflowPolicyNames = map[OverflowPolicy]string{
+	DropNewest: "drop-newest",
+	DropOldest: "drop-oldest",
+	Disconnect: "disconnect",
+}
+
+func (p OverflowPolicy) String() string {
+	if name, ok := overflowPolicyNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", int(p))
+}
+
+// ParseOverflowPolicy returns the policy named s. An empty string selects
+// DropNewest.
+func ParseOverflowPolicy(s string) (OverflowPolicy, error) {
+	if s == "" {
+		return DropNewest, nil
+	}
+	for policy, name := range overflowPolicyNames {
+		if name == s {
+			return policy, nil
+		}
+	}
+	return DropNewest, fmt.Errorf("unknown event queue overflow policy %q, expected one of drop-newest, drop-oldest or disconnect", s)
+}
+
+// getEventsListener queues the events of a single GetEvents client. Notify
+// never blocks, so that a slow client does not hold back the process manager
+// and the other clients.
+type getEventsListener struct {
+	events  chan *tetragon.GetEventsResponse
+	policy  OverflowPolicy
+	dropped prometheus.Counter
+	lost    uint64 // accessed atomically
+
+	overflowOnce sync.Once
+	overflowed   chan struct{}
+}
+
+func newListener(size int, policy OverflowPolicy, client string) *getEventsListener {
+	if size <= 0 {
+		size = DefaultEventQueueSize
+	}
+	return &getEventsListener{
+		events:     make(chan *tetragon.GetEventsResponse, size),
+		policy:     policy,
+		dropped:    listenermetrics.GetEventsDropped(client),
+		overflowed: make(chan struct{}),
+	}
+}
+
+// Notify queues res, or applies the overflow policy if the queue is full.
+// Notify is called by the notifier with its lock held, so there is only one
+// sender at a time.
+func (l *getEventsListener) Notify(res *tetragon.GetEventsResponse) {
+	select {
+	case l.events <- res:
+		return
+	default:
+	}
+
+	switch l.policy {
+	case DropOldest:
+		select {
+		case <-l.events:
+			l.drop()
+		default:
+		}
+		// There is a single sender, so there is room for res now.
+		l.events <- res
+	case Disconnect:
+		l.drop()
+		l.overflowOnce.Do(func() { close(l.overflowed) })
+	default:
+		l.drop()
+	}
+}
+
+func (l *getEventsListener) drop() {
+	atomic.AddUint64(&l.lost, 1)
+	l.dropped.Inc()
+}
+
+// takeEventsLost returns an EventsLost response for the events dropped since
+// the previous call, or nil if no events were dropped.
+func (l *getEventsListener) takeEventsLost() *tetragon.GetEventsResponse {
+	lost := atomic.SwapUint64(&l.lost, 0)
+	if lost == 0 {
+		return nil
+	}
+	return eventsLost(lost, l.policy.String())
+}
+
+func eventsLost(count uint64, policy string) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_EventsLost{
+			EventsLost: &tetragon.EventsLost{
+				Count:          count,
+				OverflowPolicy: policy,
+			},
+		},
+		NodeName: node.GetNodeNameForExport(),
+		Time:     timestamppb.Now(),
+	}
+}
+
+// clientName returns the name of the GetEvents client in metrics. Streams
+// that are not backed by a gRPC connection, such as the exporter, can name
+// themselves by implementing ClientName().
+func clientName(server tetragon.FineGuidanceSensors_GetEventsServer) string {
+	if n, ok := server.(interface{ ClientName() string }); ok {
+		return n.ClientName()
+	}
+	if p, ok := peer.FromContext(server.Context()); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
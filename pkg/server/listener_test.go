@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"testing"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func execEvent(binary string) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{
+			ProcessExec: &tetragon.ProcessExec{Process: &tetragon.Process{Binary: binary}},
+		},
+	}
+}
+
+func queuedBinaries(l *getEventsListener) []string {
+	var binaries []string
+	for len(l.events) > 0 {
+		binaries = append(binaries, (<-l.events).GetProcessExec().Process.Binary)
+	}
+	return binaries
+}
+
+func TestParseOverflowPolicy(t *testing.T) {
+	for _, policy := range []OverflowPolicy{DropNewest, DropOldest, Disconnect} {
+		p, err := ParseOverflowPolicy(policy.String())
+		require.NoError(t, err)
+		assert.Equal(t, policy, p)
+	}
+	p, err := ParseOverflowPolicy("")
+	require.NoError(t, err)
+	assert.Equal(t, DropNewest, p)
+	_, err = ParseOverflowPolicy("block")
+	assert.Error(t, err)
+}
+
+func TestListenerDropNewest(t *testing.T) {
+	l := newListener(2, DropNewest, "test-drop-newest")
+	for _, b := range []string{"a", "b", "c", "d"} {
+		l.Notify(execEvent(b))
+	}
+	assert.Equal(t, []string{"a", "b"}, queuedBinaries(l))
+
+	lost := l.takeEventsLost()
+	require.NotNil(t, lost)
+	assert.Equal(t, uint64(2), lost.GetEventsLost().Count)
+	assert.Equal(t, "drop-newest", lost.GetEventsLost().OverflowPolicy)
+	assert.Nil(t, l.takeEventsLost())
+}
+
+func TestListenerDropOldest(t *testing.T) {
+	l := newListener(2, DropOldest, "test-drop-oldest")
+	for _, b := range []string{"a", "b", "c", "d"} {
+		l.Notify(execEvent(b))
+	}
+	assert.Equal(t, []string{"c", "d"}, queuedBinaries(l))
+
+	lost := l.takeEventsLost()
+	require.NotNil(t, lost)
+	assert.Equal(t, uint64(2), lost.GetEventsLost().Count)
+}
+
+func TestListenerDisconnect(t *testing.T) {
+	l := newListener(1, Disconnect, "test-disconnect")
+	l.Notify(execEvent("a"))
+	select {
+	case <-l.overflowed:
+		t.Fatal("listener overflowed before its queue was full")
+	default:
+	}
+	l.Notify(execEvent("b"))
+	l.Notify(execEvent("c"))
+	<-l.overflowed
+	assert.Equal(t, []string{"a"}, queuedBinaries(l))
+	assert.Equal(t, uint64(2), l.takeEventsLost().GetEventsLost().Count)
+}
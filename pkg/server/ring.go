@@ -0,0 +1,347 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/logger"
+)
+
+const (
+	eventRingFlushInterval = time.Second
+	// eventRingQueueSize is the number of events waiting to be persisted.
+	// When the queue is full, the file is rewritten from the ring instead.
+	eventRingQueueSize = 4096
+)
+
+// EventRing assigns sequence numbers to events and keeps the most recent
+// ones, so that GetEvents clients can resume a stream after a reconnect.
+// The ring can be persisted to a file to survive restarts.
+type EventRing struct {
+	mu     sync.Mutex
+	events []*tetragon.GetEventsResponse
+	// head is the index of the oldest event and count the number of events
+	// in events.
+	head  int
+	count int
+	// next is the sequence number of the next event, starting at 1.
+	next uint64
+	// epoch identifies the sequence numbers of the ring, it changes when
+	// they restart.
+	epoch string
+
+	// queue passes the events to persist to the writer goroutine, nil if
+	// the ring is not persisted. overflow is set when an event did not fit
+	// in queue, so that the writer rewrites the file from the ring.
+	queue    chan *tetragon.GetEventsResponse
+	overflow bool
+
+	// The following fields are owned by the writer goroutine.
+	path        string
+	file        *os.File
+	writer      *bufio.Writer
+	written     int
+	lastWritten uint64
+	done        chan struct{}
+}
+
+// NewEventRing returns a ring keeping the last size events. With a size of 0,
+// events are only assigned sequence numbers.
+func NewEventRing(size int) *EventRing {
+	if size < 0 {
+		size = 0
+	}
+	return &EventRing{
+		events: make([]*tetragon.GetEventsResponse, size),
+		next:   1,
+		epoch:  strconv.FormatInt(time.Now().UnixNano(), 36),
+	}
+}
+
+// push stores ev, overwriting the oldest event if the ring is full. It
+// must be called with r.mu held.
+func (r *EventRing) push(ev *tetragon.GetEventsResponse) {
+	size := len(r.events)
+	if size == 0 {
+		return
+	}
+	if r.count < size {
+		r.events[(r.head+r.count)%size] = ev
+		r.count++
+		return
+	}
+	r.events[r.head] = ev
+	r.head = (r.head + 1) % size
+}
+
+// snapshot returns the events of the ring, oldest first. It must be called
+// with r.mu held.
+func (r *EventRing) snapshot() []*tetragon.GetEventsResponse {
+	events := make([]*tetragon.GetEventsResponse, 0, r.count)
+	size := len(r.events)
+	for i := 0; i < r.count; i++ {
+		events = append(events, r.events[(r.head+i)%size])
+	}
+	return events
+}
+
+// Add assigns the next sequence number to ev and stores it. Persisting the
+// event is left to the writer goroutine.
+func (r *EventRing) Add(ev *tetragon.GetEventsResponse) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ev.Sequence = r.next
+	ev.Epoch = r.epoch
+	r.next++
+	r.push(ev)
+
+	if r.queue == nil {
+		return
+	}
+	select {
+	case r.queue <- ev:
+	default:
+		r.overflow = true
+	}
+}
+
+// Since returns the stored events with a sequence number greater than seq
+// and a time not before t, oldest first. If events after seq are no longer
+// stored, lost is the number of missing events. A seq from another epoch,
+// or from a previous run of the server without an epoch, replays all stored
+// events.
+func (r *EventRing) Since(seq uint64, epoch string, t time.Time) (events []*tetragon.GetEventsResponse, lost uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if (epoch != "" && epoch != r.epoch) || seq >= r.next {
+		seq = 0
+	} else if seq > 0 {
+		oldest := r.next
+		if r.count > 0 {
+			oldest = r.events[r.head].Sequence
+		}
+		if seq+1 < oldest {
+			lost = oldest - seq - 1
+		}
+	}
+
+	for _, ev := range r.snapshot() {
+		if ev.Sequence <= seq {
+			continue
+		}
+		if !t.IsZero() && ev.Time != nil && ev.Time.AsTime().Before(t) {
+			continue
+		}
+		events = append(events, ev)
+	}
+	return events, lost
+}
+
+// Persist loads the events stored in path, if any, and then appends every
+// new event to it from a dedicated goroutine. Sequence numbers continue
+// from the last loaded event. Events are flushed to the file periodically
+// until ctx is done.
+func (r *EventRing) Persist(ctx context.Context, path string) error {
+	if len(r.events) == 0 {
+		return fmt.Errorf("persisting the event ring requires a ring size greater than 0")
+	}
+
+	r.mu.Lock()
+	if err := r.load(path); err != nil {
+		r.mu.Unlock()
+		return err
+	}
+	events := r.snapshot()
+	r.queue = make(chan *tetragon.GetEventsResponse, eventRingQueueSize)
+	r.mu.Unlock()
+
+	r.path = path
+	if err := r.compact(events); err != nil {
+		r.stopPersist()
+		return err
+	}
+
+	r.done = make(chan struct{})
+	go r.persistLoop(ctx, r.queue)
+	return nil
+}
+
+func (r *EventRing) persistLoop(ctx context.Context, queue chan *tetragon.GetEventsResponse) {
+	defer close(r.done)
+	defer r.closeFile()
+
+	ticker := time.NewTicker(eventRingFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case ev := <-queue:
+			if ev.Sequence <= r.lastWritten {
+				continue
+			}
+			if err := r.writeEvent(ev); err != nil {
+				logger.GetLogger().WithError(err).WithField("file", r.path).Warn("Failed to persist event ring, disabling persistence")
+				r.stopPersist()
+				return
+			}
+		case <-ticker.C:
+			if err := r.writer.Flush(); err != nil {
+				logger.GetLogger().WithError(err).WithField("file", r.path).Warn("Failed to persist event ring, disabling persistence")
+				r.stopPersist()
+				return
+			}
+		case <-ctx.Done():
+			r.stopPersist()
+			r.drain(queue)
+			r.maybeCompact()
+			return
+		}
+
+		if err := r.maybeCompact(); err != nil {
+			logger.GetLogger().WithError(err).WithField("file", r.path).Warn("Failed to compact event ring file, disabling persistence")
+			r.stopPersist()
+			return
+		}
+	}
+}
+
+// maybeCompact rewrites the file once it holds twice the ring size, so that
+// it does not grow forever, or if events did not fit in the queue.
+func (r *EventRing) maybeCompact() error {
+	r.mu.Lock()
+	overflow := r.overflow
+	r.overflow = false
+	var events []*tetragon.GetEventsResponse
+	if overflow || r.written >= 2*len(r.events) {
+		events = r.snapshot()
+	}
+	r.mu.Unlock()
+	if events == nil {
+		return nil
+	}
+	return r.compact(events)
+}
+
+// drain writes the events left in queue.
+func (r *EventRing) drain(queue chan *tetragon.GetEventsResponse) {
+	for {
+		select {
+		case ev := <-queue:
+			if ev.Sequence <= r.lastWritten {
+				continue
+			}
+			if err := r.writeEvent(ev); err != nil {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
+
+// stopPersist stops queueing events for the writer goroutine.
+func (r *EventRing) stopPersist() {
+	r.mu.Lock()
+	r.queue = nil
+	r.mu.Unlock()
+}
+
+// load reads the events stored in path. It must be called with r.mu held.
+func (r *EventRing) load(path string) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		ev := &tetragon.GetEventsResponse{}
+		if err := json.Unmarshal(scanner.Bytes(), ev); err != nil {
+			// A partially written last line is expected after a crash.
+			logger.GetLogger().WithError(err).WithField("file", path).Warn("Skipping invalid event in event ring file")
+			continue
+		}
+		if ev.Sequence < r.next {
+			continue
+		}
+		r.next = ev.Sequence + 1
+		if ev.Epoch != "" {
+			r.epoch = ev.Epoch
+		}
+		r.push(ev)
+	}
+	return scanner.Err()
+}
+
+// compact rewrites the file with events, the content of the ring. It is
+// called from the writer goroutine.
+func (r *EventRing) compact(events []*tetragon.GetEventsResponse) error {
+	r.closeFile()
+
+	tmp := r.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	r.file = f
+	r.writer = bufio.NewWriter(f)
+	r.written = 0
+	for _, ev := range events {
+		if err := r.writeEvent(ev); err != nil {
+			r.closeFile()
+			return err
+		}
+	}
+	if err := r.writer.Flush(); err != nil {
+		r.closeFile()
+		return err
+	}
+	if err := os.Rename(tmp, r.path); err != nil {
+		r.closeFile()
+		return err
+	}
+	if len(events) > 0 {
+		r.lastWritten = events[len(events)-1].Sequence
+	}
+	return nil
+}
+
+func (r *EventRing) writeEvent(ev *tetragon.GetEventsResponse) error {
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	if _, err := r.writer.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	r.written++
+	r.lastWritten = ev.Sequence
+	return nil
+}
+
+func (r *EventRing) closeFile() {
+	if r.writer != nil {
+		r.writer.Flush()
+		r.writer = nil
+	}
+	if r.file != nil {
+		r.file.Close()
+		r.file = nil
+	}
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func sequences(events []*tetragon.GetEventsResponse) []uint64 {
+	var seqs []uint64
+	for _, ev := range events {
+		seqs = append(seqs, ev.Sequence)
+	}
+	return seqs
+}
+
+func TestEventRingSince(t *testing.T) {
+	r := NewEventRing(3)
+	start := time.Unix(1000, 0)
+	for i := 0; i < 5; i++ {
+		ev := execEvent("a")
+		ev.Time = timestamppb.New(start.Add(time.Duration(i) * time.Second))
+		r.Add(ev)
+		assert.Equal(t, uint64(i+1), ev.Sequence)
+	}
+
+	// the ring holds events 3, 4 and 5
+	events, lost := r.Since(3, "", time.Time{})
+	assert.Equal(t, []uint64{4, 5}, sequences(events))
+	assert.Equal(t, uint64(0), lost)
+
+	events, lost = r.Since(1, "", time.Time{})
+	assert.Equal(t, []uint64{3, 4, 5}, sequences(events))
+	assert.Equal(t, uint64(1), lost)
+
+	events, lost = r.Since(5, "", time.Time{})
+	assert.Empty(t, events)
+	assert.Equal(t, uint64(0), lost)
+
+	// sequence from a previous run of the server
+	events, lost = r.Since(42, "", time.Time{})
+	assert.Equal(t, []uint64{3, 4, 5}, sequences(events))
+	assert.Equal(t, uint64(0), lost)
+
+	events, _ = r.Since(0, "", start.Add(3*time.Second))
+	assert.Equal(t, []uint64{4, 5}, sequences(events))
+}
+
+func TestEventRingDisabled(t *testing.T) {
+	r := NewEventRing(0)
+	ev := execEvent("a")
+	r.Add(ev)
+	assert.Equal(t, uint64(1), ev.Sequence)
+	events, lost := r.Since(0, "", time.Time{})
+	assert.Empty(t, events)
+	assert.Equal(t, uint64(0), lost)
+	assert.Error(t, r.Persist(context.Background(), filepath.Join(t.TempDir(), "ring")))
+}
+
+func TestEventRingPersist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ring")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r := NewEventRing(2)
+	require.NoError(t, r.Persist(ctx, path))
+	for _, b := range []string{"a", "b", "c", "d", "e"} {
+		r.Add(execEvent(b))
+	}
+	cancel()
+	<-r.done
+
+	r = NewEventRing(2)
+	require.NoError(t, r.Persist(context.Background(), path))
+	events, _ := r.Since(0, "", time.Time{})
+	assert.Equal(t, []uint64{4, 5}, sequences(events))
+	assert.Equal(t, "e", events[1].GetProcessExec().Process.Binary)
+
+	// sequence numbers and the epoch continue after a restart
+	ev := execEvent("f")
+	r.Add(ev)
+	assert.Equal(t, uint64(6), ev.Sequence)
+	assert.Equal(t, events[1].Epoch, ev.Epoch)
+}
+
+func TestEventRingEpoch(t *testing.T) {
+	r := NewEventRing(3)
+	for i := 0; i < 5; i++ {
+		r.Add(execEvent("a"))
+	}
+	epoch := r.epoch
+	assert.NotEmpty(t, epoch)
+
+	events, lost := r.Since(4, epoch, time.Time{})
+	assert.Equal(t, []uint64{5}, sequences(events))
+	assert.Equal(t, uint64(0), lost)
+
+	// sequence from another epoch, e.g. before a restart of the server
+	events, lost = r.Since(4, "other", time.Time{})
+	assert.Equal(t, []uint64{3, 4, 5}, sequences(events))
+	assert.Equal(t, uint64(0), lost)
+}
+
+func TestEventRingPersistOverflow(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ring")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r := NewEventRing(2)
+	require.NoError(t, r.Persist(ctx, path))
+	// more events than the queue holds, the file is rewritten from the ring
+	for i := 0; i < 2*eventRingQueueSize; i++ {
+		r.Add(execEvent("a"))
+	}
+	cancel()
+	<-r.done
+
+	r = NewEventRing(2)
+	require.NoError(t, r.Persist(context.Background(), path))
+	events, _ := r.Since(0, "", time.Time{})
+	assert.Len(t, events, 2)
+	assert.Equal(t, uint64(2*eventRingQueueSize), events[1].Sequence)
+}
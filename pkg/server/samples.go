@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxRecordSamplesDuration bounds the duration of RecordSamples
+	// requests.
+	maxRecordSamplesDuration = 5 * time.Minute
+	// maxRecordSamplesSize bounds the size of the samples returned by
+	// RecordSamples, which are held in memory until the response is sent.
+	maxRecordSamplesSize = 128 << 20
+)
+
+// SampleRecorder records the raw BPF samples read by the agent, in the format
+// read by tetragon --replay.
+type SampleRecorder interface {
+	RecordSamples(ctx context.Context, w io.Writer, duration time.Duration, maxSamples, maxBytes int) (int, error)
+}
+
+// SetSampleRecorder sets the recorder of the RecordSamples RPC. The RPC fails
+// if there is none.
+func (s *Server) SetSampleRecorder(recorder SampleRecorder) {
+	s.recorder = recorder
+}
+
+func (s *Server) RecordSamples(ctx context.Context, req *tetragon.RecordSamplesRequest) (*tetragon.RecordSamplesResponse, error) {
+	logger.GetLogger().WithField("request", req).Debug("Received a RecordSamples request")
+	if s.recorder == nil {
+		return nil, status.Error(codes.Unimplemented, "recording samples is not supported")
+	}
+	duration := req.GetDuration().AsDuration()
+	if duration <= 0 || duration > maxRecordSamplesDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "duration must be positive and at most %s", maxRecordSamplesDuration)
+	}
+	var b bytes.Buffer
+	n, err := s.recorder.RecordSamples(ctx, &b, duration, int(req.GetMaxSamples()), maxRecordSamplesSize)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to record samples: %s", err)
+	}
+	return &tetragon.RecordSamplesResponse{Data: b.Bytes(), Samples: uint32(n)}, nil
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type fakeRecorder struct {
+	duration   time.Duration
+	maxSamples int
+	maxBytes   int
+	err        error
+}
+
+func (r *fakeRecorder) RecordSamples(ctx context.Context, w io.Writer, duration time.Duration, maxSamples, maxBytes int) (int, error) {
+	r.duration = duration
+	r.maxSamples = maxSamples
+	r.maxBytes = maxBytes
+	if r.err != nil {
+		return 0, r.err
+	}
+	_, err := w.Write([]byte("samples"))
+	return 3, err
+}
+
+func TestRecordSamples(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewServer(ctx, &sync.WaitGroup{}, nil, nil)
+	req := &tetragon.RecordSamplesRequest{Duration: durationpb.New(5 * time.Second), MaxSamples: 100}
+
+	_, err := s.RecordSamples(ctx, req)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	recorder := &fakeRecorder{}
+	s.SetSampleRecorder(recorder)
+	res, err := s.RecordSamples(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("samples"), res.Data)
+	assert.Equal(t, uint32(3), res.Samples)
+	assert.Equal(t, 5*time.Second, recorder.duration)
+	assert.Equal(t, 100, recorder.maxSamples)
+	assert.Equal(t, maxRecordSamplesSize, recorder.maxBytes)
+
+	_, err = s.RecordSamples(ctx, &tetragon.RecordSamplesRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.RecordSamples(ctx, &tetragon.RecordSamplesRequest{Duration: durationpb.New(time.Hour)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	recorder.err = errors.New("already recording")
+	_, err = s.RecordSamples(ctx, req)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -5,9 +5,9 @@ package server
 
 import (
 	"context"
-	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	v1 "github.com/cilium/hubble/pkg/api/v1"
 	hubbleFilters "github.com/cilium/hubble/pkg/filters"
@@ -17,8 +17,13 @@ import (
 	"github.com/cilium/tetragon/pkg/filters"
 	"github.com/cilium/tetragon/pkg/health"
 	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/metrics/listenermetrics"
+	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/sensors"
+	sttManager "github.com/cilium/tetragon/pkg/stt"
 	"github.com/cilium/tetragon/pkg/version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Listener interface {
@@ -47,10 +52,11 @@ type Server struct {
 	ctxCleanupWG *sync.WaitGroup
 	notifier     notifier
 	observer     observer
-}
-
-type getEventsListener struct {
-	events chan *tetragon.GetEventsResponse
+	ring         *EventRing
+	// stt holds the stack trace trees of the kprobe events.
+	stt sttManager.Handle
+	// recorder records the raw BPF samples, see SetSampleRecorder.
+	recorder SampleRecorder
 }
 
 func NewServer(ctx context.Context, wg *sync.WaitGroup, notifier notifier, observer observer) *Server {
@@ -59,21 +65,26 @@ func NewServer(ctx context.Context, wg *sync.WaitGroup, notifier notifier, obser
 		ctxCleanupWG: wg,
 		notifier:     notifier,
 		observer:     observer,
+		ring:         NewEventRing(option.Config.EventRingSize),
+		stt:          getStackTraceTrees(),
 	}
 }
 
-func newListener() *getEventsListener {
-	return &getEventsListener{
-		events: make(chan *tetragon.GetEventsResponse, 100),
-	}
+func (s *Server) NotifyListeners(original interface{}, processed *tetragon.GetEventsResponse) {
+	s.notifier.NotifyListener(original, processed)
 }
 
-func (l *getEventsListener) Notify(res *tetragon.GetEventsResponse) {
-	l.events <- res
+// RecordEvent assigns the next sequence number to processed and keeps it in
+// the event ring. Notifiers must call it before passing processed to the
+// listeners, with the lock serializing notifications held.
+func (s *Server) RecordEvent(processed *tetragon.GetEventsResponse) {
+	s.ring.Add(processed)
+	s.addStackTraces(processed)
 }
 
-func (s *Server) NotifyListeners(original interface{}, processed *tetragon.GetEventsResponse) {
-	s.notifier.NotifyListener(original, processed)
+// EventRing returns the ring of recent events of the server.
+func (s *Server) EventRing() *EventRing {
+	return s.ring
 }
 
 // removeNotifierAndDrain removes the events listener while draining
@@ -108,6 +119,18 @@ func (s *Server) GetEventsWG(request *tetragon.GetEventsRequest, server tetragon
 	if err != nil {
 		return err
 	}
+	fieldMask, err := NewFieldMask(request.FieldMask)
+	if err != nil {
+		return err
+	}
+	// The client name comes from the original stream, the wrapper does not
+	// implement ClientName().
+	client := clientName(server)
+	if fieldMask != nil {
+		// Masking the stream rather than the events trims aggregated
+		// responses as well.
+		server = &fieldMaskServer{server, fieldMask}
+	}
 	aggregator, err := aggregator.NewAggregator(server, request.AggregationOptions)
 	if err != nil {
 		return err
@@ -116,37 +139,103 @@ func (s *Server) GetEventsWG(request *tetragon.GetEventsRequest, server tetragon
 		go aggregator.Start()
 	}
 
-	l := newListener()
+	policy, err := ParseOverflowPolicy(option.Config.EventQueueOverflowPolicy)
+	if err != nil {
+		return err
+	}
+
+	send := func(event *tetragon.GetEventsResponse) error {
+		if aggregator != nil {
+			// Send event to aggregator.
+			select {
+			case aggregator.GetEventChannel() <- event:
+			default:
+				logger.GetLogger().
+					WithField("request", request).
+					Warn("Aggregator buffer is full. Consider increasing AggregatorOptions.channel_buffer_size.")
+			}
+			return nil
+		}
+		// No need to aggregate. Directly send out the response.
+		return server.Send(event)
+	}
+
+	l := newListener(option.Config.EventQueueSize, policy, client)
 	s.notifier.AddListener(l)
+	defer listenermetrics.DeleteClient(client)
 	defer s.removeNotifierAndDrain(l)
 	if readyWG != nil {
 		readyWG.Done()
 	}
+
+	// The listener is registered before the ring is read, so an event is
+	// either replayed or queued, or both. Skip the queued duplicates.
+	var replayed uint64
+	if request.SinceSequence > 0 || request.SinceTime != nil {
+		var since time.Time
+		if request.SinceTime != nil {
+			since = request.SinceTime.AsTime()
+		}
+		events, lost := s.ring.Since(request.SinceSequence, request.SinceEpoch, since)
+		if lost > 0 {
+			if err = send(eventsLost(lost, "")); err != nil {
+				return err
+			}
+		}
+		for _, event := range events {
+			replayed = event.Sequence
+			if !hubbleFilters.Apply(allowList, denyList, &v1.Event{Event: event}) {
+				continue
+			}
+			if err = send(event); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Let the client know about dropped events, regardless of the filters,
+	// once it caught up with the queue or periodically if it does not.
+	sendLost := func() error {
+		if lost := l.takeEventsLost(); lost != nil {
+			return send(lost)
+		}
+		return nil
+	}
+	lostTicker := time.NewTicker(eventsLostInterval)
+	defer lostTicker.Stop()
+
 	s.ctxCleanupWG.Add(1)
 	for {
 		select {
 		case event := <-l.events:
-			if !hubbleFilters.Apply(allowList, denyList, &v1.Event{Event: event}) {
-				// Event is filtered out. Nothing to do here. Continue.
-				continue
+			if event.Sequence == 0 || event.Sequence > replayed {
+				if hubbleFilters.Apply(allowList, denyList, &v1.Event{Event: event}) {
+					if err = send(event); err != nil {
+						s.ctxCleanupWG.Done()
+						return err
+					}
+				}
 			}
 
-			if aggregator != nil {
-				// Send event to aggregator.
-				select {
-				case aggregator.GetEventChannel() <- event:
-				default:
-					logger.GetLogger().
-						WithField("request", request).
-						Warn("Aggregator buffer is full. Consider increasing AggregatorOptions.channel_buffer_size.")
-				}
-			} else {
-				// No need to aggregate. Directly send out the response.
-				if err = server.Send(event); err != nil {
+			if len(l.events) == 0 {
+				if err = sendLost(); err != nil {
 					s.ctxCleanupWG.Done()
 					return err
 				}
 			}
+		case <-lostTicker.C:
+			if err = sendLost(); err != nil {
+				s.ctxCleanupWG.Done()
+				return err
+			}
+		case <-l.overflowed:
+			logger.GetLogger().WithField("client", client).Warn("GetEvents client is too slow, disconnecting it")
+			sendLost()
+			if closer != nil {
+				closer.Close()
+			}
+			s.ctxCleanupWG.Done()
+			return status.Error(codes.ResourceExhausted, "event queue overflow")
 		case <-server.Context().Done():
 			if closer != nil {
 				closer.Close()
@@ -253,11 +342,6 @@ func (s *Server) SetSensorConfig(ctx context.Context, req *tetragon.SetSensorCon
 
 	return &tetragon.SetSensorConfigResponse{}, nil
 }
-func (s *Server) GetStackTraceTree(ctx context.Context, req *tetragon.GetStackTraceTreeRequest) (*tetragon.GetStackTraceTreeResponse, error) {
-	logger.GetLogger().WithField("request", req).Debug("Received a GetStackTraceTreee request")
-	return nil, fmt.Errorf("Unsupported GetStackTraceTree")
-}
-
 func (s *Server) GetVersion(ctx context.Context, req *tetragon.GetVersionRequest) (*tetragon.GetVersionResponse, error) {
 	return &tetragon.GetVersionResponse{Version: version.Version}, nil
 }
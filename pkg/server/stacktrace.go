@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/sensors"
+	stt "github.com/cilium/tetragon/pkg/stacktracetree"
+	sttManager "github.com/cilium/tetragon/pkg/stt"
+)
+
+var (
+	// stackTraceTrees holds the trees of the tracing policies, which are
+	// global like the policies themselves.
+	stackTraceTreesOnce sync.Once
+	stackTraceTrees     sttManager.Handle
+)
+
+func getStackTraceTrees() sttManager.Handle {
+	stackTraceTreesOnce.Do(func() {
+		stackTraceTrees = sttManager.StartSttManager()
+	})
+	return stackTraceTrees
+}
+
+// StackTraceTreeName returns the name of the tree of the kernel or user stack
+// traces of the kprobe events of a tracing policy.
+func StackTraceTreeName(policyName string, user bool) string {
+	if user {
+		return policyName + "/user"
+	}
+	return policyName + "/kernel"
+}
+
+func stackTrace(addrs []*tetragon.StackAddress, labels []string) *stt.Stt {
+	ret := &stt.Stt{}
+	for _, addr := range addrs {
+		ret.Append(addr.Address, addr.Symbol, labels)
+	}
+	return ret
+}
+
+// addStackTraces adds the stack traces of kprobe events to the trees of their
+// tracing policy, labeled by the binary of the process, if
+// option.Config.EnableStackTraceTrees is set. The trees are updated
+// asynchronously, stack traces are dropped if the tree manager is busy.
+func (s *Server) addStackTraces(ev *tetragon.GetEventsResponse) {
+	if !option.Config.EnableStackTraceTrees {
+		return
+	}
+	kprobe := ev.GetProcessKprobe()
+	if kprobe == nil || (len(kprobe.KernelStackTrace) == 0 && len(kprobe.UserStackTrace) == 0) {
+		return
+	}
+	labels := []string{kprobe.Process.GetBinary()}
+	if len(kprobe.KernelStackTrace) > 0 {
+		name := StackTraceTreeName(kprobe.PolicyName, false)
+		if err := s.stt.Insert(name, stackTrace(kprobe.KernelStackTrace, labels)); err != nil {
+			logger.GetLogger().WithError(err).WithField("tree", name).Debug("Failed to add stack trace")
+		}
+	}
+	if len(kprobe.UserStackTrace) > 0 {
+		name := StackTraceTreeName(kprobe.PolicyName, true)
+		if err := s.stt.Insert(name, stackTrace(kprobe.UserStackTrace, labels)); err != nil {
+			logger.GetLogger().WithError(err).WithField("tree", name).Debug("Failed to add stack trace")
+		}
+	}
+}
+
+func (s *Server) GetStackTraceTree(ctx context.Context, req *tetragon.GetStackTraceTreeRequest) (*tetragon.GetStackTraceTreeResponse, error) {
+	logger.GetLogger().WithField("request", req).Debug("Received a GetStackTraceTree request")
+	root, err := s.stt.GetTree(req.GetName(), req.GetResetOnRead())
+	if err != nil {
+		return nil, err
+	}
+	return &tetragon.GetStackTraceTreeResponse{Root: root}, nil
+}
+
+func (s *Server) ExportStackTraceTree(ctx context.Context, req *tetragon.ExportStackTraceTreeRequest) (*tetragon.ExportStackTraceTreeResponse, error) {
+	logger.GetLogger().WithField("request", req).Debug("Received an ExportStackTraceTree request")
+	root, err := s.stt.GetTree(req.GetName(), req.GetResetOnRead())
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	tree := stt.SttreeFromProto(root)
+	switch req.GetFormat() {
+	case tetragon.StackTraceExportFormat_STACK_TRACE_EXPORT_FORMAT_PPROF:
+		err = tree.WritePprof(&b)
+	case tetragon.StackTraceExportFormat_STACK_TRACE_EXPORT_FORMAT_FOLDED:
+		err = tree.WriteFolded(&b)
+	default:
+		err = fmt.Errorf("unknown export format %s", req.GetFormat())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &tetragon.ExportStackTraceTreeResponse{Data: b.Bytes()}, nil
+}
+
+// stackTracePolicyHandler destroys the stack trace trees of deleted tracing
+// policies.
+type stackTracePolicyHandler struct{}
+
+func (stackTracePolicyHandler) PolicyAdded(policyName string, spec interface{}) error {
+	return nil
+}
+
+func (stackTracePolicyHandler) PolicyDeleted(policyName string) {
+	trees := getStackTraceTrees()
+	for _, user := range []bool{false, true} {
+		if err := trees.DestroyTree(StackTraceTreeName(policyName, user)); err != nil {
+			logger.GetLogger().WithError(err).WithField("policy", policyName).Warn("Failed to destroy stack trace tree")
+		}
+	}
+}
+
+func init() {
+	sensors.RegisterPolicyHandlerAtInit("stack trace trees", stackTracePolicyHandler{})
+}
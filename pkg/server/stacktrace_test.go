@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func kprobeWithStacks(binary string, kernel ...uint64) *tetragon.GetEventsResponse {
+	var stack []*tetragon.StackAddress
+	for _, addr := range kernel {
+		stack = append(stack, &tetragon.StackAddress{Address: addr, Symbol: "fn()+0x0"})
+	}
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: &tetragon.ProcessKprobe{
+			Process:          &tetragon.Process{Binary: binary},
+			FunctionName:     "commit_creds",
+			PolicyName:       "creds",
+			KernelStackTrace: stack,
+		}},
+	}
+}
+
+func enableStackTraceTrees(t *testing.T) {
+	option.Config.EnableStackTraceTrees = true
+	t.Cleanup(func() { option.Config.EnableStackTraceTrees = false })
+}
+
+func TestStackTraceTrees(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewServer(ctx, &sync.WaitGroup{}, nil, nil)
+	enableStackTraceTrees(t)
+
+	s.RecordEvent(kprobeWithStacks("/bin/sh", 0x10, 0x20))
+	s.RecordEvent(kprobeWithStacks("/bin/su", 0x10, 0x30))
+	s.RecordEvent(kprobeWithStacks("/bin/su"))
+
+	name := StackTraceTreeName("creds", false)
+	res, err := s.GetStackTraceTree(ctx, &tetragon.GetStackTraceTreeRequest{Name: name})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), res.Root.Count)
+	require.Len(t, res.Root.Children, 1)
+	assert.Equal(t, uint64(0x10), res.Root.Children[0].Address.Address)
+	assert.Len(t, res.Root.Children[0].Children, 2)
+
+	_, err = s.GetStackTraceTree(ctx, &tetragon.GetStackTraceTreeRequest{Name: StackTraceTreeName("creds", true)})
+	assert.Error(t, err)
+
+	exp, err := s.ExportStackTraceTree(ctx, &tetragon.ExportStackTraceTreeRequest{
+		Name:        name,
+		Format:      tetragon.StackTraceExportFormat_STACK_TRACE_EXPORT_FORMAT_FOLDED,
+		ResetOnRead: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "fn;fn 1\nfn;fn 1\n", string(exp.Data))
+
+	// The tree was reset by the export.
+	res, err = s.GetStackTraceTree(ctx, &tetragon.GetStackTraceTreeRequest{Name: name})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), res.Root.Count)
+	assert.Empty(t, res.Root.Children)
+}
+
+func TestStackTraceTreesDisabled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewServer(ctx, &sync.WaitGroup{}, nil, nil)
+
+	ev := kprobeWithStacks("/bin/sh", 0x10, 0x20)
+	ev.GetProcessKprobe().PolicyName = "disabled"
+	s.RecordEvent(ev)
+	_, err := s.GetStackTraceTree(ctx, &tetragon.GetStackTraceTreeRequest{Name: StackTraceTreeName("disabled", false)})
+	assert.Error(t, err)
+}
+
+func TestStackTraceTreesPolicyDeleted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewServer(ctx, &sync.WaitGroup{}, nil, nil)
+	enableStackTraceTrees(t)
+
+	s.RecordEvent(kprobeWithStacks("/bin/sh", 0x10, 0x20))
+	name := StackTraceTreeName("creds", false)
+	_, err := s.GetStackTraceTree(ctx, &tetragon.GetStackTraceTreeRequest{Name: name})
+	require.NoError(t, err)
+
+	stackTracePolicyHandler{}.PolicyDeleted("creds")
+	_, err = s.GetStackTraceTree(ctx, &tetragon.GetStackTraceTreeRequest{Name: name})
+	assert.Error(t, err)
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/credentials"
+)
+
+// NewTLSConfig returns the TLS configuration of the gRPC server. If
+// clientCAFiles is not empty, clients must present a certificate signed by
+// one of these CAs.
+func NewTLSConfig(certFile, keyFile string, clientCAFiles []string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if len(clientCAFiles) > 0 {
+		pool, err := loadCertPool(clientCAFiles)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
+
+func loadCertPool(files []string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	for _, file := range files {
+		pem, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in CA file %s", file)
+		}
+	}
+	return pool, nil
+}
+
+// ListenUnix listens on the unix socket path, replacing a stale socket left
+// by a previous run. The socket file gets the permissions mode and, if gid is
+// not negative, the group gid.
+//
+// The socket is created in a private directory and only moved to path once
+// its permissions are set, so that it is never reachable with the looser
+// permissions of the process umask. The socket file is left behind when the
+// listener is closed.
+func ListenUnix(path string, mode os.FileMode, gid int) (net.Listener, error) {
+	if fi, err := os.Lstat(path); err == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a socket", path)
+		}
+	}
+
+	// os.MkdirTemp creates the directory with mode 0700.
+	dir, err := os.MkdirTemp(filepath.Dir(path), ".tetragon-sock-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	tmp := filepath.Join(dir, "sock")
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: tmp, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	listener.SetUnlinkOnClose(false)
+	if gid >= 0 {
+		if err := os.Chown(tmp, -1, gid); err != nil {
+			listener.Close()
+			return nil, err
+		}
+	}
+	if err := os.Chmod(tmp, mode); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
+
+// UnixPeerInfo is the AuthInfo of unix socket connections. It holds the
+// credentials of the peer process at the time it connected.
+type UnixPeerInfo struct {
+	credentials.CommonAuthInfo
+	Pid int32
+	Uid uint32
+	Gid uint32
+}
+
+// AuthType implements credentials.AuthInfo.
+func (UnixPeerInfo) AuthType() string {
+	return "unix"
+}
+
+type unixPeerCredentials struct{}
+
+// NewUnixPeerCredentials returns server transport credentials for unix socket
+// listeners. They do not encrypt the connection, but identify the peer
+// process with SO_PEERCRED.
+func NewUnixPeerCredentials() credentials.TransportCredentials {
+	return unixPeerCredentials{}
+}
+
+func (unixPeerCredentials) ClientHandshake(_ context.Context, _ string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("unix peer credentials are only supported by servers")
+}
+
+func (unixPeerCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	uc, ok := conn.(*net.UnixConn)
+	if !ok {
+		return nil, nil, fmt.Errorf("unix peer credentials used on a %T connection", conn)
+	}
+	raw, err := uc.SyscallConn()
+	if err != nil {
+		return nil, nil, err
+	}
+	var cred *unix.Ucred
+	var credErr error
+	err = raw.Control(func(fd uintptr) {
+		cred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	})
+	if err == nil {
+		err = credErr
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get peer credentials: %w", err)
+	}
+	return conn, UnixPeerInfo{
+		// The connection does not leave the host.
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		Pid:            cred.Pid,
+		Uid:            cred.Uid,
+		Gid:            cred.Gid,
+	}, nil
+}
+
+func (unixPeerCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "unix"}
+}
+
+func (c unixPeerCredentials) Clone() credentials.TransportCredentials {
+	return c
+}
+
+func (unixPeerCredentials) OverrideServerName(string) error {
+	return nil
+}
@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package stacktracetree
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// FunctionName returns the function of a node, without the offset of
+// symbols like "tcp_connect()+0x10", or the address if there is no symbol.
+func (n *SttNode) FunctionName() string {
+	if n.Symbol == "" {
+		return fmt.Sprintf("0x%x", n.Addr)
+	}
+	name := n.Symbol
+	if i := strings.LastIndex(name, "+0x"); i > 0 {
+		name = name[:i]
+	}
+	return strings.TrimSuffix(name, "()")
+}
+
+// foldedStack returns the functions of path, outermost first, separated by
+// semicolons.
+func foldedStack(path []*SttNode) string {
+	names := make([]string, len(path))
+	for i, n := range path {
+		// Frames with semicolons or spaces would break the format.
+		names[len(path)-1-i] = strings.NewReplacer(";", ":", " ", "_").Replace(n.FunctionName())
+	}
+	return strings.Join(names, ";")
+}
+
+// Folded returns the stacktraces of the tree in the folded format of Brendan
+// Gregg's FlameGraph tools, with the number of stacktraces of each stack.
+func (t *Sttree) Folded() map[string]int {
+	ret := map[string]int{}
+	t.Walk(func(path []*SttNode, count int) {
+		ret[foldedStack(path)] += count
+	})
+	return ret
+}
+
+// WriteFolded writes the stacktraces of the tree in the folded format of
+// Brendan Gregg's FlameGraph tools: one line per stack, with the functions
+// from the outermost one separated by semicolons, followed by a space and the
+// number of stacktraces. Labels are not exported.
+func (t *Sttree) WriteFolded(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	t.Walk(func(path []*SttNode, count int) {
+		fmt.Fprintf(bw, "%s %d\n", foldedStack(path), count)
+	})
+	return bw.Flush()
+}
+
+// Field numbers of the pprof profile.proto messages.
+const (
+	pprofProfileSampleType  = 1
+	pprofProfileSample      = 2
+	pprofProfileLocation    = 4
+	pprofProfileFunction    = 5
+	pprofProfileStringTable = 6
+
+	pprofValueTypeType = 1
+	pprofValueTypeUnit = 2
+
+	pprofSampleLocationID = 1
+	pprofSampleValue      = 2
+
+	pprofLocationID      = 1
+	pprofLocationAddress = 3
+	pprofLocationLine    = 4
+
+	pprofLineFunctionID = 1
+
+	pprofFunctionID         = 1
+	pprofFunctionName       = 2
+	pprofFunctionSystemName = 3
+)
+
+type pprofBuilder struct {
+	strings   map[string]uint64
+	functions map[string]uint64
+	locations map[Addr]uint64
+	buf       []byte
+}
+
+func (b *pprofBuilder) str(s string) uint64 {
+	if id, ok := b.strings[s]; ok {
+		return id
+	}
+	id := uint64(len(b.strings))
+	b.strings[s] = id
+	b.buf = protowire.AppendTag(b.buf, pprofProfileStringTable, protowire.BytesType)
+	b.buf = protowire.AppendString(b.buf, s)
+	return id
+}
+
+func (b *pprofBuilder) function(name string) uint64 {
+	if id, ok := b.functions[name]; ok {
+		return id
+	}
+	id := uint64(len(b.functions) + 1)
+	b.functions[name] = id
+	nameID := b.str(name)
+	var msg []byte
+	msg = protowire.AppendTag(msg, pprofFunctionID, protowire.VarintType)
+	msg = protowire.AppendVarint(msg, id)
+	msg = protowire.AppendTag(msg, pprofFunctionName, protowire.VarintType)
+	msg = protowire.AppendVarint(msg, nameID)
+	msg = protowire.AppendTag(msg, pprofFunctionSystemName, protowire.VarintType)
+	msg = protowire.AppendVarint(msg, nameID)
+	b.buf = protowire.AppendTag(b.buf, pprofProfileFunction, protowire.BytesType)
+	b.buf = protowire.AppendBytes(b.buf, msg)
+	return id
+}
+
+func (b *pprofBuilder) location(n *SttNode) uint64 {
+	if id, ok := b.locations[n.Addr]; ok {
+		return id
+	}
+	id := uint64(len(b.locations) + 1)
+	b.locations[n.Addr] = id
+	funcID := b.function(n.FunctionName())
+	var line []byte
+	line = protowire.AppendTag(line, pprofLineFunctionID, protowire.VarintType)
+	line = protowire.AppendVarint(line, funcID)
+	var msg []byte
+	msg = protowire.AppendTag(msg, pprofLocationID, protowire.VarintType)
+	msg = protowire.AppendVarint(msg, id)
+	msg = protowire.AppendTag(msg, pprofLocationAddress, protowire.VarintType)
+	msg = protowire.AppendVarint(msg, n.Addr)
+	msg = protowire.AppendTag(msg, pprofLocationLine, protowire.BytesType)
+	msg = protowire.AppendBytes(msg, line)
+	b.buf = protowire.AppendTag(b.buf, pprofProfileLocation, protowire.BytesType)
+	b.buf = protowire.AppendBytes(b.buf, msg)
+	return id
+}
+
+// WritePprof writes the stacktraces of the tree as a gzipped pprof profile,
+// with one sample per stack valued by its number of stacktraces. Labels are
+// not exported.
+func (t *Sttree) WritePprof(w io.Writer) error {
+	b := &pprofBuilder{
+		strings:   map[string]uint64{},
+		functions: map[string]uint64{},
+		locations: map[Addr]uint64{},
+	}
+	// The first string of the table must be empty.
+	b.str("")
+
+	var sampleType []byte
+	sampleType = protowire.AppendTag(sampleType, pprofValueTypeType, protowire.VarintType)
+	sampleType = protowire.AppendVarint(sampleType, b.str("stacktraces"))
+	sampleType = protowire.AppendTag(sampleType, pprofValueTypeUnit, protowire.VarintType)
+	sampleType = protowire.AppendVarint(sampleType, b.str("count"))
+	b.buf = protowire.AppendTag(b.buf, pprofProfileSampleType, protowire.BytesType)
+	b.buf = protowire.AppendBytes(b.buf, sampleType)
+
+	t.Walk(func(path []*SttNode, count int) {
+		// Locations of samples start with the innermost frame, as the
+		// paths of the tree.
+		var locs []byte
+		for _, n := range path {
+			locs = protowire.AppendVarint(locs, b.location(n))
+		}
+		var sample []byte
+		sample = protowire.AppendTag(sample, pprofSampleLocationID, protowire.BytesType)
+		sample = protowire.AppendBytes(sample, locs)
+		sample = protowire.AppendTag(sample, pprofSampleValue, protowire.BytesType)
+		sample = protowire.AppendBytes(sample, protowire.AppendVarint(nil, uint64(count)))
+		b.buf = protowire.AppendTag(b.buf, pprofProfileSample, protowire.BytesType)
+		b.buf = protowire.AppendBytes(b.buf, sample)
+	})
+
+	gz := gzip.NewWriter(w)
+	if _, err := gz.Write(b.buf); err != nil {
+		return err
+	}
+	return gz.Close()
+}
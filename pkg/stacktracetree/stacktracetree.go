@@ -6,6 +6,7 @@ package stacktracetree
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
@@ -46,20 +47,44 @@ func (n *SttNode) merge(n2 *SttNode) {
 		log.Printf("error: different symbols (%s,%s) for the same address %x", s1, s2, n.Addr)
 	}
 
+	if n.Labels == nil {
+		n.Labels = map[string]int{}
+	}
 	for lbl, lblCount := range n2.Labels {
 		n.Labels[lbl] += lblCount
 	}
 
-	if len(n2.Children) > 0 {
-		log.Fatal("TODO: implement children merging")
+	if n.Children == nil {
+		n.Children = map[Addr]*SttNode{}
+	}
+	for addr, child2 := range n2.Children {
+		if child, ok := n.Children[addr]; ok {
+			child.merge(child2)
+		} else {
+			n.Children[addr] = child2
+		}
 	}
 }
 
-// Sttree is a stacktrace tree
+// Sttree is a stacktrace tree. The children of the root are the first
+// addresses of the stacktraces, i.e. the innermost frames for stacktraces
+// captured by BPF.
 type Sttree struct {
 	Root SttNode
 }
 
+// Merge merges t2 into t, for example the trees of several nodes or of
+// several periods of time. The nodes of t2 might be reused by t, so t2 should
+// not be used after merging.
+func (t *Sttree) Merge(t2 *Sttree) {
+	t.Root.merge(&t2.Root)
+}
+
+// Reset removes all the stacktraces of the tree.
+func (t *Sttree) Reset() {
+	*t = *CreateSttree()
+}
+
 // Stt is a single stacktrace
 type Stt struct {
 	nodes []*SttNode
@@ -98,36 +123,39 @@ func CreateSttree() *Sttree {
 			Addr:     0,
 			Count:    0,
 			Symbol:   "",
+			Labels:   map[string]int{},
 			Children: map[Addr]*SttNode{},
 		},
 	}
 }
 
-// AddStacktrace adds a stacktrace to the tree
-func (t *Sttree) AddStacktrace(stt *Stt) {
+// AddStacktrace adds a stacktrace to the tree, and returns the number of
+// nodes added to the tree.
+func (t *Sttree) AddStacktrace(stt *Stt) int {
 	if len(stt.nodes) == 0 {
-		return
+		return 0
 	}
 
 	t.Root.Count += stt.nodes[0].Count
-	t.Root.addChildren(stt.nodes)
+	return t.Root.addChildren(stt.nodes)
 }
 
-func (n *SttNode) addChildren(nodes []*SttNode) {
+func (n *SttNode) addChildren(nodes []*SttNode) int {
 	if len(nodes) == 0 {
-		return
+		return 0
 	}
 
 	node := nodes[0]
 	addr := node.Addr
 	child := n.Children[addr]
 	if child == nil {
+		// the remaining nodes are all new
 		n.Children[addr] = node
-	} else {
-		child.merge(node)
+		node.addChildren(nodes[1:])
+		return len(nodes)
 	}
-
-	n.Children[addr].addChildren(nodes[1:])
+	child.merge(node)
+	return child.addChildren(nodes[1:])
 }
 
 func (n *SttNode) printNode(level int) {
@@ -170,3 +198,75 @@ func (n *SttNode) ToProtoNode() *tetragon.StackTraceNode {
 
 	return &protoNode
 }
+
+// SttNodeFromProto converts a protobuf node, as returned by ToProtoNode, to a
+// tree node.
+func SttNodeFromProto(protoNode *tetragon.StackTraceNode) *SttNode {
+	n := &SttNode{
+		Addr:     protoNode.GetAddress().GetAddress(),
+		Count:    int(protoNode.GetCount()),
+		Symbol:   protoNode.GetAddress().GetSymbol(),
+		Labels:   map[string]int{},
+		Children: map[Addr]*SttNode{},
+	}
+	for _, lbl := range protoNode.GetLabels() {
+		n.Labels[lbl.Key] += int(lbl.Count)
+	}
+	for _, protoChild := range protoNode.GetChildren() {
+		child := SttNodeFromProto(protoChild)
+		if c, ok := n.Children[child.Addr]; ok {
+			c.merge(child)
+		} else {
+			n.Children[child.Addr] = child
+		}
+	}
+	return n
+}
+
+// SttreeFromProto converts a protobuf root node to a tree.
+func SttreeFromProto(root *tetragon.StackTraceNode) *Sttree {
+	t := CreateSttree()
+	if root != nil {
+		t.Root = *SttNodeFromProto(root)
+	}
+	return t
+}
+
+// sortedChildren returns the children of n sorted by address, for stable
+// outputs.
+func (n *SttNode) sortedChildren() []*SttNode {
+	children := make([]*SttNode, 0, len(n.Children))
+	for _, child := range n.Children {
+		children = append(children, child)
+	}
+	sort.Slice(children, func(i, j int) bool { return children[i].Addr < children[j].Addr })
+	return children
+}
+
+// selfCount returns the number of stacktraces ending at n.
+func (n *SttNode) selfCount() int {
+	count := n.Count
+	for _, child := range n.Children {
+		count -= child.Count
+	}
+	if count < 0 {
+		return 0
+	}
+	return count
+}
+
+// Walk calls fn for each stacktrace of the tree ending at a node, with the
+// nodes from the root child to the last node, and the number of such
+// stacktraces. path is only valid during the call.
+func (t *Sttree) Walk(fn func(path []*SttNode, count int)) {
+	var walk func(n *SttNode, path []*SttNode)
+	walk = func(n *SttNode, path []*SttNode) {
+		if count := n.selfCount(); count > 0 && len(path) > 0 {
+			fn(path, count)
+		}
+		for _, child := range n.sortedChildren() {
+			walk(child, append(path, child))
+		}
+	}
+	walk(&t.Root, nil)
+}
@@ -4,8 +4,15 @@
 package stacktracetree
 
 import (
+	"bytes"
+	"compress/gzip"
 	"fmt"
+	"io"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 func TestSimple(t *testing.T) {
@@ -27,3 +34,91 @@ func TestSimple(t *testing.T) {
 	tree.Print()
 
 }
+
+func stt(addrs ...Addr) *Stt {
+	ret := &Stt{}
+	for _, addr := range addrs {
+		ret.Append(addr, fmt.Sprintf("fn%x()+0x4", addr), []string{"/bin/sh"})
+	}
+	return ret
+}
+
+func TestMerge(t *testing.T) {
+	t1 := CreateSttree()
+	assert.Equal(t, 3, t1.AddStacktrace(stt(0x10, 0x20, 0x30)))
+	assert.Equal(t, 0, t1.AddStacktrace(stt(0x10, 0x20)))
+
+	t2 := CreateSttree()
+	assert.Equal(t, 3, t2.AddStacktrace(stt(0x10, 0x20, 0x30)))
+	assert.Equal(t, 1, t2.AddStacktrace(stt(0x10, 0x40)))
+	assert.Equal(t, 1, t2.AddStacktrace(stt(0x50)))
+
+	t1.Merge(t2)
+	assert.Equal(t, 5, t1.Root.Count)
+	n10 := t1.Root.Children[0x10]
+	require.NotNil(t, n10)
+	assert.Equal(t, 4, n10.Count)
+	assert.Equal(t, 4, n10.Labels["/bin/sh"])
+	assert.Equal(t, 3, n10.Children[0x20].Count)
+	assert.Equal(t, 2, n10.Children[0x20].Children[0x30].Count)
+	assert.Equal(t, 1, n10.Children[0x40].Count)
+	assert.Equal(t, 1, t1.Root.Children[0x50].Count)
+
+	t1.Reset()
+	assert.Equal(t, 0, t1.Root.Count)
+	assert.Empty(t, t1.Root.Children)
+}
+
+func TestFromProto(t *testing.T) {
+	tree := CreateSttree()
+	tree.AddStacktrace(stt(0x10, 0x20, 0x30))
+	tree.AddStacktrace(stt(0x10, 0x40))
+
+	got := SttreeFromProto(tree.Root.ToProtoNode())
+	assert.Equal(t, tree.Folded(), got.Folded())
+	assert.Equal(t, 2, got.Root.Children[0x10].Labels["/bin/sh"])
+}
+
+func TestWriteFolded(t *testing.T) {
+	tree := CreateSttree()
+	tree.AddStacktrace(stt(0x10, 0x20, 0x30))
+	tree.AddStacktrace(stt(0x10, 0x20, 0x30))
+	tree.AddStacktrace(stt(0x10, 0x20))
+	tree.AddStacktrace(stt(0x10, 0x40))
+
+	var b bytes.Buffer
+	require.NoError(t, tree.WriteFolded(&b))
+	assert.Equal(t, ""+
+		"fn20;fn10 1\n"+
+		"fn30;fn20;fn10 2\n"+
+		"fn40;fn10 1\n", b.String())
+}
+
+func TestWritePprof(t *testing.T) {
+	tree := CreateSttree()
+	tree.AddStacktrace(stt(0x10, 0x20, 0x30))
+	tree.AddStacktrace(stt(0x10, 0x40))
+
+	var b bytes.Buffer
+	require.NoError(t, tree.WritePprof(&b))
+	gz, err := gzip.NewReader(&b)
+	require.NoError(t, err)
+	data, err := io.ReadAll(gz)
+	require.NoError(t, err)
+
+	// Count the top-level fields of the profile.
+	fields := map[protowire.Number]int{}
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		require.GreaterOrEqual(t, n, 0)
+		data = data[n:]
+		n = protowire.ConsumeFieldValue(num, typ, data)
+		require.GreaterOrEqual(t, n, 0)
+		data = data[n:]
+		fields[num]++
+	}
+	assert.Equal(t, 1, fields[pprofProfileSampleType])
+	assert.Equal(t, 2, fields[pprofProfileSample])
+	assert.Equal(t, 4, fields[pprofProfileLocation])
+	assert.Equal(t, 4, fields[pprofProfileFunction])
+}
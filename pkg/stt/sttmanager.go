@@ -4,6 +4,7 @@
 package sttManager
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
@@ -14,6 +15,22 @@ import (
 // StackTrace Tree Manager
 type Handle chan<- SttMgOp
 
+const (
+	// queueSize is the number of operations queued to the manager, so
+	// that inserts do not wait for the trees to be updated.
+	queueSize = 1024
+)
+
+var (
+	// maxTreeNodes is the number of nodes after which a tree drops new
+	// stacktraces until it is reset.
+	maxTreeNodes = 1 << 16
+
+	// ErrQueueFull is returned by Insert if the manager is too busy to
+	// queue the stacktrace.
+	ErrQueueFull = errors.New("stack trace tree manager queue is full")
+)
+
 // Operations
 
 type SttMgCreateTree struct {
@@ -29,11 +46,12 @@ type SttMgDestroyTree struct {
 type SttMgTreeInsert struct {
 	TreeName   string
 	Stacktrace *stt.Stt
-	retChan    chan error
 }
 
 type SttMgTreeToProto struct {
 	TreeName string
+	// Reset removes the stacktraces of the tree once converted.
+	Reset    bool
 	RetChan  chan error
 	RootNode *tetragon.StackTraceNode
 }
@@ -50,13 +68,27 @@ type SttMgOp interface {
 // trivial SttMgOp implementations for commands
 func (s *SttMgCreateTree) SttMgOpDone(e error)  { s.retChan <- e }
 func (s *SttMgDestroyTree) SttMgOpDone(e error) { s.retChan <- e }
-func (s *SttMgTreeInsert) SttMgOpDone(e error)  { s.retChan <- e }
+func (s *SttMgTreeInsert) SttMgOpDone(e error) {
+	if e != nil {
+		logger.GetLogger().WithError(e).WithField("tree", s.TreeName).Debug("Failed to add stack trace")
+	}
+}
 func (s *SttMgTreeToProto) SttMgOpDone(e error) { s.RetChan <- e }
 func (s *SttMgStop) SttMgOpDone(e error)        { s.retChan <- e }
 
+// tree is a stacktrace tree and its number of nodes.
+type tree struct {
+	*stt.Sttree
+	nodes int
+}
+
+func newTree() *tree {
+	return &tree{Sttree: stt.CreateSttree()}
+}
+
 func StartSttManager() Handle {
-	c := make(chan SttMgOp)
-	treeMap := make(map[string]*stt.Sttree)
+	c := make(chan SttMgOp, queueSize)
+	treeMap := make(map[string]*tree)
 	go func() {
 		done := false
 		for !done {
@@ -64,27 +96,36 @@ func StartSttManager() Handle {
 			var err error
 			switch op := op_.(type) {
 			case *SttMgCreateTree:
-				treeMap[op.TreeName] = stt.CreateSttree()
+				treeMap[op.TreeName] = newTree()
 				err = nil
 			case *SttMgDestroyTree:
 				delete(treeMap, op.TreeName)
 				err = nil
 			case *SttMgTreeInsert:
-				stt, ok := treeMap[op.TreeName]
+				// trees are created on the first insert
+				t, ok := treeMap[op.TreeName]
 				if !ok {
-					err = fmt.Errorf("SttMgTreeInsert: tree %s does not exist", op.TreeName)
+					t = newTree()
+					treeMap[op.TreeName] = t
+				}
+				if t.nodes >= maxTreeNodes {
+					err = fmt.Errorf("tree %s has more than %d nodes", op.TreeName, maxTreeNodes)
 					break
 				}
-				stt.AddStacktrace(op.Stacktrace)
+				t.nodes += t.AddStacktrace(op.Stacktrace)
 				err = nil
 
 			case *SttMgTreeToProto:
-				stt, ok := treeMap[op.TreeName]
+				t, ok := treeMap[op.TreeName]
 				if !ok {
 					err = fmt.Errorf("SttMgTreeToProto: tree %s does not exist", op.TreeName)
 					break
 				}
-				op.RootNode = stt.Root.ToProtoNode()
+				op.RootNode = t.Root.ToProtoNode()
+				if op.Reset {
+					t.Reset()
+					t.nodes = 0
+				}
 				err = nil
 
 			case *SttMgStop:
@@ -131,17 +172,43 @@ func (h Handle) DestroyTree(tname string) error {
 	return <-retc
 }
 
+// Insert queues stt to be added to the tree tname. It does not wait for the
+// tree to be updated, and returns ErrQueueFull instead of blocking if the
+// manager is busy. Trees with too many nodes drop new stacktraces until they
+// are reset.
 func (h Handle) Insert(tname string, stt *stt.Stt) error {
 	if h == nil {
 		return fmt.Errorf("Instert failed, Handle is nil")
 	}
 
-	retc := make(chan error)
 	op := &SttMgTreeInsert{
 		TreeName:   tname,
 		Stacktrace: stt,
-		retChan:    retc,
+	}
+	select {
+	case h <- op:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// GetTree returns the root node of the tree tname, and removes its
+// stacktraces if reset is set.
+func (h Handle) GetTree(tname string, reset bool) (*tetragon.StackTraceNode, error) {
+	if h == nil {
+		return nil, fmt.Errorf("GetTree failed, Handle is nil")
+	}
+
+	retc := make(chan error)
+	op := &SttMgTreeToProto{
+		TreeName: tname,
+		Reset:    reset,
+		RetChan:  retc,
 	}
 	h <- op
-	return <-retc
+	if err := <-retc; err != nil {
+		return nil, err
+	}
+	return op.RootNode, nil
 }
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package sttManager
+
+import (
+	"testing"
+
+	stt "github.com/cilium/tetragon/pkg/stacktracetree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stackTrace(addrs ...uint64) *stt.Stt {
+	ret := &stt.Stt{}
+	for _, addr := range addrs {
+		ret.Append(addr, "", nil)
+	}
+	return ret
+}
+
+func TestTreeSizeLimit(t *testing.T) {
+	old := maxTreeNodes
+	maxTreeNodes = 4
+	t.Cleanup(func() { maxTreeNodes = old })
+
+	h := StartSttManager()
+	require.NoError(t, h.Insert("tree", stackTrace(0x10, 0x20, 0x30)))
+	require.NoError(t, h.Insert("tree", stackTrace(0x10, 0x40)))
+	// the tree is full, new stacktraces are dropped
+	require.NoError(t, h.Insert("tree", stackTrace(0x50)))
+	require.NoError(t, h.Insert("tree", stackTrace(0x10, 0x20, 0x30)))
+
+	root, err := h.GetTree("tree", true)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), root.Count)
+	assert.Len(t, root.Children, 1)
+
+	// resetting the tree makes room for new stacktraces
+	require.NoError(t, h.Insert("tree", stackTrace(0x50)))
+	root, err = h.GetTree("tree", false)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), root.Count)
+	require.Len(t, root.Children, 1)
+	assert.Equal(t, uint64(0x50), root.Children[0].Address.Address)
+
+	require.NoError(t, h.DestroyTree("tree"))
+	_, err = h.GetTree("tree", false)
+	assert.Error(t, err)
+}
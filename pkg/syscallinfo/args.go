@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package syscallinfo
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// MaxArgs is the maximum number of syscall arguments
+const MaxArgs = 6
+
+// names of the char * arguments that are strings, the other char *
+// arguments are output buffers
+var charPtrStrings = map[string]bool{
+	"dev_name": true,
+	"dir_name": true,
+	"filename": true,
+	"type":     true,
+}
+
+// names of the const char * arguments that are buffers
+var constCharPtrBuffers = map[string]bool{
+	"buf":       true,
+	"u_msg_ptr": true,
+}
+
+// IsString returns true if the argument is a string
+func (sai *SyscallArgInfo) IsString() bool {
+	switch sai.Type {
+	case "const char *":
+		return !constCharPtrBuffers[sai.Name]
+	case "char *":
+		return charPtrStrings[sai.Name]
+	}
+	return false
+}
+
+// IsFd returns true if the argument is a file descriptor
+func (sai *SyscallArgInfo) IsFd() bool {
+	switch sai.Name {
+	case "fd", "dfd", "olddfd", "newdfd", "oldfd", "newfd", "fd_in", "fd_out", "epfd":
+		return !strings.HasSuffix(sai.Type, "*")
+	}
+	return false
+}
+
+// StringArgs returns a bitmask of the string arguments of a syscall
+func StringArgs(sysID int) uint8 {
+	var ret uint8
+	args, ok := GetSyscallArgs(GetSyscallName(sysID))
+	if !ok {
+		return 0
+	}
+	for i := range args {
+		if i < MaxArgs && args[i].IsString() {
+			ret |= 1 << i
+		}
+	}
+	return ret
+}
+
+// DecodeArg returns the value of an argument of a syscall given its raw value:
+// flags with known names are returned as a string (e.g., O_RDONLY|O_CLOEXEC),
+// file descriptors and signed integers as int64, and everything else as
+// uint64. String arguments are not decoded, since val is a pointer.
+func DecodeArg(sysName string, arg *SyscallArgInfo, val uint64) interface{} {
+	if flags, ok := syscallFlags[sysName+":"+arg.Name]; ok {
+		return flags(val)
+	}
+	if arg.IsFd() {
+		return int64(int32(val))
+	}
+	switch arg.Type {
+	case "int", "pid_t", "uid_t", "gid_t":
+		return int64(int32(val))
+	case "long", "off_t", "loff_t":
+		return int64(val)
+	}
+	return val
+}
+
+type flagName struct {
+	val  uint64
+	name string
+}
+
+// flagsString returns the names of the flags of val separated by |, with the
+// remaining bits in hex.
+func flagsString(val uint64, names []flagName) string {
+	var ret []string
+	for _, f := range names {
+		if f.val != 0 && val&f.val == f.val {
+			ret = append(ret, f.name)
+			val &^= f.val
+		}
+	}
+	if val != 0 || len(ret) == 0 {
+		ret = append(ret, fmt.Sprintf("0x%x", val))
+	}
+	return strings.Join(ret, "|")
+}
+
+func flagsDecoder(names []flagName) func(uint64) string {
+	return func(val uint64) string {
+		return flagsString(val, names)
+	}
+}
+
+// NB: flags that include other flags (e.g., O_TMPFILE includes O_DIRECTORY)
+// need to come first.
+var openFlags = []flagName{
+	{unix.O_TMPFILE, "O_TMPFILE"},
+	{unix.O_SYNC, "O_SYNC"},
+	{unix.O_CREAT, "O_CREAT"},
+	{unix.O_EXCL, "O_EXCL"},
+	{unix.O_NOCTTY, "O_NOCTTY"},
+	{unix.O_TRUNC, "O_TRUNC"},
+	{unix.O_APPEND, "O_APPEND"},
+	{unix.O_NONBLOCK, "O_NONBLOCK"},
+	{unix.O_DSYNC, "O_DSYNC"},
+	{unix.O_ASYNC, "O_ASYNC"},
+	{unix.O_DIRECT, "O_DIRECT"},
+	{unix.O_LARGEFILE, "O_LARGEFILE"},
+	{unix.O_DIRECTORY, "O_DIRECTORY"},
+	{unix.O_NOFOLLOW, "O_NOFOLLOW"},
+	{unix.O_NOATIME, "O_NOATIME"},
+	{unix.O_CLOEXEC, "O_CLOEXEC"},
+	{unix.O_PATH, "O_PATH"},
+}
+
+func openFlagsString(val uint64) string {
+	var mode string
+	switch val & unix.O_ACCMODE {
+	case unix.O_RDONLY:
+		mode = "O_RDONLY"
+	case unix.O_WRONLY:
+		mode = "O_WRONLY"
+	case unix.O_RDWR:
+		mode = "O_RDWR"
+	default:
+		return flagsString(val, openFlags)
+	}
+	if val &^= unix.O_ACCMODE; val == 0 {
+		return mode
+	}
+	return mode + "|" + flagsString(val, openFlags)
+}
+
+var protFlags = []flagName{
+	{unix.PROT_READ, "PROT_READ"},
+	{unix.PROT_WRITE, "PROT_WRITE"},
+	{unix.PROT_EXEC, "PROT_EXEC"},
+	{unix.PROT_GROWSDOWN, "PROT_GROWSDOWN"},
+	{unix.PROT_GROWSUP, "PROT_GROWSUP"},
+}
+
+func protFlagsString(val uint64) string {
+	if val == unix.PROT_NONE {
+		return "PROT_NONE"
+	}
+	return flagsString(val, protFlags)
+}
+
+var mmapFlags = []flagName{
+	{unix.MAP_SHARED_VALIDATE, "MAP_SHARED_VALIDATE"},
+	{unix.MAP_SHARED, "MAP_SHARED"},
+	{unix.MAP_PRIVATE, "MAP_PRIVATE"},
+	{unix.MAP_FIXED_NOREPLACE, "MAP_FIXED_NOREPLACE"},
+	{unix.MAP_FIXED, "MAP_FIXED"},
+	{unix.MAP_ANONYMOUS, "MAP_ANONYMOUS"},
+	{unix.MAP_GROWSDOWN, "MAP_GROWSDOWN"},
+	{unix.MAP_DENYWRITE, "MAP_DENYWRITE"},
+	{unix.MAP_EXECUTABLE, "MAP_EXECUTABLE"},
+	{unix.MAP_LOCKED, "MAP_LOCKED"},
+	{unix.MAP_NORESERVE, "MAP_NORESERVE"},
+	{unix.MAP_POPULATE, "MAP_POPULATE"},
+	{unix.MAP_NONBLOCK, "MAP_NONBLOCK"},
+	{unix.MAP_STACK, "MAP_STACK"},
+	{unix.MAP_HUGETLB, "MAP_HUGETLB"},
+	{unix.MAP_SYNC, "MAP_SYNC"},
+}
+
+var cloneFlags = []flagName{
+	{unix.CLONE_VM, "CLONE_VM"},
+	{unix.CLONE_FS, "CLONE_FS"},
+	{unix.CLONE_FILES, "CLONE_FILES"},
+	{unix.CLONE_SIGHAND, "CLONE_SIGHAND"},
+	{unix.CLONE_PIDFD, "CLONE_PIDFD"},
+	{unix.CLONE_PTRACE, "CLONE_PTRACE"},
+	{unix.CLONE_VFORK, "CLONE_VFORK"},
+	{unix.CLONE_PARENT, "CLONE_PARENT"},
+	{unix.CLONE_THREAD, "CLONE_THREAD"},
+	{unix.CLONE_NEWNS, "CLONE_NEWNS"},
+	{unix.CLONE_SYSVSEM, "CLONE_SYSVSEM"},
+	{unix.CLONE_SETTLS, "CLONE_SETTLS"},
+	{unix.CLONE_PARENT_SETTID, "CLONE_PARENT_SETTID"},
+	{unix.CLONE_CHILD_CLEARTID, "CLONE_CHILD_CLEARTID"},
+	{unix.CLONE_UNTRACED, "CLONE_UNTRACED"},
+	{unix.CLONE_CHILD_SETTID, "CLONE_CHILD_SETTID"},
+	{unix.CLONE_NEWCGROUP, "CLONE_NEWCGROUP"},
+	{unix.CLONE_NEWUTS, "CLONE_NEWUTS"},
+	{unix.CLONE_NEWIPC, "CLONE_NEWIPC"},
+	{unix.CLONE_NEWUSER, "CLONE_NEWUSER"},
+	{unix.CLONE_NEWPID, "CLONE_NEWPID"},
+	{unix.CLONE_NEWNET, "CLONE_NEWNET"},
+	{unix.CLONE_IO, "CLONE_IO"},
+	{unix.CLONE_NEWTIME, "CLONE_NEWTIME"},
+}
+
+var mountFlags = []flagName{
+	{unix.MS_RDONLY, "MS_RDONLY"},
+	{unix.MS_NOSUID, "MS_NOSUID"},
+	{unix.MS_NODEV, "MS_NODEV"},
+	{unix.MS_NOEXEC, "MS_NOEXEC"},
+	{unix.MS_SYNCHRONOUS, "MS_SYNCHRONOUS"},
+	{unix.MS_REMOUNT, "MS_REMOUNT"},
+	{unix.MS_MANDLOCK, "MS_MANDLOCK"},
+	{unix.MS_DIRSYNC, "MS_DIRSYNC"},
+	{unix.MS_NOSYMFOLLOW, "MS_NOSYMFOLLOW"},
+	{unix.MS_NOATIME, "MS_NOATIME"},
+	{unix.MS_NODIRATIME, "MS_NODIRATIME"},
+	{unix.MS_BIND, "MS_BIND"},
+	{unix.MS_MOVE, "MS_MOVE"},
+	{unix.MS_REC, "MS_REC"},
+	{unix.MS_SILENT, "MS_SILENT"},
+	{unix.MS_POSIXACL, "MS_POSIXACL"},
+	{unix.MS_UNBINDABLE, "MS_UNBINDABLE"},
+	{unix.MS_PRIVATE, "MS_PRIVATE"},
+	{unix.MS_SLAVE, "MS_SLAVE"},
+	{unix.MS_SHARED, "MS_SHARED"},
+	{unix.MS_RELATIME, "MS_RELATIME"},
+	{unix.MS_KERNMOUNT, "MS_KERNMOUNT"},
+	{unix.MS_I_VERSION, "MS_I_VERSION"},
+	{unix.MS_STRICTATIME, "MS_STRICTATIME"},
+	{unix.MS_LAZYTIME, "MS_LAZYTIME"},
+}
+
+var umountFlags = []flagName{
+	{unix.MNT_FORCE, "MNT_FORCE"},
+	{unix.MNT_DETACH, "MNT_DETACH"},
+	{unix.MNT_EXPIRE, "MNT_EXPIRE"},
+	{unix.UMOUNT_NOFOLLOW, "UMOUNT_NOFOLLOW"},
+}
+
+// syscallFlags are the decoders of the flags arguments, by syscall:argument
+var syscallFlags = map[string]func(uint64) string{
+	"open:flags":            openFlagsString,
+	"openat:flags":          openFlagsString,
+	"mmap:prot":             protFlagsString,
+	"mprotect:prot":         protFlagsString,
+	"mmap:flags":            flagsDecoder(mmapFlags),
+	"clone:clone_flags":     flagsDecoder(cloneFlags),
+	"unshare:unshare_flags": flagsDecoder(cloneFlags),
+	"setns:flags":           flagsDecoder(cloneFlags),
+	"mount:flags":           flagsDecoder(mountFlags),
+	"umount:flags":          flagsDecoder(umountFlags),
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package syscallinfo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// syscallSets are named sets of syscalls, modeled after the systemd seccomp
+// filter sets. Members may be other sets. Syscalls that do not exist on an
+// architecture are ignored.
+var syscallSets = map[string][]string{
+	"@clock": {
+		"adjtimex", "clock_adjtime", "clock_settime", "settimeofday",
+	},
+	"@debug": {
+		"kcmp", "lookup_dcookie", "perf_event_open", "pidfd_getfd",
+		"process_vm_readv", "process_vm_writev", "ptrace",
+	},
+	"@module": {
+		"delete_module", "finit_module", "init_module",
+	},
+	"@mount": {
+		"chroot", "fsconfig", "fsmount", "fsopen", "fspick", "mount",
+		"mount_setattr", "move_mount", "open_tree", "pivot_root", "umount",
+		"umount2",
+	},
+	"@raw-io": {
+		"ioperm", "iopl",
+	},
+	"@reboot": {
+		"kexec_file_load", "kexec_load", "reboot",
+	},
+	"@swap": {
+		"swapoff", "swapon",
+	},
+	"@privileged": {
+		"@chown", "@clock", "@module", "@raw-io", "@reboot", "@swap",
+		"_sysctl", "acct", "bpf", "capset", "chroot", "fanotify_init",
+		"nfsservctl", "open_by_handle_at", "pivot_root", "quotactl",
+		"setdomainname", "setfsuid", "setgid", "setgroups",
+		"sethostname", "setresgid", "setresuid", "setregid", "setreuid",
+		"setuid", "vhangup",
+	},
+	"@chown": {
+		"chown", "fchown", "fchownat", "lchown",
+	},
+}
+
+// GetSyscallSets returns the names of the syscall sets
+func GetSyscallSets() []string {
+	ret := make([]string, 0, len(syscallSets))
+	for name := range syscallSets {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// ResolveSyscalls returns the ids of syscalls given by name, or by set name
+// for names starting with @
+func ResolveSyscalls(names []string) ([]int, error) {
+	ids := map[int]struct{}{}
+	var resolve func(name string, sets []string) error
+	resolve = func(name string, sets []string) error {
+		if !strings.HasPrefix(name, "@") {
+			if id, ok := GetSyscallID(name); ok {
+				ids[id] = struct{}{}
+				return nil
+			}
+			if len(sets) > 0 {
+				// syscall of a set that does not exist on this architecture
+				return nil
+			}
+			return fmt.Errorf("unknown syscall %q", name)
+		}
+		for _, s := range sets {
+			if s == name {
+				return fmt.Errorf("syscall set %s includes itself", name)
+			}
+		}
+		members, ok := syscallSets[name]
+		if !ok {
+			return fmt.Errorf("unknown syscall set %q", name)
+		}
+		for _, m := range members {
+			if err := resolve(m, append(sets, name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, name := range names {
+		if err := resolve(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	ret := make([]int, 0, len(ids))
+	for id := range ids {
+		ret = append(ret, id)
+	}
+	sort.Ints(ret)
+	return ret, nil
+}
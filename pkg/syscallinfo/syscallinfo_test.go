@@ -10,7 +10,16 @@ import (
 	"golang.org/x/sys/unix"
 )
 
+// requireSyscallTable skips tests on architectures without a syscall table,
+// see syscalls_other.go.
+func requireSyscallTable(t *testing.T) {
+	if len(syscallNames) == 0 {
+		t.Skip("no syscall table for this architecture")
+	}
+}
+
 func TestSycallInfo(t *testing.T) {
+	requireSyscallTable(t)
 	sysName := GetSyscallName(unix.SYS_BPF)
 	if sysName != "bpf" {
 		t.Fatalf("got unexpected syscall name: %s (expecting bpf)", sysName)
@@ -26,3 +35,73 @@ func TestSycallInfo(t *testing.T) {
 	proto := actualArgs.Proto(sysName)
 	assert.Equal(t, "long bpf(int cmd, union bpf_attr * uattr, unsigned int size)", proto)
 }
+
+func TestSyscallID(t *testing.T) {
+	requireSyscallTable(t)
+	id, ok := GetSyscallID("bpf")
+	assert.True(t, ok)
+	assert.Equal(t, unix.SYS_BPF, id)
+	id, ok = GetSyscallID("sys_openat")
+	assert.True(t, ok)
+	assert.Equal(t, unix.SYS_OPENAT, id)
+	_, ok = GetSyscallID("nope")
+	assert.False(t, ok)
+}
+
+func TestResolveSyscalls(t *testing.T) {
+	requireSyscallTable(t)
+	ids, err := ResolveSyscalls([]string{"@module", "ptrace", "init_module"})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int{unix.SYS_INIT_MODULE, unix.SYS_FINIT_MODULE, unix.SYS_DELETE_MODULE, unix.SYS_PTRACE}, ids)
+
+	ids, err = ResolveSyscalls([]string{"@privileged"})
+	assert.NoError(t, err)
+	assert.Contains(t, ids, unix.SYS_SETUID)
+	assert.Contains(t, ids, unix.SYS_FCHOWN)
+	assert.Contains(t, ids, unix.SYS_REBOOT)
+
+	_, err = ResolveSyscalls([]string{"nope"})
+	assert.Error(t, err)
+	_, err = ResolveSyscalls([]string{"@nope"})
+	assert.Error(t, err)
+
+	for _, set := range GetSyscallSets() {
+		ids, err := ResolveSyscalls([]string{set})
+		assert.NoError(t, err, set)
+		for _, id := range ids {
+			assert.Less(t, id, MaxSyscalls)
+		}
+	}
+}
+
+func TestDecodeArgs(t *testing.T) {
+	requireSyscallTable(t)
+	args, ok := GetSyscallArgs("openat")
+	assert.True(t, ok)
+	assert.Equal(t, uint8(0x2), StringArgs(unix.SYS_OPENAT))
+
+	fdcwd := int64(unix.AT_FDCWD)
+	vals := []uint64{
+		uint64(fdcwd),
+		0x7fff0000,
+		unix.O_WRONLY | unix.O_CREAT | unix.O_CLOEXEC,
+		0644,
+	}
+	expected := []interface{}{
+		fdcwd,
+		uint64(0x7fff0000),
+		"O_WRONLY|O_CREAT|O_CLOEXEC",
+		uint64(0644),
+	}
+	for i := range args {
+		assert.Equal(t, expected[i], DecodeArg("openat", &args[i], vals[i]), args[i].Name)
+	}
+
+	args, _ = GetSyscallArgs("mmap")
+	assert.Equal(t, "PROT_READ|PROT_EXEC", DecodeArg("mmap", &args[2], unix.PROT_READ|unix.PROT_EXEC))
+	assert.Equal(t, "PROT_NONE", DecodeArg("mmap", &args[2], 0))
+	assert.Equal(t, "MAP_PRIVATE|MAP_ANONYMOUS|0x80000000", DecodeArg("mmap", &args[3], unix.MAP_PRIVATE|unix.MAP_ANONYMOUS|0x80000000))
+
+	args, _ = GetSyscallArgs("unshare")
+	assert.Equal(t, "CLONE_NEWNS|CLONE_NEWUSER", DecodeArg("unshare", &args[0], unix.CLONE_NEWNS|unix.CLONE_NEWUSER))
+}
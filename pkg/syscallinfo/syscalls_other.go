@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !amd64 && !arm64
+// +build !amd64,!arm64
+
+package syscallinfo
+
+// syscallNames is empty on architectures without a syscall table: syscall ids
+// are reported without names, and syscall names cannot be resolved.
+var syscallNames = map[int]string{}
@@ -5,10 +5,13 @@ package tracepoint
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 )
 
+var arraySizeMacroRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 type IntTyBase int
 
 const (
@@ -53,6 +56,13 @@ type ArrayTy struct {
 	Size uint
 }
 
+// DataLocTy is a dynamic array (__data_loc <ty>[]). The field holds the
+// location of the array in the tracepoint data: its offset in the lower 16
+// bits and its length in the upper 16 bits.
+type DataLocTy struct {
+	Ty interface{}
+}
+
 type Field struct {
 	Name string
 	Type interface{}
@@ -174,13 +184,17 @@ func parseField(s string) (*Field, error) {
 		return nil, &ParseError{r: "expecting at least two fields"}
 	}
 
+	name := fields[nfields-1]
+	if fields[0] == "__data_loc" {
+		return parseDataLocField(fields[1:nfields-1], name)
+	}
+
 	tyFields := fields[0 : nfields-1]
 	retTy, err := parseTy(tyFields)
 	if err != nil {
 		return nil, err
 	}
 
-	name := fields[nfields-1]
 	if bOpen := strings.Index(name, "["); bOpen != -1 {
 		var size uint64
 		if !strings.HasSuffix(name, "]") {
@@ -190,7 +204,13 @@ func parseField(s string) (*Field, error) {
 		size_s := strings.TrimSuffix(substrings[1], "]")
 		size, err = strconv.ParseUint(size_s, 10, 32)
 		if err != nil {
-			return nil, &ParseError{r: fmt.Sprintf("failed to parse size: %s", err)}
+			// the size might be a macro (e.g., char comm[TASK_COMM_LEN]),
+			// in which case it is left to 0 and determined from the
+			// size of the field by FieldFormat.ParseField.
+			if !arraySizeMacroRe.MatchString(size_s) {
+				return nil, &ParseError{r: fmt.Sprintf("failed to parse size: %s", err)}
+			}
+			size = 0
 		}
 		retTy = ArrayTy{
 			Ty:   retTy,
@@ -205,6 +225,26 @@ func parseField(s string) (*Field, error) {
 	}, nil
 }
 
+// parseDataLocField parses the type of a __data_loc field, e.g.:
+// __data_loc char[] filename
+func parseDataLocField(tyFields []string, name string) (*Field, error) {
+	nfields := len(tyFields)
+	if nfields == 0 || !strings.HasSuffix(tyFields[nfields-1], "[]") {
+		return nil, &ParseError{r: "could not parse __data_loc structure"}
+	}
+	elemFields := make([]string, nfields)
+	copy(elemFields, tyFields)
+	elemFields[nfields-1] = strings.TrimSuffix(elemFields[nfields-1], "[]")
+	elemTy, err := parseTy(elemFields)
+	if err != nil {
+		return nil, err
+	}
+	return &Field{
+		Name: name,
+		Type: DataLocTy{Ty: elemTy},
+	}, nil
+}
+
 // NBytes retruns the number of bytes of an integer type
 func (ty *IntTy) NBytes() (int, error) {
 	var ret int
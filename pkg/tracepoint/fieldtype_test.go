@@ -133,6 +133,23 @@ func TestTracepointFieldParsing(t *testing.T) {
 					Const: true,
 				},
 			},
+		}, {
+			"__data_loc char[] filename",
+			&Field{
+				Name: "filename",
+				Type: DataLocTy{
+					Ty: IntTy{Base: IntTyChar, Unsigned: false},
+				},
+			},
+		}, {
+			"char comm[TASK_COMM_LEN]",
+			&Field{
+				Name: "comm",
+				Type: ArrayTy{
+					Ty:   IntTy{Base: IntTyChar, Unsigned: false},
+					Size: 0,
+				},
+			},
 		},
 	}
 
@@ -48,10 +48,46 @@ func (tff *FieldFormat) ParseField() error {
 	if err != nil {
 		return err
 	}
+	if arrTy, ok := ty.Type.(ArrayTy); ok && arrTy.Size == 0 {
+		if intTy, ok := arrTy.Ty.(IntTy); ok {
+			nbytes, err := intTy.NBytes()
+			if err != nil {
+				return err
+			}
+			arrTy.Size = tff.Size / uint(nbytes)
+			ty.Type = arrTy
+		}
+	}
 	tff.Field = ty
 	return nil
 }
 
+// Name returns the name of the field, without parsing its type.
+func (tff *FieldFormat) Name() string {
+	if tff.Field != nil {
+		return tff.Field.Name
+	}
+	fields := strings.Fields(tff.FieldStr)
+	if len(fields) == 0 {
+		return ""
+	}
+	name := fields[len(fields)-1]
+	if bOpen := strings.Index(name, "["); bOpen != -1 {
+		name = name[:bOpen]
+	}
+	return name
+}
+
+// FieldIndex returns the index in Fields of the field with the given name.
+func (f *Format) FieldIndex(name string) (int, error) {
+	for i := range f.Fields {
+		if f.Fields[i].Name() == name {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("field %q not found in format of %s", name, f.Name)
+}
+
 // LoadFormat loads the format of a tracepoint from /sys/kernel/debug
 func (gt *Tracepoint) LoadFormat() error {
 	gtf, err := tracepointLoadFormat(gt.Subsys, gt.Event)
@@ -116,3 +116,37 @@ func TestTracepointsAll(t *testing.T) {
 		}
 	}
 }
+
+func TestTracepointFieldIndex(t *testing.T) {
+	format := Format{
+		Name: "sched_process_exec",
+		Fields: []FieldFormat{
+			{FieldStr: "unsigned short common_type", Offset: 0, Size: 2},
+			{FieldStr: "__data_loc char[] filename", Offset: 8, Size: 4, IsSigned: true},
+			{FieldStr: "pid_t pid", Offset: 12, Size: 4, IsSigned: true},
+			{FieldStr: "char comm[TASK_COMM_LEN]", Offset: 16, Size: 16, IsSigned: true},
+		},
+	}
+
+	for i, name := range []string{"common_type", "filename", "pid", "comm"} {
+		idx, err := format.FieldIndex(name)
+		if err != nil {
+			t.Fatalf("FieldIndex(%s): %s", name, err)
+		}
+		if idx != i {
+			t.Errorf("FieldIndex(%s): got %d expecting %d", name, idx, i)
+		}
+	}
+	if _, err := format.FieldIndex("nope"); err == nil {
+		t.Error("FieldIndex(nope): expecting error")
+	}
+
+	comm := &format.Fields[3]
+	if err := comm.ParseField(); err != nil {
+		t.Fatalf("ParseField: %s", err)
+	}
+	expected := ArrayTy{Ty: IntTy{Base: IntTyChar}, Size: 16}
+	if !reflect.DeepEqual(comm.Field.Type, expected) {
+		t.Errorf("unexpected type for comm: got %+v expecting %+v", comm.Field.Type, expected)
+	}
+}
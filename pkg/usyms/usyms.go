@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package usyms symbolizes user space addresses using the ELF symbol tables
+// of the binaries and shared libraries mapped by processes.
+package usyms
+
+import (
+	"bufio"
+	"debug/elf"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// Symbol is the location of a user space address.
+type Symbol struct {
+	// Name of the function, empty if it was not found.
+	Name string
+	// Offset of the address in the function.
+	Offset uint64
+	// Module is the path of the binary or shared library of the address.
+	Module string
+}
+
+// String returns a string representation of Symbol.
+func (s *Symbol) String() string {
+	if s.Name == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s()+0x%x", s.Name, s.Offset)
+}
+
+type mapping struct {
+	start, end uint64
+	offset     uint64
+	file       fileKey
+	path       string
+}
+
+// fileKey identifies a mapped file by the device and inode of
+// /proc/pid/maps, which unlike the path do not depend on the mount namespace
+// of the process.
+type fileKey struct {
+	dev   string
+	inode uint64
+}
+
+// procKey identifies a process, the ktime of its exec distinguishes it from
+// processes reusing its pid and from its own later execs.
+type procKey struct {
+	pid   uint32
+	ktime uint64
+}
+
+type elfSym struct {
+	addr uint64
+	size uint64
+	name string
+}
+
+// elfFile holds the function symbols of an ELF file sorted by address, and
+// its loadable segments to translate file offsets to virtual addresses.
+type elfFile struct {
+	syms  []elfSym
+	progs []elf.ProgHeader
+}
+
+// Usyms symbolizes user space addresses. The mappings of processes are
+// cached by pid and exec ktime, the symbol tables of ELF files by device and
+// inode and, to share them between containers, by build id.
+type Usyms struct {
+	procfs string
+	// maps caches the []mapping of processes by procKey
+	maps *lru.Cache
+	// files caches the *elfFile of mapped files by fileKey
+	files *lru.Cache
+	// buildIDs caches the *elfFile of mapped files by build id
+	buildIDs *lru.Cache
+}
+
+// NewUsyms creates a new Usyms structure reading process mappings from
+// procfs.
+func NewUsyms(procfs string) (*Usyms, error) {
+	maps, err := lru.New(1024)
+	if err != nil {
+		return nil, err
+	}
+	files, err := lru.New(64)
+	if err != nil {
+		return nil, err
+	}
+	buildIDs, err := lru.New(64)
+	if err != nil {
+		return nil, err
+	}
+	return &Usyms{procfs: procfs, maps: maps, files: files, buildIDs: buildIDs}, nil
+}
+
+// Symbolize returns the symbols of the addresses of the process pid whose
+// exec happened at ktime. Symbols of addresses that cannot be resolved, for
+// example because the process exited, have an empty name.
+func (u *Usyms) Symbolize(pid uint32, ktime uint64, addrs []uint64) ([]Symbol, error) {
+	key := procKey{pid: pid, ktime: ktime}
+	var maps []mapping
+	cached := false
+	if v, ok := u.maps.Get(key); ok {
+		maps = v.([]mapping)
+		cached = true
+	} else {
+		var err error
+		if maps, err = u.readMaps(pid); err != nil {
+			return nil, err
+		}
+		u.maps.Add(key, maps)
+	}
+
+	ret := make([]Symbol, len(addrs))
+	for i, addr := range addrs {
+		m := findMapping(maps, addr)
+		if m == nil && cached {
+			// The process mapped new files since its mappings
+			// were cached, read them again once.
+			cached = false
+			if fresh, err := u.readMaps(pid); err == nil {
+				maps = fresh
+				u.maps.Add(key, maps)
+				m = findMapping(maps, addr)
+			}
+		}
+		if m == nil {
+			continue
+		}
+		ret[i].Module = m.path
+		f, err := u.getFile(pid, m)
+		if err != nil {
+			continue
+		}
+		if sym, ok := f.lookup(addr - m.start + m.offset); ok {
+			ret[i].Name = sym.name
+			ret[i].Offset = f.offsetToVaddr(addr-m.start+m.offset) - sym.addr
+		}
+	}
+	return ret, nil
+}
+
+// readMaps returns the file backed executable mappings of the process pid.
+func (u *Usyms) readMaps(pid uint32) ([]mapping, error) {
+	file, err := os.Open(filepath.Join(u.procfs, strconv.FormatUint(uint64(pid), 10), "maps"))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var maps []mapping
+	s := bufio.NewScanner(file)
+	for s.Scan() {
+		// address perms offset dev inode pathname
+		fields := strings.Fields(s.Text())
+		if len(fields) < 6 || !strings.Contains(fields[1], "x") || !strings.HasPrefix(fields[5], "/") {
+			continue
+		}
+		bounds := strings.SplitN(fields[0], "-", 2)
+		if len(bounds) != 2 {
+			continue
+		}
+		var m mapping
+		if m.start, err = strconv.ParseUint(bounds[0], 16, 64); err != nil {
+			continue
+		}
+		if m.end, err = strconv.ParseUint(bounds[1], 16, 64); err != nil {
+			continue
+		}
+		if m.offset, err = strconv.ParseUint(fields[2], 16, 64); err != nil {
+			continue
+		}
+		if m.file.inode, err = strconv.ParseUint(fields[4], 10, 64); err != nil {
+			continue
+		}
+		m.file.dev = fields[3]
+		m.path = fields[5]
+		maps = append(maps, m)
+	}
+	return maps, s.Err()
+}
+
+func findMapping(maps []mapping, addr uint64) *mapping {
+	for i := range maps {
+		if maps[i].start <= addr && addr < maps[i].end {
+			return &maps[i]
+		}
+	}
+	return nil
+}
+
+// getFile returns the symbols of the ELF file of the mapping m of the process
+// pid. The file is only opened if its device and inode are not cached yet.
+func (u *Usyms) getFile(pid uint32, m *mapping) (*elfFile, error) {
+	if v, ok := u.files.Get(m.file); ok {
+		return v.(*elfFile), nil
+	}
+
+	fname := filepath.Join(u.procfs, strconv.FormatUint(uint64(pid), 10), "root", m.path)
+	ef, err := elf.Open(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer ef.Close()
+
+	id := buildID(ef)
+	if id != "" {
+		if v, ok := u.buildIDs.Get(id); ok {
+			u.files.Add(m.file, v)
+			return v.(*elfFile), nil
+		}
+	}
+	f := readELF(ef)
+	u.files.Add(m.file, f)
+	if id != "" {
+		u.buildIDs.Add(id, f)
+	}
+	return f, nil
+}
+
+// buildID returns the GNU build id of an ELF file, or an empty string.
+func buildID(ef *elf.File) string {
+	s := ef.Section(".note.gnu.build-id")
+	if s == nil {
+		return ""
+	}
+	data, err := s.Data()
+	// namesz, descsz, type, "GNU\0", desc
+	if err != nil || len(data) < 16 {
+		return ""
+	}
+	descsz := ef.ByteOrder.Uint32(data[4:8])
+	if uint64(len(data)) < 16+uint64(descsz) {
+		return ""
+	}
+	return hex.EncodeToString(data[16 : 16+descsz])
+}
+
+func readELF(ef *elf.File) *elfFile {
+	f := &elfFile{}
+	for _, p := range ef.Progs {
+		if p.Type == elf.PT_LOAD {
+			f.progs = append(f.progs, p.ProgHeader)
+		}
+	}
+	// Stripped files only have dynamic symbols.
+	syms, _ := ef.Symbols()
+	dynsyms, _ := ef.DynamicSymbols()
+	for _, s := range append(syms, dynsyms...) {
+		if elf.ST_TYPE(s.Info) != elf.STT_FUNC || s.Value == 0 {
+			continue
+		}
+		f.syms = append(f.syms, elfSym{addr: s.Value, size: s.Size, name: s.Name})
+	}
+	sort.Slice(f.syms, func(i, j int) bool { return f.syms[i].addr < f.syms[j].addr })
+	return f
+}
+
+// offsetToVaddr translates a file offset to a virtual address of the ELF
+// file.
+func (f *elfFile) offsetToVaddr(off uint64) uint64 {
+	for _, p := range f.progs {
+		if p.Off <= off && off < p.Off+p.Filesz {
+			return off - p.Off + p.Vaddr
+		}
+	}
+	return off
+}
+
+// lookup returns the function containing the file offset off.
+func (f *elfFile) lookup(off uint64) (elfSym, bool) {
+	vaddr := f.offsetToVaddr(off)
+	i := sort.Search(len(f.syms), func(i int) bool { return f.syms[i].addr > vaddr })
+	if i == 0 {
+		return elfSym{}, false
+	}
+	sym := f.syms[i-1]
+	if sym.size != 0 && vaddr >= sym.addr+sym.size {
+		return elfSym{}, false
+	}
+	return sym, true
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package usyms
+
+import (
+	"debug/elf"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// libcAddr returns the address of a libc function in the test process.
+func libcAddr(t *testing.T, u *Usyms, name string) (uint64, string) {
+	maps, err := u.readMaps(uint32(os.Getpid()))
+	require.NoError(t, err)
+	for _, m := range maps {
+		if !strings.Contains(m.path, "libc.so") {
+			continue
+		}
+		ef, err := elf.Open(m.path)
+		require.NoError(t, err)
+		defer ef.Close()
+		f := readELF(ef)
+		syms, err := ef.DynamicSymbols()
+		require.NoError(t, err)
+		for _, s := range syms {
+			if s.Name != name {
+				continue
+			}
+			for _, p := range f.progs {
+				if p.Vaddr <= s.Value && s.Value < p.Vaddr+p.Filesz {
+					off := s.Value - p.Vaddr + p.Off
+					if m.offset <= off && off < m.offset+m.end-m.start {
+						return m.start + off - m.offset, m.path
+					}
+				}
+			}
+		}
+	}
+	t.Skip("libc function not mapped in the test process")
+	return 0, ""
+}
+
+func TestSymbolize(t *testing.T) {
+	u, err := NewUsyms("/proc")
+	require.NoError(t, err)
+
+	addr, path := libcAddr(t, u, "getpid")
+	syms, err := u.Symbolize(uint32(os.Getpid()), 1, []uint64{addr, addr + 1, 0})
+	require.NoError(t, err)
+	require.Len(t, syms, 3)
+
+	// getpid can be resolved to one of its aliases, e.g. __getpid.
+	assert.True(t, strings.HasSuffix(syms[0].Name, "getpid"), syms[0].Name)
+	assert.Equal(t, uint64(0), syms[0].Offset)
+	assert.Equal(t, path, syms[0].Module)
+	assert.Equal(t, syms[0].Name, syms[1].Name)
+	assert.Equal(t, uint64(1), syms[1].Offset)
+	assert.Equal(t, syms[1].Name+"()+0x1", syms[1].String())
+
+	// Unmapped addresses are not resolved.
+	assert.Equal(t, Symbol{}, syms[2])
+	assert.Equal(t, "", syms[2].String())
+}
+
+func TestSymbolizeExited(t *testing.T) {
+	u, err := NewUsyms(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = u.Symbolize(1, 1, []uint64{0x1000})
+	assert.Error(t, err)
+}
+
+func TestSymbolizeCache(t *testing.T) {
+	u, err := NewUsyms("/proc")
+	require.NoError(t, err)
+
+	addr, _ := libcAddr(t, u, "getpid")
+	pid := uint32(os.Getpid())
+	syms, err := u.Symbolize(pid, 1, []uint64{addr})
+	require.NoError(t, err)
+
+	// Mappings are cached per exec, files by device and inode.
+	assert.Equal(t, 1, u.maps.Len())
+	assert.Equal(t, 1, u.files.Len())
+	v, ok := u.maps.Get(procKey{pid: pid, ktime: 1})
+	require.True(t, ok)
+	m := findMapping(v.([]mapping), addr)
+	require.NotNil(t, m)
+	assert.NotZero(t, m.file.inode)
+	assert.True(t, u.files.Contains(m.file))
+
+	again, err := u.Symbolize(pid, 1, []uint64{addr})
+	require.NoError(t, err)
+	assert.Equal(t, syms, again)
+	assert.Equal(t, 1, u.maps.Len())
+
+	// A new exec of the pid reads the mappings again.
+	_, err = u.Symbolize(pid, 2, []uint64{addr})
+	require.NoError(t, err)
+	assert.Equal(t, 2, u.maps.Len())
+	assert.Equal(t, 1, u.files.Len())
+}